@@ -0,0 +1,147 @@
+// Package chartest renders charts headless and compares them against golden images,
+// so applications can add visual regression tests of their dashboards with one line
+package chartest
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/software"
+	"fyne.io/fyne/v2/test"
+	"github.com/skoona/sknlinechart"
+)
+
+// UpdateEnv environment variable that, when set, rewrites golden images from the current rendering
+const UpdateEnv = "CHARTEST_UPDATE"
+
+// channelTolerance color channel difference, out of 255, below which pixels are treated as equal
+// so antialiasing differences between platforms do not count
+const channelTolerance = 8
+
+// defaultRenderSize size used for charts that have not been resized, the gui's default window
+var defaultRenderSize = fyne.NewSize(982, 452)
+
+// AssertRendersLike renders the chart headless and fails the test when more than tolerance,
+// a fraction from 0 to 1, of its pixels differ from the golden png. A missing golden, or any
+// golden when CHARTEST_UPDATE is set, is written from the rendering instead. On a mismatch the
+// rendering is written beside the golden as <name>.actual.png for inspection
+func AssertRendersLike(t testing.TB, chart sknlinechart.LineChart, goldenPath string, tolerance float64) bool {
+	t.Helper()
+	actual := Render(chart)
+
+	if _, err := os.Stat(goldenPath); os.Getenv(UpdateEnv) != "" || errors.Is(err, os.ErrNotExist) {
+		if err := writePNG(goldenPath, actual); err != nil {
+			t.Errorf("chartest: writing golden %s: %v", goldenPath, err)
+			return false
+		}
+		t.Logf("chartest: wrote golden %s", goldenPath)
+		return true
+	}
+
+	golden, err := readPNG(goldenPath)
+	if err != nil {
+		t.Errorf("chartest: reading golden %s: %v", goldenPath, err)
+		return false
+	}
+	diff, err := Compare(golden, actual)
+	if err == nil && diff <= tolerance {
+		return true
+	}
+
+	actualPath := strings.TrimSuffix(goldenPath, filepath.Ext(goldenPath)) + ".actual.png"
+	if werr := writePNG(actualPath, actual); werr != nil {
+		t.Logf("chartest: writing %s: %v", actualPath, werr)
+	}
+	if err != nil {
+		t.Errorf("chartest: %s: %v, rendering written to %s", goldenPath, err, actualPath)
+	} else {
+		t.Errorf("chartest: %s: %.2f%% of pixels differ, tolerance %.2f%%, rendering written to %s",
+			goldenPath, diff*100, tolerance*100, actualPath)
+	}
+	return false
+}
+
+// Render draws the chart on an offscreen software canvas at its current size, the same
+// renderer the gui uses. The chart is moved onto the offscreen canvas
+func Render(chart sknlinechart.LineChart) image.Image {
+	if fyne.CurrentApp() == nil {
+		test.NewApp() // the software canvas needs a current app for themes and fonts
+	}
+	size := chart.Size()
+	if size.IsZero() {
+		size = defaultRenderSize
+	}
+	c := software.NewCanvas()
+	c.SetPadded(false)
+	c.SetContent(container.NewPadded(chart)) // padded like a window so scale labels are not clipped
+	c.Resize(size)
+	return c.Capture()
+}
+
+// Compare returns the fraction of pixels that differ between two images of the same size
+func Compare(expected, actual image.Image) (float64, error) {
+	eb, ab := expected.Bounds(), actual.Bounds()
+	if eb.Dx() != ab.Dx() || eb.Dy() != ab.Dy() {
+		return 1, fmt.Errorf("size differs. expected:%dx%d, actual:%dx%d", eb.Dx(), eb.Dy(), ab.Dx(), ab.Dy())
+	}
+	if eb.Empty() {
+		return 0, nil
+	}
+	differ := 0
+	for y := 0; y < eb.Dy(); y++ {
+		for x := 0; x < eb.Dx(); x++ {
+			if pixelsDiffer(expected.At(eb.Min.X+x, eb.Min.Y+y), actual.At(ab.Min.X+x, ab.Min.Y+y)) {
+				differ++
+			}
+		}
+	}
+	return float64(differ) / float64(eb.Dx()*eb.Dy()), nil
+}
+
+// pixelsDiffer returns true when any color channel differs by more than the channel tolerance
+func pixelsDiffer(a, b color.Color) bool {
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+	for _, d := range []int64{int64(ar) - int64(br), int64(ag) - int64(bg), int64(ab) - int64(bb), int64(aa) - int64(ba)} {
+		if d < 0 {
+			d = -d
+		}
+		if d>>8 > channelTolerance {
+			return true
+		}
+	}
+	return false
+}
+
+func readPNG(path string) (image.Image, error) {
+	in, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+	return png.Decode(in)
+}
+
+func writePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = png.Encode(out, img)
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,13 @@
+package chartest_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestChartest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Chartest Suite")
+}
@@ -0,0 +1,90 @@
+package chartest_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"github.com/skoona/sknlinechart/chartest"
+)
+
+// recordingT captures failures so mismatches can be asserted without failing the spec,
+// only the methods AssertRendersLike calls are implemented
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingT) Helper()             {}
+func (r *recordingT) Logf(string, ...any) {}
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+var _ = Describe("Golden image assertions", func() {
+	var (
+		golden string
+		rt     *recordingT
+	)
+
+	// chart returns a sized chart of a fixed ramp, so renderings repeat exactly
+	chart := func(title string) sknlinechart.LineChart {
+		lc, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithTitle(title)))
+		Expect(err).NotTo(HaveOccurred())
+		var points []sknlinechart.ChartDatapoint
+		for i := 0; i < 40; i++ {
+			points = append(points, sknlinechart.NewChartDatapoint(float32(20+i), theme.ColorBlue, "Thu, 01 Jan 2026 00:00:00 UTC"))
+		}
+		lc.ApplyDataPoints("Ramp", points)
+		lc.Resize(fyne.NewSize(600, 300))
+		return lc
+	}
+
+	BeforeEach(func() {
+		test.NewApp()
+		golden = filepath.Join(GinkgoT().TempDir(), "golden", "ramp.png")
+		rt = &recordingT{}
+	})
+
+	It("should write a missing golden and then match it", func() {
+		Expect(chartest.AssertRendersLike(rt, chart("Ramp"), golden, 0)).To(BeTrue())
+		Expect(golden).To(BeAnExistingFile())
+		Expect(chartest.AssertRendersLike(rt, chart("Ramp"), golden, 0)).To(BeTrue())
+		Expect(rt.errors).To(BeEmpty())
+	})
+	It("should fail a rendering that differs beyond the tolerance", func() {
+		Expect(chartest.AssertRendersLike(rt, chart("Ramp"), golden, 0)).To(BeTrue())
+
+		Expect(chartest.AssertRendersLike(rt, chart("A different title entirely"), golden, 0.0001)).To(BeFalse())
+		Expect(rt.errors).To(HaveLen(1))
+		Expect(rt.errors[0]).To(ContainSubstring("of pixels differ"))
+		Expect(filepath.Join(filepath.Dir(golden), "ramp.actual.png")).To(BeAnExistingFile())
+
+		rt.errors = nil
+		Expect(chartest.AssertRendersLike(rt, chart("A different title entirely"), golden, 0.5)).To(BeTrue())
+		Expect(rt.errors).To(BeEmpty())
+	})
+	It("should rewrite the golden when updating", func() {
+		Expect(chartest.AssertRendersLike(rt, chart("Ramp"), golden, 0)).To(BeTrue())
+		os.Setenv(chartest.UpdateEnv, "1")
+		DeferCleanup(os.Unsetenv, chartest.UpdateEnv)
+		Expect(chartest.AssertRendersLike(rt, chart("Updated"), golden, 0)).To(BeTrue())
+		os.Unsetenv(chartest.UpdateEnv)
+		Expect(chartest.AssertRendersLike(rt, chart("Updated"), golden, 0)).To(BeTrue())
+		Expect(rt.errors).To(BeEmpty())
+	})
+	It("should report images of different sizes as fully different", func() {
+		small := chart("Ramp")
+		small.Resize(fyne.NewSize(300, 200))
+		diff, err := chartest.Compare(chartest.Render(chart("Ramp")), chartest.Render(small))
+		Expect(err).To(HaveOccurred())
+		Expect(diff).To(BeNumerically("==", 1))
+	})
+})
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/software"
+	"fyne.io/fyne/v2/test"
+)
+
+// headlessCanvas lays the chart out on an offscreen software canvas, the same renderer the gui uses,
+// padded like the gui window so scale labels are not clipped. fyne has no headless driver of its own;
+// the software canvas resolves themes and fonts through the current app, so the test package's
+// in-memory app is installed for that alone, it is the only use of fyne's test package in this tool
+func headlessCanvas(chart fyne.CanvasObject, width, height int) fyne.Canvas {
+	if fyne.CurrentApp() == nil {
+		test.NewApp()
+	}
+	c := software.NewCanvas()
+	c.SetPadded(false)
+	c.SetContent(container.NewPadded(chart))
+	c.Resize(fyne.NewSize(float32(width), float32(height)))
+	return c
+}
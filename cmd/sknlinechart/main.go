@@ -63,6 +63,13 @@ func makeChart(title, footer string) (lc.LineChart, error) {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		os.Exit(runExport(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		os.Exit(runReplay(os.Args[2:]))
+	}
+
 	systemSignalChannel := make(chan os.Signal, 1)
 	exitCode := 0
 	windowClosed := false
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/app"
+	"fyne.io/fyne/v2/container"
+	lc "github.com/skoona/sknlinechart"
+)
+
+// runReplay plays a chart recording back into a chart window, at the pace it was recorded
+// usage: sknlinechart replay -in recording.rec|dir [-speed 1] [-title t]
+func runReplay(args []string) int {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	in := fs.String("in", "", "recording file written by EnableRecorder, or a directory of them played oldest first")
+	speed := fs.Float64("speed", 1, "playback speed multiple, 0 plays as fast as possible")
+	title := fs.String("title", "Replay", "chart title")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *in == "" || *speed < 0 {
+		fs.Usage()
+		return 2
+	}
+	files, err := recordingFiles(*in)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "replay:", err.Error())
+		return 1
+	}
+
+	gui := app.NewWithID("net.skoona.sknLineChart")
+	w := gui.NewWindow("Replay: " + filepath.Base(*in))
+	chart, err := lc.NewWithOptions(lc.NewChartOptions(lc.WithTitle(*title)))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "replay:", err.Error())
+		return 1
+	}
+	stop := make(chan struct{})
+	w.SetOnClosed(func() { close(stop) })
+	failed := make(chan struct{}) // closed when the replay fails
+	go func() {
+		err := replay(chart, files, *speed, stop)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "replay:", err.Error())
+			close(failed)
+		}
+	}()
+
+	w.SetContent(container.NewPadded(chart))
+	w.Resize(fyne.NewSize(982, 452))
+	w.CenterOnScreen()
+	w.ShowAndRun()
+	select {
+	case <-failed:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// recordingFiles returns the path itself, or the recordings in the directory sorted oldest first
+func recordingFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	files, err := filepath.Glob(filepath.Join(path, "*.rec"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no recordings in %s", path)
+	}
+	sort.Strings(files) // timestamped names sort oldest first
+	return files, nil
+}
+
+// replay applies each recorded point to the chart, waiting out the recorded gaps divided by speed
+func replay(chart lc.LineChart, files []string, speed float64, stop <-chan struct{}) error {
+	var last time.Time
+	for _, name := range files {
+		in, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		reader, err := lc.NewRecordingReader(in)
+		if err != nil {
+			in.Close()
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		for {
+			entry, err := reader.Next()
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) { // a recording cut short ends at its last whole point
+				break
+			}
+			if err != nil {
+				in.Close()
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			if speed > 0 && !last.IsZero() && entry.At.After(last) {
+				select {
+				case <-stop:
+					in.Close()
+					return nil
+				case <-time.After(time.Duration(float64(entry.At.Sub(last)) / speed)):
+				}
+			}
+			last = entry.At
+			if entry.Replace {
+				_ = chart.ClearSeries(entry.Series)
+				continue
+			}
+			chart.ApplyDataPoint(entry.Series, &entry.Point)
+		}
+		in.Close()
+	}
+	return nil
+}
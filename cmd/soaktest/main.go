@@ -0,0 +1,172 @@
+// soaktest runs a chart headless for hours at a configurable ingest rate, failing as soon as heap,
+// renderer object, or goroutine counts pass their ceilings, so leaks are caught before a release
+//
+// usage: soaktest [-duration 4h] [-rate 20] [-series 4] [-churn 1m] [-heap-mb 256] [-objects 5000] [-goroutines 64]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/software"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	lc "github.com/skoona/sknlinechart"
+)
+
+// soakPalette colors assigned to the soak series in order
+var soakPalette = []string{
+	theme.ColorBlue, theme.ColorRed, theme.ColorGreen, theme.ColorOrange,
+	theme.ColorPurple, theme.ColorYellow, theme.ColorBrown, theme.ColorGray,
+}
+
+// soakConfig limits and rates of one soak run
+type soakConfig struct {
+	duration      time.Duration
+	rate          float64 // datapoints per second for each series
+	series        int
+	churn         time.Duration // how often a series is deleted and a new one added, 0 never
+	render        time.Duration
+	check         time.Duration
+	heapMB        uint64
+	objects       int
+	goroutines    int
+	width, height int
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run parses the flags and soaks the chart, returning the process exit code
+func run(args []string) int {
+	var cfg soakConfig
+	fs := flag.NewFlagSet("soaktest", flag.ContinueOnError)
+	fs.DurationVar(&cfg.duration, "duration", 4*time.Hour, "how long to run")
+	fs.Float64Var(&cfg.rate, "rate", 20, "datapoints per second for each series")
+	fs.IntVar(&cfg.series, "series", 4, "series fed concurrently")
+	fs.DurationVar(&cfg.churn, "churn", time.Minute, "delete one series and add a new one this often, 0 disables")
+	fs.DurationVar(&cfg.render, "render", time.Second, "how often the chart is drawn")
+	fs.DurationVar(&cfg.check, "check", 30*time.Second, "how often the ceilings are checked and reported")
+	fs.Uint64Var(&cfg.heapMB, "heap-mb", 256, "live heap ceiling in megabytes, after garbage collection")
+	fs.IntVar(&cfg.objects, "objects", 5000, "renderer canvas object ceiling")
+	fs.IntVar(&cfg.goroutines, "goroutines", 64, "goroutine ceiling")
+	fs.IntVar(&cfg.width, "width", 982, "canvas width")
+	fs.IntVar(&cfg.height, "height", 452, "canvas height")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if cfg.duration <= 0 || cfg.rate <= 0 || cfg.series <= 0 || cfg.render <= 0 || cfg.check <= 0 {
+		fs.Usage()
+		return 2
+	}
+
+	err := soak(cfg, log.New(os.Stdout, "[SOAK] ", log.LstdFlags))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "soaktest:", err.Error())
+		return 1
+	}
+	return 0
+}
+
+// soak feeds and draws the chart until the duration passes, a ceiling is exceeded, or the process is interrupted
+func soak(cfg soakConfig, logger *log.Logger) error {
+	test.NewApp() // headless app, the software canvas needs a current app for themes and fonts
+
+	chart, err := lc.NewWithOptions(lc.NewChartOptions(lc.WithTitle("Soak Test")))
+	if err != nil {
+		return err
+	}
+	c := software.NewCanvas()
+	c.SetPadded(false)
+	c.SetContent(container.NewPadded(chart))
+	c.Resize(fyne.NewSize(float32(cfg.width), float32(cfg.height)))
+
+	names := make([]string, 0, cfg.series)
+	for i := 0; i < cfg.series; i++ {
+		names = append(names, fmt.Sprint("Series-", i))
+	}
+	next := cfg.series // number of the next churned series
+
+	ingest := time.NewTicker(time.Duration(float64(time.Second) / cfg.rate))
+	defer ingest.Stop()
+	render := time.NewTicker(cfg.render)
+	defer render.Stop()
+	check := time.NewTicker(cfg.check)
+	defer check.Stop()
+	var churn <-chan time.Time
+	if cfg.churn > 0 {
+		t := time.NewTicker(cfg.churn)
+		defer t.Stop()
+		churn = t.C
+	}
+	done := time.After(cfg.duration)
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(stop)
+
+	started := time.Now()
+	logger.Printf("soaking %d series at %.1f points/s each for %v", cfg.series, cfg.rate, cfg.duration)
+	for tick := 0; ; tick++ {
+		select {
+		case <-ingest.C:
+			for idx, name := range names {
+				value := 65 + 50*math.Sin(float64(tick+idx*10)/25) + rand.Float64()*5
+				point := lc.NewChartDatapoint(float32(value), soakPalette[idx%len(soakPalette)], time.Now().Format(time.RFC1123))
+				chart.ApplyDataPoint(name, &point)
+			}
+		case <-render.C:
+			c.Capture()
+		case <-churn:
+			if err := chart.DeleteSeries(names[0]); err != nil {
+				return err
+			}
+			names = append(names[1:], fmt.Sprint("Series-", next))
+			next++
+		case <-check.C:
+			if err := checkCeilings(cfg, chart, started, logger); err != nil {
+				return err
+			}
+		case <-done:
+			logger.Printf("completed after %v", time.Since(started).Round(time.Second))
+			return checkCeilings(cfg, chart, started, logger)
+		case sig := <-stop:
+			logger.Printf("stopped by %v after %v", sig, time.Since(started).Round(time.Second))
+			return checkCeilings(cfg, chart, started, logger)
+		}
+	}
+}
+
+// checkCeilings reports the chart's resource use and fails when any ceiling is exceeded
+func checkCeilings(cfg soakConfig, chart lc.LineChart, started time.Time, logger *log.Logger) error {
+	runtime.GC()
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	heapMB := mem.HeapAlloc / (1024 * 1024)
+	objects := len(test.WidgetRenderer(chart.(fyne.Widget)).Objects())
+	goroutines := runtime.NumGoroutine()
+	metrics := chart.Metrics()
+	logger.Printf("elapsed=%v heap=%dMB objects=%d goroutines=%d ingested=%d dropped=%d refreshes=%d",
+		time.Since(started).Round(time.Second), heapMB, objects, goroutines,
+		metrics.PointsIngested, metrics.PointsDropped, metrics.Refreshes)
+
+	switch {
+	case heapMB > cfg.heapMB:
+		return fmt.Errorf("heap %dMB exceeds the %dMB ceiling", heapMB, cfg.heapMB)
+	case objects > cfg.objects:
+		return fmt.Errorf("renderer holds %d canvas objects, ceiling %d", objects, cfg.objects)
+	case goroutines > cfg.goroutines:
+		return fmt.Errorf("%d goroutines running, ceiling %d", goroutines, cfg.goroutines)
+	}
+	return nil
+}
@@ -3,33 +3,102 @@ package sknlinechart
 import (
 	"fyne.io/fyne/v2"
 	"github.com/google/uuid"
+	"image/color"
+	"math"
 	"strings"
+	"time"
 )
 
 type chartDatapoint struct {
 	value                float32
 	colorName            string
+	color                color.Color
 	timestamp            string
+	at                   time.Time // timestamp as read once at ingest, zero when unreadable
 	externalID           string
+	lower                float32
+	upper                float32
+	bounded              bool
+	xValue               float32
+	hasXValue            bool
+	missing              bool
 	markerTopPosition    *fyne.Position
 	markerBottomPosition *fyne.Position
 }
 
 func NewChartDatapoint(value float32, colorName, timestamp string) ChartDatapoint {
+	at, _ := parseTimestamp(timestamp)
 	return &chartDatapoint{
 		value:                value,
 		colorName:            colorName,
 		timestamp:            timestamp,
+		at:                   at,
 		markerTopPosition:    &fyne.Position{X: 0, Y: 0},
 		markerBottomPosition: &fyne.Position{X: 0, Y: 0},
 		externalID:           uuid.New().String(),
 	}
 }
+
+// NewChartDatapointAt creates a datapoint from a time, formatted once as its RFC1123 timestamp, sparing
+// high-rate sources the formatting and the chart the parsing of every sample
+func NewChartDatapointAt(value float32, colorName string, at time.Time) ChartDatapoint {
+	return &chartDatapoint{
+		value:                value,
+		colorName:            colorName,
+		timestamp:            at.Format(time.RFC1123),
+		at:                   at,
+		markerTopPosition:    &fyne.Position{X: 0, Y: 0},
+		markerBottomPosition: &fyne.Position{X: 0, Y: 0},
+		externalID:           uuid.New().String(),
+	}
+}
+
+// NewChartDatapointWithBounds creates a datapoint carrying the lower and upper values of its confidence interval
+func NewChartDatapointWithBounds(value, lower, upper float32, colorName, timestamp string) ChartDatapoint {
+	point := NewChartDatapoint(value, colorName, timestamp)
+	point.SetBounds(lower, upper)
+	return point
+}
+
+// NewEnvelopeDatapoint creates a datapoint for a pre-aggregated interval, its average plotted as the
+// series' line with the band between its min and max shaded around it
+func NewEnvelopeDatapoint(min, avg, max float32, colorName, timestamp string) ChartDatapoint {
+	return NewChartDatapointWithBounds(avg, min, max, colorName, timestamp)
+}
+
+// NewChartDatapointWithError creates a datapoint of value ± errorMargin, the margin shaded as a band around the series
+func NewChartDatapointWithError(value, errorMargin float32, colorName, timestamp string) ChartDatapoint {
+	margin := float32(math.Abs(float64(errorMargin)))
+	return NewChartDatapointWithBounds(value, value-margin, value+margin, colorName, timestamp)
+}
+
+// NewXYDatapoint creates a datapoint plotted at a numeric x value, rather than its index, on a chart with an x axis range
+func NewXYDatapoint(x, y float32, colorName, timestamp string) ChartDatapoint {
+	point := NewChartDatapoint(y, colorName, timestamp)
+	point.SetXValue(x)
+	return point
+}
+
+// NewMissingDatapoint creates a placeholder for a sample the source failed to deliver; the series'
+// line is broken around it so the dropout stays visible
+func NewMissingDatapoint(colorName, timestamp string) ChartDatapoint {
+	point := NewChartDatapoint(0, colorName, timestamp)
+	point.SetMissing(true)
+	return point
+}
 func (d *chartDatapoint) Copy() ChartDatapoint {
 	return &chartDatapoint{
 		value:                d.value,
+		lower:                d.lower,
+		upper:                d.upper,
+		bounded:              d.bounded,
+		xValue:               d.xValue,
+		hasXValue:            d.hasXValue,
+		missing:              d.missing,
 		colorName:            strings.Clone(d.colorName),
+		color:                d.color,
 		timestamp:            strings.Clone(d.timestamp),
+		at:                   d.at,
 		externalID:           strings.Clone(d.externalID),
 		markerTopPosition:    &fyne.Position{X: 0, Y: 0},
 		markerBottomPosition: &fyne.Position{X: 0, Y: 0},
@@ -47,6 +116,9 @@ func (d *chartDatapoint) ColorName() string {
 func (d *chartDatapoint) Timestamp() string {
 	return d.timestamp
 }
+func (d *chartDatapoint) Time() (time.Time, bool) {
+	return d.at, !d.at.IsZero()
+}
 func (d *chartDatapoint) ExternalID() string {
 	return d.externalID
 }
@@ -60,6 +132,46 @@ func (d *chartDatapoint) SetMarkerPosition(top *fyne.Position, bottom *fyne.Posi
 func (d *chartDatapoint) SetColorName(n string) {
 	d.colorName = n
 }
+func (d *chartDatapoint) Color() color.Color {
+	return d.color
+}
+func (d *chartDatapoint) SetColor(c color.Color) {
+	d.color = c
+}
 func (d *chartDatapoint) SetTimestamp(t string) {
 	d.timestamp = t
+	d.at, _ = parseTimestamp(t)
+}
+func (d *chartDatapoint) Bounds() (float32, float32, bool) {
+	return d.lower, d.upper, d.bounded
+}
+func (d *chartDatapoint) SetBounds(lower, upper float32) {
+	if lower > upper {
+		lower, upper = upper, lower
+	}
+	d.lower = lower
+	d.upper = upper
+	d.bounded = true
+}
+func (d *chartDatapoint) ClearBounds() {
+	d.lower = 0
+	d.upper = 0
+	d.bounded = false
+}
+func (d *chartDatapoint) XValue() (float32, bool) {
+	return d.xValue, d.hasXValue
+}
+func (d *chartDatapoint) SetXValue(x float32) {
+	d.xValue = x
+	d.hasXValue = true
+}
+func (d *chartDatapoint) ClearXValue() {
+	d.xValue = 0
+	d.hasXValue = false
+}
+func (d *chartDatapoint) IsMissing() bool {
+	return d.missing || math.IsNaN(float64(d.value))
+}
+func (d *chartDatapoint) SetMissing(missing bool) {
+	d.missing = missing
 }
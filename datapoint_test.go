@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/skoona/sknlinechart"
 	"reflect"
+	"testing"
 	"time"
 )
 
@@ -53,5 +54,61 @@ var _ = Describe("Datapoint Operations", func() {
 		Expect(*a).To(Equal(c))
 		Expect(*b).To(Equal(d))
 	})
+	It("should carry optional confidence bounds", func() {
+		point := sknlinechart.NewChartDatapoint(50, theme.ColorYellow, time.Now().Format(time.RFC1123))
+		_, _, ok := point.Bounds()
+		Expect(ok).To(BeFalse())
+
+		bounded := sknlinechart.NewChartDatapointWithBounds(50, 60, 40, theme.ColorYellow, time.Now().Format(time.RFC1123))
+		lower, upper, ok := bounded.Bounds()
+		Expect(ok).To(BeTrue())
+		Expect(lower).To(BeNumerically("==", 40))
+		Expect(upper).To(BeNumerically("==", 60))
+		Expect(bounded.Copy()).To(Equal(bounded))
+
+		bounded.ClearBounds()
+		_, _, ok = bounded.Bounds()
+		Expect(ok).To(BeFalse())
+	})
+	It("should build envelopes from min/avg/max and value ± error", func() {
+		envelope := sknlinechart.NewEnvelopeDatapoint(40, 52, 70, theme.ColorYellow, time.Now().Format(time.RFC1123))
+		lower, upper, ok := envelope.Bounds()
+		Expect(ok).To(BeTrue())
+		Expect([]float32{lower, envelope.Value(), upper}).To(Equal([]float32{40, 52, 70}))
+
+		measured := sknlinechart.NewChartDatapointWithError(50, -2.5, theme.ColorYellow, time.Now().Format(time.RFC1123))
+		lower, upper, ok = measured.Bounds()
+		Expect(ok).To(BeTrue())
+		Expect([]float32{lower, measured.Value(), upper}).To(Equal([]float32{47.5, 50, 52.5}))
+	})
+	It("should read its timestamp once and keep the time", func() {
+		at := time.Date(2024, 3, 1, 12, 30, 15, 0, time.UTC)
+		point := sknlinechart.NewChartDatapoint(10, theme.ColorYellow, at.Format(time.RFC3339))
+		ts, ok := point.Time()
+		Expect(ok).To(BeTrue())
+		Expect(ts.Equal(at)).To(BeTrue())
+
+		point.SetTimestamp(at.Add(time.Minute).Format(time.RFC1123))
+		ts, _ = point.Time()
+		Expect(ts.Equal(at.Add(time.Minute))).To(BeTrue())
+		copied, _ := point.Copy().Time()
+		Expect(copied).To(Equal(ts))
+
+		point.SetTimestamp("not a time")
+		_, ok = point.Time()
+		Expect(ok).To(BeFalse())
+	})
+	It("should be created from a time with its timestamp formatted once", func() {
+		at := time.Date(2024, 3, 1, 12, 30, 15, 0, time.UTC)
+		point := sknlinechart.NewChartDatapointAt(10, theme.ColorYellow, at)
+		Expect(point.Timestamp()).To(Equal(at.Format(time.RFC1123)))
+		ts, ok := point.Time()
+		Expect(ok).To(BeTrue())
+		Expect(ts).To(Equal(at))
+		Expect(testing.AllocsPerRun(100, func() {
+			_, _ = point.Time()
+			_ = point.Timestamp()
+		})).To(BeZero())
+	})
 
 })
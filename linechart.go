@@ -3,10 +3,13 @@ package sknlinechart
 import (
 	"errors"
 	"fmt"
+	"image/color"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"fyne.io/fyne/v2"
@@ -59,6 +62,9 @@ type LineChartSkn struct {
 	widget.BaseWidget       // Inherit from BaseWidget
 	dataSeriesAdded         bool
 	datapointAdded          bool
+	dirtySeries             map[string]int // series changed since their last layout, from the first point changed
+	refining                map[string]int // series loaded progressively, with the points drawn until refined
+	refineTimer             *time.Timer    // the next refinement frame, nil once the refinement is drawn
 	dataPointStrokeSize     float32
 	dataPointXLimit         int
 	dataPointYLimit         float32
@@ -69,6 +75,95 @@ type LineChartSkn struct {
 	enableVertGridLines     bool
 	enableMousePointDisplay bool
 	enableColorLegend       bool
+	enableZoomSelection     bool
+	enableCrosshair         bool
+	enableTouchMode         bool
+	enableHoverHighlight    bool
+	highlightDimOpacity     float32
+	highlightedSeries       string
+	crosshairActive         bool
+	crosshairPosition       fyne.Position
+	crosshairLinked         bool
+	keyboardCursor          bool
+	cursorSeries            string
+	cursorIndex             int
+	linkGroup               *ChartLinkGroup
+	forecasts               map[string][]ChartDatapoint
+	timeBands               []TimeBand
+	thresholds              []thresholdLine
+	regions                 []ReferenceRegion
+	colorRules              map[string]ColorRule
+	seriesGradients         map[string]ColorGradient
+	seriesColors            map[string]color.Color
+	seriesStyles            map[string]SeriesStyle
+	seriesFills             map[string]color.Color
+	popupBuilders           map[string]PopupBuilder
+	insets                  []insetChart
+	ratios                  map[string]ratioSeries
+	derived                 map[string]derivedSeries
+	seriesMetadata          map[string]SeriesMetadata
+	gaps                    map[string][]int
+	pointLimits             map[string]int
+	pointRings              map[string][]*ChartDatapoint // backing storage of each series, see ringAppend
+	timeWindow              time.Duration
+	nonFinitePolicy         NonFiniteValuePolicy
+	maxTextLength           int
+	xAxis                   *xAxisRange
+	enableTimeSpacing       bool
+	yInverted               bool
+	stackingMode            StackingMode
+	lineInterpolation       LineInterpolation
+	stack                   map[string]stackLayer
+	timeSpan                *timeSpan
+	updatedPoints           map[string][]int
+	enableGapMarkers        bool
+	enablePixelSnapping     bool
+	enableDownsampling      bool
+	enableProgressive       bool
+	cvdMode                 CVDMode
+	renderBackend           RenderBackend
+	hoverSnapRadius         float32
+	hoverMode               HoverMode
+	hoverSeries             string         // the series of the datapoint shown by the hover popup
+	hoverIndex              int            // its index within the series
+	hoverPoint              ChartDatapoint // a copy of it, nil while no popup is shown
+	clipboardFormat         string         // empty for defaultClipboardFormat
+	monochrome              bool
+	monochromeRanks         map[string]int // each series' place among the monochrome dash patterns and markers
+	renderQuality           RenderQuality
+	mouseActions            map[desktop.MouseButton]ChartAction
+	contextMenuItems        []*fyne.MenuItem
+	pinnedTooltips          []pinnedTooltip
+	annotations             []Annotation
+	verticalMarkers         []VerticalMarker
+	hiddenSeries            map[string]bool
+	legendPosition          LegendPosition
+	legendValues            []LegendValue
+	enableStatsOverlay      bool
+	enableExportContext     bool
+	exportPrivacy           ExportPrivacy
+	legendBounds            []legendBound
+	enableAnnotationEditing bool
+	draggedAnnotation       int
+	dragInProgress          bool
+	compareRows             []compareRow
+	comparePosition         fyne.Position
+	selectionActive         bool
+	suppressTap             bool
+	selectionStart          fyne.Position
+	selectionEnd            fyne.Position
+	viewport                *ChartViewport
+	relayoutRequired        bool
+	plotMin                 fyne.Position
+	plotMax                 fyne.Position
+	lastUpdated             map[string]time.Time
+	staleThreshold          time.Duration
+	staleWatch              chan struct{}
+	sink                    chan SeriesPoint // drained by the chart while open, see DataSink
+	sinkStop                chan struct{}    // closed to stop draining the sink
+	metrics                 chartMetrics
+	detachedCharts          map[string][]*LineChartSkn
+	dataStoreView           *chartStoreView
 	topLeftLabel            string // The text to display in the widget
 	topCenteredLabel        string
 	topRightLabel           string
@@ -79,53 +174,69 @@ type LineChartSkn struct {
 	bottomRightLabel        string
 	mouseDisplayStr         string
 	mouseDisplayPosition    *fyne.Position
-	mouseDisplayFrameColor  string
+	mouseDisplayFrameColor  color.Color       // nil for the foreground color
+	mouseDisplayContent     fyne.CanvasObject // a series' custom popup, nil for the text popup
 	dataPoints              map[string][]*ChartDatapoint
 	minSize                 fyne.Size
 	mapsLock                sync.RWMutex
 	debugLoggingEnabled     bool
 	logger                  *log.Logger
+	snapshot                *autoSnapshot
+	snapshotRetention       int
+	snapshotLock            sync.Mutex
+	recorder                *chartRecorder
+	recorderLock            sync.Mutex
+	gif                     *gifRecording
+	gifLock                 sync.Mutex
+	preferences             *preferencesBinding
+	preferencesLock         sync.Mutex
+	idleLock                sync.Mutex
+	refreshSuspended        bool
+	refreshPending          bool
+	idleLifecycle           fyne.Lifecycle
+	commandRunning          bool // a command queued with Do is running, guarded by the idleLock
+	remeasurePixels         bool // the next refresh measures the device pixel grid, guarded by the idleLock
+	refreshInterval         time.Duration
+	coalescedRefresh        *time.Timer // a redraw of streamed datapoints waiting out the refresh interval
+	lastStreamRefresh       time.Time
+	commandLock             sync.Mutex
+	commands                []func(api LineChart)
+	commandsDraining        bool
+	translator              atomic.Pointer[func(key string) string]
+	renderer                *lineChartRenderer // the latest created, walked by ExportSVG
 	// Private: Exposed for Testing; DO NOT USE
-	objectsCache         []fyne.CanvasObject
-	OnHoverPointCallback func(series string, dataPoint ChartDatapoint)
+	objectsCache          []fyne.CanvasObject
+	OnHoverPointCallback  func(series string, dataPoint ChartDatapoint)
+	OnMouseButtonCallback func(button desktop.MouseButton, position fyne.Position)
 }
 
 var _ LineChart = (*LineChartSkn)(nil)
 var _ fyne.Widget = (*LineChartSkn)(nil)
 var _ fyne.CanvasObject = (*LineChartSkn)(nil)
+var _ fyne.Draggable = (*LineChartSkn)(nil)
 
 // NewLineChart Create the Line Chart
-// be careful not to exceed the series data point limit, which defaults to 150
 //
-// can return a valid chart object and an error object; errors really should be handled
-// and are caused by data points exceeding the container limit of 150; they will be truncated
+// Deprecated: use New, this alias is kept so existing code continues to compile
 func NewLineChart(topTitle, bottomTitle string, xScaleFactor, yScaleFactor int, dataPoints *map[string][]*ChartDatapoint) (LineChart, error) {
 	return New(topTitle, bottomTitle, xScaleFactor, yScaleFactor, dataPoints)
 }
-func New(topTitle, bottomTitle string, xScaleFactor, yScaleFactor int, dataPoints *map[string][]*ChartDatapoint) (LineChart, error) {
+
+// New Create the Line Chart
+// be careful not to exceed the series data point limit, which defaults to 150; options such as
+// WithXLimit are applied before the datapoints are checked against it
+//
+// can return a valid chart object and an error object; errors really should be handled
+// and are caused by data points exceeding the container limit; they will be truncated
+func New(topTitle, bottomTitle string, xScaleFactor, yScaleFactor int, dataPoints *map[string][]*ChartDatapoint, options ...ChartOption) (LineChart, error) {
 	if dataPoints == nil {
 		return nil, errors.New("dataPoint Params cannot be nil")
 	}
-	err := errors.New("")
-	dpl := 150 // max xScale
-	for key, points := range *dataPoints {
-		cnt := len(points)
-		if cnt > dpl {
-			for len(points) > dpl {
-				points = RemoveIndexFromSlice(0, points)
-			}
-			(*dataPoints)[key] = points
-			err = fmt.Errorf("%s\n::NewLineChart() dataPoint contents exceeds the point count limit[Action: truncated leading]. Series: %s, points: %d, Limit: %d", err.Error(), key, cnt, dpl)
-		}
-	}
-	if len(err.Error()) < 10 {
-		err = nil
-	}
 	w := &LineChartSkn{ // Create this widget with an initial text value
 		dataPoints:              *dataPoints,
 		dataPointStrokeSize:     2.0,
 		dataSeriesAdded:         true,
-		dataPointXLimit:         dpl,
+		dataPointXLimit:         XPointLimit,
 		dataPointYLimit:         float32(yScaleFactor * 13),
 		chartXScaleMultiplier:   xScaleFactor,
 		chartYScaleMultiplier:   yScaleFactor,
@@ -134,9 +245,15 @@ func New(topTitle, bottomTitle string, xScaleFactor, yScaleFactor int, dataPoint
 		enableVertGridLines:     true,
 		enableMousePointDisplay: true,
 		enableColorLegend:       true,
+		enablePixelSnapping:     true,
+		enableDownsampling:      true,
+		enableProgressive:       true,
+		refreshInterval:         DefaultRefreshInterval,
+		maxTextLength:           defaultMaxTextLength,
+		nonFinitePolicy:         NonFiniteGap,
+		enableExportContext:     true,
 		mouseDisplayStr:         "",
 		mouseDisplayPosition:    &fyne.Position{},
-		mouseDisplayFrameColor:  string(theme.ColorNameForeground),
 		topLeftLabel:            "",
 		topCenteredLabel:        topTitle,
 		topRightLabel:           "",
@@ -149,6 +266,34 @@ func New(topTitle, bottomTitle string, xScaleFactor, yScaleFactor int, dataPoint
 		objectsCache:            []fyne.CanvasObject{}, // everything except datapoints, markers, and mousebox
 		mapsLock:                sync.RWMutex{},
 		logger:                  log.New(os.Stdout, "[DEBUG] ", log.Lmicroseconds|log.Lshortfile),
+		snapshotRetention:       defaultAutoSnapshotRetention,
+		hoverSnapRadius:         defaultHoverSnapRadius,
+		mouseActions:            defaultMouseActions(),
+		enableTouchMode:         isMobileDevice(),
+		highlightDimOpacity:     defaultHighlightDimOpacity,
+		draggedAnnotation:       -1,
+	}
+	err := errors.New("")
+	if errOpt := NewChartOptions(options...).Apply(w); errOpt != nil {
+		err = errOpt
+	}
+	dpl := w.dataPointXLimit // max xScale
+	for key, points := range w.dataPoints {
+		cnt := len(points)
+		if cnt > dpl {
+			for len(points) > dpl {
+				points = RemoveIndexFromSlice(0, points)
+			}
+			w.dataPoints[key] = points
+			err = fmt.Errorf("%s\n::NewLineChart() dataPoint contents exceeds the point count limit[Action: truncated leading]. Series: %s, points: %d, Limit: %d", err.Error(), key, cnt, dpl)
+		}
+	}
+	if len(err.Error()) < 10 {
+		err = nil
+	}
+	for key, points := range w.dataPoints {
+		w.dataPoints[key], _ = w.acceptDataSeries(points)
+		w.touchSeries(key)
 	}
 	w.ExtendBaseWidget(w) // Initialize the BaseWidget
 	return w, err
@@ -159,6 +304,9 @@ func (w *LineChartSkn) CreateRenderer() fyne.WidgetRenderer {
 	startTime := time.Now()
 	w.debugLog("LineChartSkn::CreateRenderer()")
 	r := newLineChartRenderer(w)
+	w.mapsLock.Lock()
+	w.renderer = r.(*lineChartRenderer)
+	w.mapsLock.Unlock()
 	w.debugLog("LineChartSkn::CreateRenderer() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 	return r
 }
@@ -167,10 +315,11 @@ func (w *LineChartSkn) SetOnHoverPointCallback(f func(series string, dataPoint C
 	w.OnHoverPointCallback = f
 }
 
-// SetMinSize set the minimum size limit for the linechart
+// SetMinSize set the minimum size limit for the linechart, MinSize grows past it when the labels need more room
 func (w *LineChartSkn) SetMinSize(s fyne.Size) {
 	w.debugLog("LineChartSkn::SetMinSize()")
 	w.minSize = s
+	w.Refresh()
 }
 
 // GetTopLeftLabel return text from top left label
@@ -324,21 +473,36 @@ func (w *LineChartSkn) ApplyDataSeries(seriesName string, newSeries []*ChartData
 		return fmt.Errorf("ApplyDataSeries() no active widget")
 	}
 
-	if len(newSeries) <= w.dataPointXLimit {
+	w.mapsLock.RLock()
+	limit := w.seriesPointLimit(seriesName)
+	w.mapsLock.RUnlock()
+	if len(newSeries) <= limit {
 		w.mapsLock.Lock()
-		w.dataPoints[seriesName] = newSeries
+		accepted, rejected := w.acceptDataSeries(newSeries)
+		w.dataPoints[seriesName] = accepted
+		w.markDirty(seriesName, 0)
+		w.startRefining(seriesName)
+		w.trimAnnotations(seriesName, len(accepted))
+		delete(w.gaps, seriesName)
+		w.touchSeries(seriesName)
 		w.dataSeriesAdded = true
+		expired := w.applyTimeWindow()
 		w.mapsLock.Unlock()
+		w.metrics.ingested(len(accepted))
+		w.metrics.dropped(expired + rejected)
+		w.mirrorDataSeries(seriesName, accepted)
+		w.record(seriesName, true, accepted)
 		w.Refresh()
 	} else {
+		w.metrics.dropped(len(newSeries))
 		w.debugLog("LineChartSkn::ApplyDataSeries() ERROR EXIT")
-		return fmt.Errorf("[%s] data series datapoints limit exceeded. limit:%d, count:%d", seriesName, w.dataPointXLimit, len(newSeries))
+		return fmt.Errorf("[%s] data series datapoints limit exceeded. limit:%d, count:%d", seriesName, limit, len(newSeries))
 	}
 	w.debugLog("LineChartSkn::ApplyDataSeries() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 	return nil
 }
 
-// ApplyDataPoint adds a new datapoint to an existing series
+// ApplyDataPoint adds a new datapoint to an existing series; safe to call from any goroutine, also while the chart draws
 // will shift out the oldest point if containers limit is exceeded
 func (w *LineChartSkn) ApplyDataPoint(seriesName string, newDataPoint *ChartDatapoint) {
 	startTime := time.Now()
@@ -349,31 +513,285 @@ func (w *LineChartSkn) ApplyDataPoint(seriesName string, newDataPoint *ChartData
 	}
 
 	w.mapsLock.Lock()
+	if !w.acceptDatapoint(newDataPoint) {
+		w.mapsLock.Unlock()
+		w.metrics.dropped(1)
+		w.debugLog("LineChartSkn::ApplyDataPoint(rejected) EXIT")
+		return
+	}
+	rolledOff := w.appendDataPoint(seriesName, newDataPoint)
+	rolledOff += w.applyTimeWindow()
+	w.touchSeries(seriesName)
+	w.datapointAdded = true
+	w.mapsLock.Unlock()
+	w.metrics.ingested(1)
+	w.metrics.dropped(rolledOff)
+	w.mirrorDataPoint(seriesName, newDataPoint)
+	w.record(seriesName, false, []*ChartDatapoint{newDataPoint})
+	w.refreshCoalesced()
+	w.debugLog("LineChartSkn::ApplyDataPoint() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
+}
 
-	if len(w.dataPoints[seriesName]) <= w.dataPointXLimit {
-		w.dataPoints[seriesName] = append(w.dataPoints[seriesName], newDataPoint)
-	} else {
-		w.dataPoints[seriesName] = ShiftSlice(newDataPoint, w.dataPoints[seriesName])
+// ApplyDataPoints appends many datapoints to a series, shifting out the oldest points as
+// ApplyDataPoint does, with a single Refresh at the end; use it when backfilling history
+func (w *LineChartSkn) ApplyDataPoints(seriesName string, points []ChartDatapoint) {
+	startTime := time.Now()
+
+	w.debugLog("LineChartSkn::ApplyDataPoints() ENTER")
+	if w == nil || len(points) == 0 {
+		return
 	}
+
+	applied := make([]*ChartDatapoint, 0, len(points))
+	rolledOff := 0
+	w.mapsLock.Lock()
+	for _, point := range points {
+		dp := point
+		if !w.acceptDatapoint(&dp) {
+			rolledOff++
+			continue
+		}
+		applied = append(applied, &dp)
+		rolledOff += w.appendDataPoint(seriesName, &dp)
+	}
+	if len(applied) >= progressiveMinPoints { // backfilling a large history
+		w.startRefining(seriesName)
+	}
+	rolledOff += w.applyTimeWindow()
+	w.touchSeries(seriesName)
 	w.datapointAdded = true
 	w.mapsLock.Unlock()
+	w.metrics.ingested(len(applied))
+	w.metrics.dropped(rolledOff)
+	w.mirrorDataPoints(seriesName, applied)
+	w.record(seriesName, false, applied)
+	w.refreshCoalesced()
+	w.debugLog("LineChartSkn::ApplyDataPoints() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
+}
+
+// appendDataPoint adds the datapoint to the series, returns 1 when the oldest point rolled off
+// caller must hold the mapsLock
+func (w *LineChartSkn) appendDataPoint(seriesName string, newDataPoint *ChartDatapoint) int {
+	rolledOff := 0
+	if count := len(w.dataPoints[seriesName]); count <= w.seriesPointLimit(seriesName) {
+		w.ringAppend(seriesName, newDataPoint, false)
+		w.markDirty(seriesName, count)
+	} else {
+		w.ringAppend(seriesName, newDataPoint, true)
+		w.markDirty(seriesName, 0) // every point moved along
+		rolledOff = 1
+		w.shiftAnnotations(seriesName)
+		w.shiftGaps(seriesName)
+	}
+	w.consumeForecast(seriesName)
+	return rolledOff
+}
+
+// ClearSeries removes all datapoints of the series, with their annotations, pins, gaps, and forecast,
+// keeping the series registered with its legend entry, visibility, color rule, and metadata
+func (w *LineChartSkn) ClearSeries(seriesName string) error {
+	w.debugLog("LineChartSkn::ClearSeries() ENTER")
+	w.mapsLock.Lock()
+	if _, ok := w.dataPoints[seriesName]; !ok {
+		w.mapsLock.Unlock()
+		w.debugLog("LineChartSkn::ClearSeries() ERROR EXIT")
+		return fmt.Errorf("ClearSeries() series not found: %s", seriesName)
+	}
+	w.clearSeries(seriesName)
+	w.mapsLock.Unlock()
 	w.Refresh()
-	w.debugLog("LineChartSkn::ApplyDataPoint() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
+	w.debugLog("LineChartSkn::ClearSeries() EXIT")
+	return nil
+}
+
+// ClearAllData empties every series as ClearSeries does, for dashboard reset buttons
+func (w *LineChartSkn) ClearAllData() {
+	w.debugLog("LineChartSkn::ClearAllData() ENTER")
+	w.mapsLock.Lock()
+	for key := range w.dataPoints {
+		w.clearSeries(key)
+	}
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::ClearAllData() EXIT")
+}
+
+// clearSeries caller must hold the mapsLock
+func (w *LineChartSkn) clearSeries(seriesName string) {
+	w.dataPoints[seriesName] = []*ChartDatapoint{}
+	w.markDirty(seriesName, 0)
+	delete(w.forecasts, seriesName)
+	delete(w.gaps, seriesName)
+	w.trimAnnotations(seriesName, 0)
+	pins := w.pinnedTooltips[:0]
+	for _, pin := range w.pinnedTooltips {
+		if pin.series != seriesName {
+			pins = append(pins, pin)
+		}
+	}
+	w.pinnedTooltips = pins
+	w.relayoutRequired = true
+}
+
+// GetDataSeries returns copies of the datapoints the series currently holds, nil when it does not exist
+func (w *LineChartSkn) GetDataSeries(seriesName string) []ChartDatapoint {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	points, ok := w.dataPoints[seriesName]
+	if !ok {
+		return nil
+	}
+	series := make([]ChartDatapoint, 0, len(points))
+	for _, point := range points {
+		series = append(series, (*point).Copy())
+	}
+	return series
+}
+
+// GetSeriesNames returns the names of the chart's series in sorted order
+func (w *LineChartSkn) GetSeriesNames() []string {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	names := make([]string, 0, len(w.dataPoints))
+	for key := range w.dataPoints {
+		names = append(names, key)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DeleteSeries removes a series, its annotations, pins, forecast, and metadata from the chart;
+// the renderer frees its lines and markers on the following refresh
+func (w *LineChartSkn) DeleteSeries(seriesName string) error {
+	w.debugLog("LineChartSkn::DeleteSeries() ENTER")
+	w.mapsLock.Lock()
+	if _, ok := w.dataPoints[seriesName]; !ok {
+		w.mapsLock.Unlock()
+		w.debugLog("LineChartSkn::DeleteSeries() ERROR EXIT")
+		return fmt.Errorf("DeleteSeries() series not found: %s", seriesName)
+	}
+	w.forgetSeries(seriesName)
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::DeleteSeries() EXIT")
+	return nil
+}
+
+// ReplaceAllDataSeries swaps the chart's whole dataset for newSeries, of any size. Series missing
+// from newSeries are deleted as by DeleteSeries; the others keep their settings, their data is replaced
+// as by ApplyDataSeries. Nothing changes when a series exceeds its point limit
+func (w *LineChartSkn) ReplaceAllDataSeries(newSeries map[string][]*ChartDatapoint) error {
+	w.debugLog("LineChartSkn::ReplaceAllDataSeries() ENTER")
+	w.mapsLock.Lock()
+	for key, points := range newSeries {
+		if limit := w.seriesPointLimit(key); len(points) > limit {
+			w.mapsLock.Unlock()
+			w.metrics.dropped(len(points))
+			w.debugLog("LineChartSkn::ReplaceAllDataSeries() ERROR EXIT")
+			return fmt.Errorf("ReplaceAllDataSeries() [%s] data series datapoints limit exceeded. limit:%d, count:%d", key, limit, len(points))
+		}
+	}
+	accepted := make(map[string][]*ChartDatapoint, len(newSeries))
+	rejected := 0
+	for key, points := range newSeries {
+		points, dropped := w.acceptDataSeries(points)
+		accepted[key] = points
+		rejected += dropped
+	}
+	newSeries = accepted
+	for key := range w.dataPoints {
+		if _, ok := newSeries[key]; !ok {
+			w.forgetSeries(key)
+		}
+	}
+	ingested := 0
+	for key, points := range newSeries {
+		w.dataPoints[key] = points
+		w.trimAnnotations(key, len(points))
+		delete(w.gaps, key)
+		w.touchSeries(key)
+		ingested += len(points)
+	}
+	w.pinnedTooltips = nil
+	expired := w.applyTimeWindow()
+	w.dataSeriesAdded = true
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+
+	w.metrics.ingested(ingested)
+	w.metrics.dropped(expired + rejected)
+	for key, points := range newSeries {
+		w.mirrorDataSeries(key, points)
+		w.record(key, true, points)
+	}
+	w.Refresh()
+	w.debugLog("LineChartSkn::ReplaceAllDataSeries() EXIT")
+	return nil
+}
+
+// forgetSeries removes the series' data and everything attached to it
+// caller must hold the mapsLock
+func (w *LineChartSkn) forgetSeries(seriesName string) {
+	delete(w.dataPoints, seriesName)
+	delete(w.hiddenSeries, seriesName)
+	delete(w.forecasts, seriesName)
+	delete(w.lastUpdated, seriesName)
+	delete(w.detachedCharts, seriesName)
+	delete(w.colorRules, seriesName)
+	delete(w.seriesGradients, seriesName)
+	delete(w.seriesColors, seriesName)
+	delete(w.seriesStyles, seriesName)
+	delete(w.seriesFills, seriesName)
+	delete(w.popupBuilders, seriesName)
+	delete(w.ratios, seriesName)
+	delete(w.derived, seriesName)
+	delete(w.seriesMetadata, seriesName)
+	delete(w.gaps, seriesName)
+	delete(w.pointLimits, seriesName)
+	delete(w.pointRings, seriesName)
+	delete(w.refining, seriesName)
+	w.trimAnnotations(seriesName, 0)
+	pins := w.pinnedTooltips[:0]
+	for _, pin := range w.pinnedTooltips {
+		if pin.series != seriesName {
+			pins = append(pins, pin)
+		}
+	}
+	w.pinnedTooltips = pins
+	if w.highlightedSeries == seriesName {
+		w.highlightedSeries = ""
+	}
 }
 
 // Tapped From the Tappable Interface
-func (w *LineChartSkn) Tapped(*fyne.PointEvent) {
+func (w *LineChartSkn) Tapped(pe *fyne.PointEvent) {
 	w.debugLog("LineChartSkn::Tapped() ENTER")
-	w.enableMousePointDisplay = !w.enableMousePointDisplay
-	w.Refresh()
+	w.localizeEvent(pe)
+	w.requestFocus()
+	if w.suppressTap {
+		w.suppressTap = false
+		w.debugLog("LineChartSkn::Tapped(consumed by mouse down) EXIT")
+		return
+	}
+	if w.toggleLegendAt(pe.Position) {
+		w.debugLog("LineChartSkn::Tapped(legend) EXIT")
+		return
+	}
+	if w.enableTouchMode { // no hover on touch screens, the tap shows the value instead
+		w.tapDatapoint(pe.Position)
+		w.debugLog("LineChartSkn::Tapped(touch) EXIT")
+		return
+	}
+	w.performMouseAction(desktop.MouseButtonPrimary, pe.Position)
 	w.debugLog("LineChartSkn::Tapped() EXIT")
 }
 
 // TappedSecondary From the SecondaryTappable Interface
-func (w *LineChartSkn) TappedSecondary(*fyne.PointEvent) {
+func (w *LineChartSkn) TappedSecondary(pe *fyne.PointEvent) {
 	w.debugLog("LineChartSkn::TappedSecondary() ENTER")
-	w.enableDataPointMarkers = !w.enableDataPointMarkers
-	w.Refresh()
+	w.localizeEvent(pe)
+	w.performMouseAction(desktop.MouseButtonSecondary, pe.Position)
 	w.debugLog("LineChartSkn::TappedSecondary() EXIT")
 }
 
@@ -387,34 +805,37 @@ func (w *LineChartSkn) MouseMoved(me *desktop.MouseEvent) {
 	startTime := time.Now()
 
 	w.debugLog("LineChartSkn::MouseMoved() ENTER")
+	w.localizeEvent(&me.PointEvent)
+	if w.selectionActive {
+		w.selectionEnd = w.clampToPlotArea(me.Position)
+		w.Refresh()
+		w.debugLog("LineChartSkn::MouseMoved(zoom selection) EXIT")
+		return
+	}
+	needsRefresh := w.highlightSeriesAt(me.Position)
+	w.crosshairLinked = false
+	w.keyboardCursor = false
+	w.broadcastCursor(me.Position)
+	if w.enableCrosshair {
+		w.crosshairActive = w.isInsidePlotArea(me.Position)
+		w.crosshairPosition = me.Position
+		needsRefresh = true
+	}
 	if !w.enableMousePointDisplay {
+		if needsRefresh {
+			w.Refresh()
+		}
 		w.debugLog("LineChartSkn::MouseMoved(disabled) EXIT")
 		return
 	}
-	w.mapsLock.Lock()
-	matched := false
-
-found:
-	for key, points := range w.dataPoints {
-		for idx, point := range points {
-			top, bottom := (*point).MarkerPosition()
-			if !me.Position.IsZero() && !top.IsZero() {
-				if me.Position.X > top.X && me.Position.X < bottom.X &&
-					me.Position.Y > top.Y-1 && me.Position.Y < bottom.Y {
-					w.debugLog("MouseMoved() matched Mouse: ", me.Position, ", Top: ", top, ", Bottom: ", bottom)
-					value := fmt.Sprint(key, ", Index: ", idx, ", Value: ", (*point).Value(), "    [", (*point).Timestamp(), "]")
-					w.enableMouseContainer(value, (*point).ColorName(), &me.Position)
-					if w.OnHoverPointCallback != nil {
-						w.OnHoverPointCallback(strings.Clone(key), (*point).Copy())
-					}
-					matched = true
-					break found
-				}
-			}
-		}
+	if w.hoverMode == HoverCompareSeries {
+		w.showDatapointAt(me.Position)
+		w.Refresh()
+		w.debugLog("LineChartSkn::MouseMoved(compare) EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
+		return
 	}
-	w.mapsLock.Unlock()
-	if matched {
+	matched := w.showDatapointAt(me.Position)
+	if matched || needsRefresh {
 		w.Refresh()
 	}
 	w.debugLog("LineChartSkn::MouseMoved() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
@@ -423,18 +844,23 @@ found:
 // MouseOut disable display of mouse data point display
 func (w *LineChartSkn) MouseOut() {
 	w.debugLog("LineChartSkn::MouseOut()")
+	w.crosshairActive = false
+	w.broadcastCursorOut()
+	w.clearHighlight()
 	w.disableMouseContainer()
 }
 
 // enableMouseContainer private method to prepare values need by renderer to create pop display
-// composes display text, captures position and colorName for use by renderer
-func (w *LineChartSkn) enableMouseContainer(value, frameColor string, mousePosition *fyne.Position) *LineChartSkn {
+// composes display text, captures position and frame color for use by renderer
+func (w *LineChartSkn) enableMouseContainer(value string, frameColor color.Color, mousePosition *fyne.Position) *LineChartSkn {
 	startTime := time.Now()
 	w.debugLog("LineChartSkn::enableMouseContainer() ENTER")
 
+	value = w.clipText(value)
 	w.mouseDisplayStr = value
+	w.mouseDisplayContent = nil
 	w.mouseDisplayFrameColor = frameColor
-	ct := canvas.NewText(value, theme.PrimaryColorNamed(frameColor))
+	ct := canvas.NewText(value, frameColor)
 	parts := strings.Split(value, "[")
 	ts := fyne.MeasureText(parts[0], ct.TextSize, ct.TextStyle)
 	mp := &fyne.Position{X: mousePosition.X - (ts.Width / 2), Y: mousePosition.Y - (3 * ts.Height) - theme.Padding()}
@@ -448,7 +874,12 @@ func (w *LineChartSkn) enableMouseContainer(value, frameColor string, mousePosit
 // blank string will prevent display
 func (w *LineChartSkn) disableMouseContainer() {
 	w.debugLog("LineChartSkn::disableMouseContainer()")
+	w.mapsLock.Lock()
 	w.mouseDisplayStr = ""
+	w.mouseDisplayContent = nil
+	w.compareRows = nil
+	w.hoverPoint = nil
+	w.mapsLock.Unlock()
 	w.Refresh()
 }
 
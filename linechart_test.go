@@ -5,7 +5,9 @@ import (
 	"reflect"
 	"time"
 
-	_ "fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/theme"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -87,6 +89,224 @@ var _ = Describe("verify line chart initial state", func() {
 
 })
 
+var _ = Describe("Reading series back out", func() {
+	It("should return copies of the current datapoints after roll-off", func() {
+		lc, _ := makeUI("Testing", "Read", 150)
+		defer stopRedraws(lc)
+		Expect(lc.GetDataSeries("Unknown")).To(BeNil())
+		for i := 0; i < 5; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(i), theme.ColorRed, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("Testing", &point)
+			lc.ApplyDataPoint("Alpha", &point)
+		}
+		Expect(lc.GetSeriesNames()).To(Equal([]string{"Alpha", "Testing"}))
+
+		series := lc.GetDataSeries("Testing")
+		Expect(series).To(HaveLen(151))
+		Expect(series[150].Value()).To(BeNumerically("==", 4))
+		series[150].SetValue(99)
+		Expect(lc.GetDataSeries("Testing")[150].Value()).To(BeNumerically("==", 4))
+	})
+})
+
+var _ = Describe("Deleting a series", func() {
+	It("should remove the data and free its canvas objects", func() {
+		lc, _ := makeUI("Testing", "Delete", 20)
+		var other []*sknlinechart.ChartDatapoint
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(40, theme.ColorRed, time.Now().Format(time.RFC1123))
+			other = append(other, &point)
+		}
+		Expect(lc.ApplyDataSeries("Other", other)).To(Succeed())
+		lc.Resize(fyne.NewSize(800, 400))
+		Expect(lc.AddAnnotation("Other", 2, "remove me")).To(Succeed())
+		renderer := test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn))
+		objects := len(renderer.Objects())
+
+		Expect(lc.DeleteSeries("Unknown")).To(HaveOccurred())
+		Expect(lc.DeleteSeries("Other")).To(Succeed())
+		Expect(renderer.Objects()).To(HaveLen(objects - 20))
+		Expect(lc.State().Series).NotTo(HaveKey("Other"))
+		Expect(lc.GetAnnotations()).To(BeEmpty())
+		_, listed := legendEntry(lc, "Other")
+		Expect(listed).To(BeFalse())
+
+		Expect(lc.ApplyDataSeries("Other", other[:5])).To(Succeed())
+		Expect(renderer.Objects()).To(HaveLen(objects - 10))
+	})
+})
+
+var _ = Describe("Replacing the whole dataset", func() {
+	series := func(count int, color string) []*sknlinechart.ChartDatapoint {
+		var points []*sknlinechart.ChartDatapoint
+		for i := 0; i < count; i++ {
+			point := sknlinechart.NewChartDatapoint(40, color, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		return points
+	}
+
+	It("should swap in a dataset with fewer or more series", func() {
+		lc, _ := makeUI("Testing", "Replace", 20)
+		Expect(lc.ApplyDataSeries("Other", series(10, theme.ColorRed))).To(Succeed())
+		lc.Resize(fyne.NewSize(800, 400))
+		Expect(lc.AddAnnotation("Other", 2, "removed")).To(Succeed())
+		renderer := test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn))
+		objects := len(renderer.Objects())
+
+		Expect(lc.ReplaceAllDataSeries(map[string][]*sknlinechart.ChartDatapoint{"Fresh": series(5, theme.ColorOrange)})).To(Succeed())
+		Expect(lc.GetSeriesNames()).To(Equal([]string{"Fresh"}))
+		Expect(lc.GetAnnotations()).To(BeEmpty())
+		Expect(renderer.Objects()).To(HaveLen(objects - 60 + 10))
+		_, listed := legendEntry(lc, "Testing")
+		Expect(listed).To(BeFalse())
+
+		Expect(lc.ReplaceAllDataSeries(map[string][]*sknlinechart.ChartDatapoint{
+			"A": series(3, theme.ColorRed), "B": series(3, theme.ColorBlue), "C": series(3, theme.ColorPurple),
+		})).To(Succeed())
+		Expect(lc.GetSeriesNames()).To(Equal([]string{"A", "B", "C"}))
+		Expect(renderer.Objects()).To(HaveLen(objects - 60 + 18))
+
+		Expect(lc.ReplaceAllDataSeries(map[string][]*sknlinechart.ChartDatapoint{"Big": series(200, theme.ColorRed)})).To(HaveOccurred())
+		Expect(lc.GetSeriesNames()).To(Equal([]string{"A", "B", "C"}))
+
+		Expect(lc.ReplaceAllDataSeries(nil)).To(Succeed())
+		Expect(lc.GetSeriesNames()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Appending datapoints in a batch", func() {
+	It("should roll off the oldest points and refresh once", func() {
+		lc, _ := makeUI("Testing", "Batch", 20)
+		lc.Resize(fyne.NewSize(800, 400))
+		var batch []sknlinechart.ChartDatapoint
+		for i := 0; i < 140; i++ {
+			batch = append(batch, sknlinechart.NewChartDatapoint(float32(i), theme.ColorRed, time.Now().Format(time.RFC1123)))
+		}
+		before := lc.Metrics()
+
+		lc.ApplyDataPoints("Testing", batch)
+		after := lc.Metrics()
+		Expect(after.Refreshes - before.Refreshes).To(BeNumerically("==", 1))
+		Expect(after.PointsIngested - before.PointsIngested).To(BeNumerically("==", 140))
+		Expect(after.PointsDropped - before.PointsDropped).To(BeNumerically("==", 9))
+
+		points := lc.GetDataSeries("Testing")
+		Expect(points).To(HaveLen(151))
+		Expect(points[150].Value()).To(BeNumerically("==", 139))
+		Expect(points[11].Value()).To(BeNumerically("==", 0))
+	})
+})
+
+var _ = Describe("Clearing series data", func() {
+	It("should empty the datapoints while keeping the series registered", func() {
+		lc, _ := makeUI("Testing", "Clear", 20)
+		lc.Resize(fyne.NewSize(800, 400))
+		Expect(lc.AddAnnotation("Testing", 2, "clear me")).To(Succeed())
+		Expect(lc.HideSeries("Testing")).To(Succeed())
+
+		Expect(lc.ClearSeries("Unknown")).To(HaveOccurred())
+		Expect(lc.ClearSeries("Testing")).To(Succeed())
+		Expect(lc.GetSeriesNames()).To(Equal([]string{"Testing"}))
+		Expect(lc.GetDataSeries("Testing")).To(BeEmpty())
+		Expect(lc.GetAnnotations()).To(BeEmpty())
+		Expect(lc.IsSeriesVisible("Testing")).To(BeFalse())
+		_, listed := legendEntry(lc, "Testing")
+		Expect(listed).To(BeTrue())
+
+		point := sknlinechart.NewChartDatapoint(40, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Testing", &point)
+		Expect(lc.GetDataSeries("Testing")).To(HaveLen(1))
+	})
+	It("should empty every series at once", func() {
+		lc, _ := makeUI("Testing", "Clear", 20)
+		point := sknlinechart.NewChartDatapoint(40, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Other", &point)
+		lc.Resize(fyne.NewSize(800, 400))
+
+		lc.ClearAllData()
+		Expect(lc.GetSeriesNames()).To(Equal([]string{"Other", "Testing"}))
+		Expect(lc.GetDataSeries("Other")).To(BeEmpty())
+		Expect(lc.GetDataSeries("Testing")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Confidence bands", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+	)
+
+	bandRaster := func() *canvas.Raster {
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if r, ok := o.(*canvas.Raster); ok {
+				return r
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 20; i++ {
+			point := sknlinechart.NewChartDatapoint(50, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			if i >= 5 && i < 15 {
+				point.SetBounds(40, 60)
+			}
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should fill between the bounds of neighbouring points only", func() {
+		raster := bandRaster()
+		Expect(raster.Visible()).To(BeTrue())
+		size := raster.Size()
+		img := raster.Generator(int(size.Width), int(size.Height))
+
+		top, _ := (*points[10]).MarkerPosition()
+		x := int(top.X + 2 - raster.Position().X)
+		y := int(top.Y + 2 - raster.Position().Y)
+		_, _, _, a := img.At(x, y).RGBA()
+		Expect(a).To(BeNumerically(">", 0))
+		_, _, _, a = img.At(x, y-int(size.Height/4)).RGBA()
+		Expect(a).To(BeZero())
+
+		outside, _ := (*points[2]).MarkerPosition()
+		_, _, _, a = img.At(int(outside.X+2-raster.Position().X), y).RGBA()
+		Expect(a).To(BeZero())
+	})
+	It("should show the envelope's range in readouts", func() {
+		skn := lc.(*sknlinechart.LineChartSkn)
+		skn.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft}) // the latest sample, without bounds
+		Expect(visibleText(lc, "Testing Value: 50")).NotTo(BeNil())
+		for i := 0; i < 5; i++ {
+			skn.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft})
+		}
+		Expect(visibleText(lc, "Testing Value: 50 (40–60)")).NotTo(BeNil())
+	})
+	It("should keep the bounds in saved state", func() {
+		series := lc.State().Series["Testing"]
+		Expect(series[4].Lower).To(BeNil())
+		Expect(*series[5].Lower).To(BeNumerically("==", 40))
+		Expect(*series[5].Upper).To(BeNumerically("==", 60))
+	})
+	It("should hide the band when no point has bounds", func() {
+		plain, _ := makeUI("Testing", "Bands", 20)
+		lc = plain
+		lc.Resize(fyne.NewSize(800, 400))
+		Expect(bandRaster().Visible()).To(BeFalse())
+	})
+})
+
+// stopRedraws destroys the chart's renderer, dropping any redraw still waiting on the refresh interval,
+// so no timer outlives the spec which streamed the points
+func stopRedraws(lc sknlinechart.LineChart) {
+	test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Destroy()
+}
+
 func makeUI(title, footer string, points int) (sknlinechart.LineChart, error) {
 	var dataPoints = map[string][]*sknlinechart.ChartDatapoint{} // legend, points
 	if points != 0 {
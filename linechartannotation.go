@@ -0,0 +1,283 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.DoubleTappable = (*LineChartSkn)(nil)
+
+// annotationFlagHeight pixel distance between a datapoint and its annotation label
+const annotationFlagHeight float32 = 18
+
+// Annotation text note attached to a series datapoint
+type Annotation struct {
+	Series string `json:"series"`
+	Index  int    `json:"index"`
+	Text   string `json:"text"`
+}
+
+// AddAnnotation attaches text to the series datapoint at index, replacing any note already there
+func (w *LineChartSkn) AddAnnotation(seriesName string, index int, text string) error {
+	w.debugLog("LineChartSkn::AddAnnotation() ENTER")
+	if strings.TrimSpace(text) == "" {
+		w.debugLog("LineChartSkn::AddAnnotation() ERROR EXIT")
+		return fmt.Errorf("AddAnnotation() [%s] annotation text is empty", seriesName)
+	}
+	w.mapsLock.Lock()
+	err := w.validAnnotationPoint(seriesName, index)
+	if err == nil {
+		w.setAnnotation(Annotation{Series: seriesName, Index: index, Text: text})
+	}
+	w.mapsLock.Unlock()
+	if err != nil {
+		w.debugLog("LineChartSkn::AddAnnotation() ERROR EXIT")
+		return err
+	}
+	w.Refresh()
+	w.debugLog("LineChartSkn::AddAnnotation() EXIT")
+	return nil
+}
+
+// RemoveAnnotation deletes the note on the series datapoint at index, returns false when none exists
+func (w *LineChartSkn) RemoveAnnotation(seriesName string, index int) bool {
+	w.debugLog("LineChartSkn::RemoveAnnotation()")
+	w.mapsLock.Lock()
+	removed := w.removeAnnotation(seriesName, index)
+	w.mapsLock.Unlock()
+	if removed {
+		w.Refresh()
+	}
+	return removed
+}
+
+// GetAnnotations returns a copy of the chart's annotations
+func (w *LineChartSkn) GetAnnotations() []Annotation {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return append([]Annotation(nil), w.annotations...)
+}
+
+// IsAnnotationEditingEnabled returns true when annotations can be edited with the mouse
+func (w *LineChartSkn) IsAnnotationEditingEnabled() bool {
+	return w.enableAnnotationEditing
+}
+
+// SetAnnotationEditing enables double-click on a datapoint or label to add or edit an annotation,
+// and dragging a label to move it along its series
+func (w *LineChartSkn) SetAnnotationEditing(enable bool) {
+	w.enableAnnotationEditing = enable
+}
+
+// DoubleTapped opens the annotation editor for the label or datapoint under the pointer
+func (w *LineChartSkn) DoubleTapped(pe *fyne.PointEvent) {
+	w.debugLog("LineChartSkn::DoubleTapped() ENTER")
+	w.localizeEvent(pe)
+	if !w.enableAnnotationEditing {
+		w.debugLog("LineChartSkn::DoubleTapped(disabled) EXIT")
+		return
+	}
+	w.mapsLock.RLock()
+	var target Annotation
+	at, found := w.annotationAt(pe.Position)
+	if found {
+		target = w.annotations[at]
+	} else {
+		key, idx, _, matched := w.nearestDatapoint(pe.Position)
+		target, found = Annotation{Series: key, Index: idx}, matched
+		for _, a := range w.annotations {
+			if a.Series == key && a.Index == idx {
+				target = a
+			}
+		}
+	}
+	w.mapsLock.RUnlock()
+	if found {
+		w.showAnnotationEditor(target)
+	}
+	w.debugLog("LineChartSkn::DoubleTapped() EXIT")
+}
+
+// showAnnotationEditor prompts for the annotation text; saving empty text removes the annotation
+func (w *LineChartSkn) showAnnotationEditor(a Annotation) {
+	win := w.parentWindow()
+	if win == nil {
+		return
+	}
+	entry := widget.NewEntry()
+	entry.SetText(a.Text)
+	items := []*widget.FormItem{widget.NewFormItem(fmt.Sprint(a.Series, ", ", w.Message(MessageIndex), ": ", a.Index), entry)}
+	dialog.ShowForm(w.Message(MessageAnnotation), w.Message(MessageSave), w.Message(MessageCancel), items, func(save bool) {
+		if !save {
+			return
+		}
+		if strings.TrimSpace(entry.Text) == "" {
+			w.RemoveAnnotation(a.Series, a.Index)
+			return
+		}
+		err := w.AddAnnotation(a.Series, a.Index, entry.Text)
+		if err != nil {
+			dialog.ShowError(err, win)
+		}
+	}, win)
+}
+
+// beginAnnotationDrag starts moving the annotation whose label is under pos, returns false when none
+func (w *LineChartSkn) beginAnnotationDrag(pos fyne.Position) bool {
+	if !w.enableAnnotationEditing {
+		return false
+	}
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	at, found := w.annotationAt(pos)
+	if found {
+		w.draggedAnnotation = at
+	}
+	return found
+}
+
+// dragAnnotation moves the dragged annotation to its series datapoint nearest pos along the x axis
+func (w *LineChartSkn) dragAnnotation(pos fyne.Position) bool {
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	if w.draggedAnnotation < 0 || w.draggedAnnotation >= len(w.annotations) {
+		return false
+	}
+	a := w.annotations[w.draggedAnnotation]
+	x, _ := w.positionToData(w.clampToPlotArea(pos))
+	idx := int(math.Round(float64(x)))
+	if last := len(w.dataPoints[a.Series]) - 1; idx > last {
+		idx = last
+	}
+	if idx < 0 || idx == a.Index {
+		return false
+	}
+	for _, other := range w.annotations {
+		if other.Series == a.Series && other.Index == idx {
+			return false // occupied, keep the label where it is
+		}
+	}
+	w.annotations[w.draggedAnnotation].Index = idx
+	return true
+}
+
+// validAnnotationPoint caller must hold the mapsLock
+func (w *LineChartSkn) validAnnotationPoint(seriesName string, index int) error {
+	points, ok := w.dataPoints[seriesName]
+	if !ok {
+		return fmt.Errorf("AddAnnotation() series not found: %s", seriesName)
+	}
+	if index < 0 || index >= len(points) {
+		return fmt.Errorf("AddAnnotation() [%s] index out of range. index:%d, count:%d", seriesName, index, len(points))
+	}
+	return nil
+}
+
+// setAnnotation caller must hold the mapsLock
+func (w *LineChartSkn) setAnnotation(a Annotation) {
+	for idx, existing := range w.annotations {
+		if existing.Series == a.Series && existing.Index == a.Index {
+			w.annotations[idx].Text = a.Text
+			return
+		}
+	}
+	w.annotations = append(w.annotations, a)
+}
+
+// removeAnnotation caller must hold the mapsLock
+func (w *LineChartSkn) removeAnnotation(seriesName string, index int) bool {
+	for idx, a := range w.annotations {
+		if a.Series == seriesName && a.Index == index {
+			w.annotations = append(w.annotations[:idx], w.annotations[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// shiftAnnotations follows a series whose oldest datapoint rolled off, dropping notes on that point
+// caller must hold the mapsLock
+func (w *LineChartSkn) shiftAnnotations(seriesName string) {
+	kept := w.annotations[:0]
+	for _, a := range w.annotations {
+		if a.Series == seriesName {
+			a.Index--
+			if a.Index < 0 {
+				continue
+			}
+		}
+		kept = append(kept, a)
+	}
+	w.annotations = kept
+}
+
+// trimAnnotations drops notes beyond the end of a replaced series
+// caller must hold the mapsLock
+func (w *LineChartSkn) trimAnnotations(seriesName string, count int) {
+	kept := w.annotations[:0]
+	for _, a := range w.annotations {
+		if a.Series != seriesName || a.Index < count {
+			kept = append(kept, a)
+		}
+	}
+	w.annotations = kept
+}
+
+// annotationsAt returns the notes for one index of every series joined as "series: text"
+// caller must hold the mapsLock
+func (w *LineChartSkn) annotationsAt(index int, names []string) string {
+	var notes []string
+	for _, name := range names {
+		for _, a := range w.annotations {
+			if a.Series == name && a.Index == index {
+				notes = append(notes, a.Series+": "+a.Text)
+			}
+		}
+	}
+	return strings.Join(notes, "; ")
+}
+
+// annotationLabel returns the label position and size above the annotated datapoint,
+// false when the datapoint is not on screen
+// caller must hold the mapsLock
+func (w *LineChartSkn) annotationLabel(a Annotation) (fyne.Position, fyne.Size, bool) {
+	points := w.dataPoints[a.Series]
+	if a.Index >= len(points) || !w.isIndexVisible(a.Index) {
+		return fyne.Position{}, fyne.Size{}, false
+	}
+	top, bottom := (*points[a.Index]).MarkerPosition()
+	if top.IsZero() {
+		return fyne.Position{}, fyne.Size{}, false
+	}
+	ts := fyne.MeasureText(a.Text, theme.CaptionTextSize(), fyne.TextStyle{})
+	size := fyne.NewSize(ts.Width+theme.Padding()*2, ts.Height+theme.Padding())
+	pos := fyne.NewPos((top.X+bottom.X)/2-size.Width/2, top.Y-annotationFlagHeight-size.Height)
+	if width := w.Size().Width; pos.X+size.Width > width {
+		pos.X = width - size.Width
+	}
+	if pos.X < 0 {
+		pos.X = 0
+	}
+	if pos.Y < 0 {
+		pos.Y = 0
+	}
+	return pos, size, true
+}
+
+// annotationAt returns the position in the annotations slice of the label containing pos
+// caller must hold the mapsLock
+func (w *LineChartSkn) annotationAt(pos fyne.Position) (int, bool) {
+	for idx := len(w.annotations) - 1; idx >= 0; idx-- { // topmost label first
+		p, s, ok := w.annotationLabel(w.annotations[idx])
+		if ok && pos.X >= p.X && pos.X <= p.X+s.Width && pos.Y >= p.Y && pos.Y <= p.Y+s.Height {
+			return idx, true
+		}
+	}
+	return -1, false
+}
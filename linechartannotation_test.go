@@ -0,0 +1,144 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+// visibleText returns the first visible canvas.Text drawn by the chart with the given content
+func visibleText(lc sknlinechart.LineChart, content string) *canvas.Text {
+	var found *canvas.Text
+	var walk func(objs []fyne.CanvasObject)
+	walk = func(objs []fyne.CanvasObject) {
+		for _, o := range objs {
+			switch v := o.(type) {
+			case *fyne.Container:
+				if v.Visible() {
+					walk(v.Objects)
+				}
+			case *canvas.Text:
+				if found == nil && v.Visible() && v.Text == content {
+					found = v
+				}
+			}
+		}
+	}
+	walk(test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects())
+	return found
+}
+
+var _ = Describe("Chart annotations", func() {
+	var (
+		lc     sknlinechart.LineChart
+		skn    *sknlinechart.LineChartSkn
+		points []*sknlinechart.ChartDatapoint
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 20; i++ {
+			point := sknlinechart.NewChartDatapoint(50, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points}),
+			sknlinechart.WithAnnotationEditing(true)))
+		lc.Resize(fyne.NewSize(800, 400))
+		skn = lc.(*sknlinechart.LineChartSkn)
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+	})
+
+	It("should validate, replace, and remove annotations", func() {
+		Expect(lc.AddAnnotation("Unknown", 1, "deploy")).To(HaveOccurred())
+		Expect(lc.AddAnnotation("Testing", 20, "deploy")).To(HaveOccurred())
+		Expect(lc.AddAnnotation("Testing", 1, " ")).To(HaveOccurred())
+
+		Expect(lc.AddAnnotation("Testing", 1, "deploy")).To(Succeed())
+		Expect(lc.AddAnnotation("Testing", 1, "rollback")).To(Succeed())
+		Expect(lc.GetAnnotations()).To(Equal([]sknlinechart.Annotation{{Series: "Testing", Index: 1, Text: "rollback"}}))
+		Expect(visibleText(lc, "rollback")).NotTo(BeNil())
+
+		Expect(lc.RemoveAnnotation("Testing", 1)).To(BeTrue())
+		Expect(lc.RemoveAnnotation("Testing", 1)).To(BeFalse())
+		Expect(visibleText(lc, "rollback")).To(BeNil())
+	})
+	It("should follow its datapoint as the series rolls off", func() {
+		Expect(lc.AddAnnotation("Testing", 0, "first")).To(Succeed())
+		Expect(lc.AddAnnotation("Testing", 5, "sixth")).To(Succeed())
+		for i := 0; i < 132; i++ {
+			point := sknlinechart.NewChartDatapoint(60, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("Testing", &point)
+		}
+		Expect(lc.GetAnnotations()).To(Equal([]sknlinechart.Annotation{{Series: "Testing", Index: 4, Text: "sixth"}}))
+	})
+	It("should persist annotations in state and csv exports", func() {
+		Expect(lc.AddAnnotation("Testing", 3, "alarm")).To(Succeed())
+
+		var state bytes.Buffer
+		Expect(lc.SaveState(&state)).To(Succeed())
+		restored, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		Expect(restored.LoadState(&state)).To(Succeed())
+		Expect(restored.GetAnnotations()).To(Equal(lc.GetAnnotations()))
+
+		dir := GinkgoT().TempDir()
+		Expect(lc.EnableAutoSnapshot(5*time.Millisecond, dir, sknlinechart.SnapshotCSV)).To(Succeed())
+		defer lc.DisableAutoSnapshot()
+		var files []string
+		Eventually(func() int {
+			files, _ = filepath.Glob(filepath.Join(dir, "*.csv"))
+			return len(files)
+		}).Should(BeNumerically(">", 0))
+		content, err := os.ReadFile(files[0])
+		Expect(err).NotTo(HaveOccurred())
+		lines := strings.Split(string(content), "\n")
+		Expect(lines[0]).To(Equal("index,timestamp,Testing,annotations"))
+		Expect(lines[4]).To(HaveSuffix(",Testing: alarm"))
+	})
+	It("should move a label dragged along its series", func() {
+		Expect(lc.AddAnnotation("Testing", 3, "drag me")).To(Succeed())
+		label := visibleText(lc, "drag me")
+		Expect(label).NotTo(BeNil())
+
+		down := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary}
+		down.Position = label.Position().AddXY(2, 2)
+		skn.MouseDown(down)
+		top, bottom := (*points[10]).MarkerPosition()
+		target := fyne.NewPos((top.X+bottom.X)/2, down.Position.Y)
+		skn.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: target}, Dragged: fyne.NewDelta(target.X-down.Position.X, 0)})
+		skn.MouseUp(down)
+		skn.DragEnd()
+
+		Expect(lc.GetAnnotations()[0].Index).To(Equal(10))
+		Expect(lc.IsZoomed()).To(BeFalse())
+	})
+	It("should open the editor on double-click of a datapoint", func() {
+		win := test.NewWindow(lc)
+		defer win.Close()
+		win.Resize(fyne.NewSize(800, 400))
+		top, bottom := (*points[7]).MarkerPosition()
+		skn.DoubleTapped(&fyne.PointEvent{Position: fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)})
+		Expect(win.Canvas().Overlays().Top()).NotTo(BeNil())
+	})
+	It("should ignore double-click when editing is disabled", func() {
+		lc.SetAnnotationEditing(false)
+		win := test.NewWindow(lc)
+		defer win.Close()
+		top, bottom := (*points[7]).MarkerPosition()
+		skn.DoubleTapped(&fyne.PointEvent{Position: fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)})
+		Expect(win.Canvas().Overlays().Top()).To(BeNil())
+	})
+})
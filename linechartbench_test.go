@@ -0,0 +1,73 @@
+package sknlinechart_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/skoona/sknlinechart"
+)
+
+// benchChart returns a chart shown in a test window holding 100 series of points, each full when
+// full is true, drawing every point appended at once
+func benchChart(b *testing.B, full bool) sknlinechart.LineChart {
+	test.NewApp()
+	lc, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithRefreshInterval(0)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	w := test.NewWindow(lc)
+	b.Cleanup(w.Close)
+	w.Resize(fyne.NewSize(800, 400))
+	count := 100
+	if full {
+		count = 151
+	}
+	for s := 0; s < 100; s++ {
+		var points []sknlinechart.ChartDatapoint
+		for i := 0; i < count; i++ {
+			points = append(points, sknlinechart.NewChartDatapoint(float32(i%100), theme.ColorOrange, time.Now().Format(time.RFC1123)))
+		}
+		lc.ApplyDataPoints(fmt.Sprint("Series ", s), points)
+	}
+	return lc
+}
+
+// BenchmarkApplyDataPointAppend appends to one of 100 series not yet full, positioning the new segment alone
+func BenchmarkApplyDataPointAppend(b *testing.B) {
+	lc := benchChart(b, false)
+	point := sknlinechart.NewChartDatapoint(50, theme.ColorOrange, time.Now().Format(time.RFC1123))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if i%50 == 0 { // keep the series short of full
+			b.StopTimer()
+			lc.ApplyDataSeries("Series 0", nil)
+			b.StartTimer()
+		}
+		p := point.Copy()
+		lc.ApplyDataPoint("Series 0", &p)
+	}
+}
+
+// BenchmarkApplyDataPointRolling appends to one of 100 full series, rolling its oldest point off
+func BenchmarkApplyDataPointRolling(b *testing.B) {
+	lc := benchChart(b, true)
+	point := sknlinechart.NewChartDatapoint(50, theme.ColorOrange, time.Now().Format(time.RFC1123))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p := point.Copy()
+		lc.ApplyDataPoint("Series 0", &p)
+	}
+}
+
+// BenchmarkRelayout lays out all 100 full series again, the cost appends avoid
+func BenchmarkRelayout(b *testing.B) {
+	lc := benchChart(b, true)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lc.ResetZoom()
+	}
+}
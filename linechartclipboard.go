@@ -0,0 +1,89 @@
+package sknlinechart
+
+import (
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// defaultClipboardFormat the text copied for the hovered datapoint unless SetClipboardFormat changes it
+const defaultClipboardFormat = "{series},{timestamp},{value}"
+
+// SetClipboardFormat sets the text CopyHoveredDatapoint places on the clipboard, where {series},
+// {timestamp}, {value} and {index} are replaced by the hovered datapoint's; empty restores
+// the default "{series},{timestamp},{value}"
+func (w *LineChartSkn) SetClipboardFormat(format string) {
+	w.mapsLock.Lock()
+	w.clipboardFormat = format
+	w.mapsLock.Unlock()
+}
+
+// GetClipboardFormat returns the text copied for the hovered datapoint, before its placeholders are replaced
+func (w *LineChartSkn) GetClipboardFormat() string {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	if w.clipboardFormat == "" {
+		return defaultClipboardFormat
+	}
+	return w.clipboardFormat
+}
+
+// CopyHoveredDatapoint places the datapoint shown by the hover popup on the window's clipboard,
+// formatted as set by SetClipboardFormat. False when no popup is shown or the chart is not in a window.
+// Ctrl+C, or Cmd+C, does the same while the chart has focus
+func (w *LineChartSkn) CopyHoveredDatapoint() bool {
+	w.debugLog("LineChartSkn::CopyHoveredDatapoint() ENTER")
+	text, ok := w.hoveredDatapointText()
+	if !ok {
+		w.debugLog("LineChartSkn::CopyHoveredDatapoint(nothing hovered) EXIT")
+		return false
+	}
+	ok = w.copyToClipboard(text)
+	w.debugLog("LineChartSkn::CopyHoveredDatapoint() EXIT")
+	return ok
+}
+
+// TypedShortcut From the Shortcutable Interface, copies the hovered datapoint on the copy shortcut
+func (w *LineChartSkn) TypedShortcut(shortcut fyne.Shortcut) {
+	w.debugLog("LineChartSkn::TypedShortcut()")
+	if _, ok := shortcut.(*fyne.ShortcutCopy); ok {
+		w.CopyHoveredDatapoint()
+	}
+}
+
+// hoveredDatapointText returns the clipboard text of the datapoint shown by the hover popup, false when none is shown
+func (w *LineChartSkn) hoveredDatapointText() (string, bool) {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	if w.hoverPoint == nil || w.mouseDisplayStr == "" {
+		return "", false
+	}
+	value := "NaN"
+	if !w.hoverPoint.IsMissing() {
+		value = strconv.FormatFloat(float64(w.hoverPoint.Value()), 'f', -1, 32)
+	}
+	format := w.clipboardFormat
+	if format == "" {
+		format = defaultClipboardFormat
+	}
+	return strings.NewReplacer(
+		"{series}", w.hoverSeries,
+		"{timestamp}", w.hoverPoint.Timestamp(),
+		"{value}", value,
+		"{index}", strconv.Itoa(w.hoverIndex),
+	).Replace(format), true
+}
+
+// copyToClipboard places the text on the clipboard of the window showing the chart, false when not shown
+func (w *LineChartSkn) copyToClipboard(text string) bool {
+	if fyne.CurrentApp() == nil {
+		return false
+	}
+	win := w.parentWindow()
+	if win == nil {
+		return false
+	}
+	win.Clipboard().SetContent(text)
+	return true
+}
@@ -0,0 +1,65 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Copying the hovered datapoint", func() {
+	var (
+		lc     *sknlinechart.LineChartSkn
+		win    fyne.Window
+		points []*sknlinechart.ChartDatapoint
+	)
+
+	hover := func(idx int) {
+		top, bottom := (*points[idx]).MarkerPosition()
+		over := &desktop.MouseEvent{}
+		over.Position = fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)
+		lc.MouseMoved(over)
+	}
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(10*i)+0.5, theme.ColorBlue, "Mon, 12 Oct 2026 10:00:00 UTC")
+			points = append(points, &point)
+		}
+		chart, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"CPU": points})))
+		lc = chart.(*sknlinechart.LineChartSkn)
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+		win.Clipboard().SetContent("")
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should copy series, timestamp and value while the popup is shown", func() {
+		Expect(lc.CopyHoveredDatapoint()).To(BeFalse())
+		hover(5)
+		Expect(lc.CopyHoveredDatapoint()).To(BeTrue())
+		Expect(win.Clipboard().Content()).To(Equal("CPU,Mon, 12 Oct 2026 10:00:00 UTC,50.5"))
+
+		lc.MouseOut()
+		win.Clipboard().SetContent("")
+		Expect(lc.CopyHoveredDatapoint()).To(BeFalse())
+		Expect(win.Clipboard().Content()).To(BeEmpty())
+	})
+	It("should copy on the copy shortcut using the clipboard format", func() {
+		Expect(lc.GetClipboardFormat()).To(Equal("{series},{timestamp},{value}"))
+		lc.SetClipboardFormat("{series}[{index}] = {value}")
+		hover(3)
+		lc.TypedShortcut(&fyne.ShortcutCopy{})
+		Expect(win.Clipboard().Content()).To(Equal("CPU[3] = 30.5"))
+
+		lc.SetClipboardFormat("")
+		Expect(lc.GetClipboardFormat()).To(Equal("{series},{timestamp},{value}"))
+	})
+})
@@ -0,0 +1,71 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultRefreshInterval the least time between redraws caused by datapoints streaming in
+const DefaultRefreshInterval = 100 * time.Millisecond
+
+// SetRefreshInterval limits the redraws caused by incoming datapoints to one per interval, so 20
+// series updated at 1 Hz redraw once rather than 20 times. The first point after a quiet
+// interval redraws at once, those following it are coalesced into a single redraw at the
+// interval's end. Zero redraws on every point; DefaultRefreshInterval applies unless changed
+func (w *LineChartSkn) SetRefreshInterval(interval time.Duration) error {
+	w.debugLog("LineChartSkn::SetRefreshInterval() ENTER")
+	if interval < 0 {
+		w.debugLog("LineChartSkn::SetRefreshInterval() ERROR EXIT")
+		return fmt.Errorf("SetRefreshInterval() interval must not be negative: %v", interval)
+	}
+	w.idleLock.Lock()
+	w.refreshInterval = interval
+	w.idleLock.Unlock()
+	w.debugLog("LineChartSkn::SetRefreshInterval() EXIT")
+	return nil
+}
+
+// GetRefreshInterval returns the least time between redraws caused by incoming datapoints
+func (w *LineChartSkn) GetRefreshInterval() time.Duration {
+	w.idleLock.Lock()
+	defer w.idleLock.Unlock()
+	return w.refreshInterval
+}
+
+// refreshCoalesced redraws for newly ingested datapoints, at once when the last such redraw is
+// an interval old, otherwise once the interval ends joined by any other points arriving meanwhile
+func (w *LineChartSkn) refreshCoalesced() {
+	w.idleLock.Lock()
+	if w.coalescedRefresh != nil { // a redraw is already due, it will show these points too
+		w.idleLock.Unlock()
+		return
+	}
+	wait := w.refreshInterval - time.Since(w.lastStreamRefresh)
+	if w.refreshInterval <= 0 || wait <= 0 {
+		w.lastStreamRefresh = time.Now()
+		w.idleLock.Unlock()
+		w.refreshData()
+		return
+	}
+	w.coalescedRefresh = time.AfterFunc(wait, w.flushCoalesced)
+	w.idleLock.Unlock()
+}
+
+// flushCoalesced performs the redraw coalesced at the end of the interval
+func (w *LineChartSkn) flushCoalesced() {
+	w.idleLock.Lock()
+	w.coalescedRefresh = nil
+	w.lastStreamRefresh = time.Now()
+	w.idleLock.Unlock()
+	w.refreshData()
+}
+
+// cancelCoalesced drops a coalesced redraw made redundant by one happening now
+// caller must hold the idleLock
+func (w *LineChartSkn) cancelCoalesced() {
+	if w.coalescedRefresh != nil {
+		w.coalescedRefresh.Stop()
+		w.coalescedRefresh = nil
+		w.lastStreamRefresh = time.Now()
+	}
+}
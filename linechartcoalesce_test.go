@@ -0,0 +1,69 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Coalesced refreshes", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	refreshes := func() uint64 {
+		return lc.Metrics().Refreshes
+	}
+	// burst applies one point to each of 20 series, as 20 sensors reporting together
+	burst := func() {
+		for i := 0; i < 20; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(i), theme.ColorOrange, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint(string(rune('A'+i)), &point)
+		}
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+		win.Close()
+	})
+
+	It("should redraw a burst of points at once and again when the interval ends", func() {
+		Expect(lc.GetRefreshInterval()).To(Equal(sknlinechart.DefaultRefreshInterval))
+		before := refreshes()
+		burst()
+		Expect(refreshes() - before).To(BeNumerically("==", 1))
+		Eventually(refreshes).WithTimeout(time.Second).Should(BeNumerically("==", before+2))
+		Consistently(refreshes).WithTimeout(2 * sknlinechart.DefaultRefreshInterval).Should(BeNumerically("==", before+2))
+		Expect(lc.GetDataSeries("T")).To(HaveLen(1))
+	})
+	It("should redraw on every point once the interval is zero", func() {
+		Expect(lc.SetRefreshInterval(0)).To(Succeed())
+		before := refreshes()
+		burst()
+		Expect(refreshes() - before).To(BeNumerically("==", 20))
+	})
+	It("should fold a waiting redraw into one happening sooner", func() {
+		burst()
+		before := refreshes()
+		lc.SetTitle("Sensors")
+		lc.Refresh()
+		Consistently(refreshes).WithTimeout(2 * sknlinechart.DefaultRefreshInterval).Should(BeNumerically("==", before+1))
+	})
+	It("should reject negative intervals", func() {
+		Expect(lc.SetRefreshInterval(-time.Second)).To(HaveOccurred())
+		Expect(lc.GetRefreshInterval()).To(Equal(sknlinechart.DefaultRefreshInterval))
+		_, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithRefreshInterval(-1)))
+		Expect(err).To(HaveOccurred())
+	})
+})
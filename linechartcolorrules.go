@@ -0,0 +1,73 @@
+package sknlinechart
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+// ColorRule returns the theme color name for a datapoint value, empty keeps the point's own color
+type ColorRule func(value float64) string
+
+// ThresholdColorRule colors values green below amber, orange from amber, and red from red upward
+func ThresholdColorRule(amber, red float64) ColorRule {
+	return func(value float64) string {
+		switch {
+		case value >= red:
+			return theme.ColorRed
+		case value >= amber:
+			return theme.ColorOrange
+		default:
+			return theme.ColorGreen
+		}
+	}
+}
+
+// SetSeriesColorRule colors each point of the series, and the segment leading to it, by its value
+// so threshold breaches show directly in the trace; nil restores the points' own colors
+func (w *LineChartSkn) SetSeriesColorRule(seriesName string, rule ColorRule) {
+	w.debugLog("LineChartSkn::SetSeriesColorRule()")
+	w.mapsLock.Lock()
+	if rule == nil {
+		delete(w.colorRules, seriesName)
+	} else {
+		if w.colorRules == nil {
+			w.colorRules = map[string]ColorRule{}
+		}
+		w.colorRules[seriesName] = rule
+	}
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// pointColor returns the color the point is drawn with, as seen under the color-vision deficiency simulation when set,
+// black in monochrome mode
+// caller must hold the mapsLock
+func (w *LineChartSkn) pointColor(seriesName string, point *ChartDatapoint) color.Color {
+	if w.monochrome {
+		return monochromeInk
+	}
+	return w.cvdColor(w.chosenPointColor(seriesName, point))
+}
+
+// chosenPointColor returns the color chosen for the point: the one chosen by its series' color rule,
+// else its series' value gradient, the point's own color, the series color, and last the point's theme color name
+// caller must hold the mapsLock
+func (w *LineChartSkn) chosenPointColor(seriesName string, point *ChartDatapoint) color.Color {
+	if rule, ok := w.colorRules[seriesName]; ok {
+		if name := rule(float64((*point).Value())); name != "" {
+			return theme.PrimaryColorNamed(name)
+		}
+	}
+	if gradient, ok := w.seriesGradients[seriesName]; ok {
+		return gradient.at((*point).Value())
+	}
+	if c := (*point).Color(); c != nil {
+		return c
+	}
+	if c, ok := w.seriesColors[seriesName]; ok {
+		return c
+	}
+	return theme.PrimaryColorNamed((*point).ColorName())
+}
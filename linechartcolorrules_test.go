@@ -0,0 +1,82 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Series color rules", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for _, value := range []float32{30, 40, 70, 75, 90, 95, 50, 20} {
+			point := sknlinechart.NewChartDatapoint(value, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should classify values by threshold", func() {
+		rule := sknlinechart.ThresholdColorRule(60, 80)
+		Expect(rule(59.9)).To(Equal(theme.ColorGreen))
+		Expect(rule(60)).To(Equal(theme.ColorOrange))
+		Expect(rule(80)).To(Equal(theme.ColorRed))
+	})
+	It("should color each segment by the value it leads to", func() {
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(HaveLen(8))
+
+		lc.SetSeriesColorRule("Testing", func(v float64) string { // grid lines are green, use other colors
+			switch {
+			case v >= 80:
+				return theme.ColorRed
+			case v >= 60:
+				return theme.ColorOrange
+			}
+			return theme.ColorPurple
+		})
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(BeEmpty())
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorPurple))).To(HaveLen(4))
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange))).To(HaveLen(2))
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorRed))).To(HaveLen(2))
+
+		lc.SetSeriesColorRule("Testing", nil)
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(HaveLen(8))
+	})
+	It("should keep the point's own color when the rule returns no name", func() {
+		lc.SetSeriesColorRule("Testing", func(v float64) string {
+			if v > 80 {
+				return theme.ColorRed
+			}
+			return ""
+		})
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(HaveLen(6))
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorRed))).To(HaveLen(2))
+	})
+	It("should leave the hovered datapoint's own color unchanged", func() {
+		lc.SetSeriesColorRule("Testing", sknlinechart.ThresholdColorRule(60, 80))
+		var hovered sknlinechart.ChartDatapoint
+		skn := lc.(*sknlinechart.LineChartSkn)
+		skn.OnHoverPointCallback = func(series string, dataPoint sknlinechart.ChartDatapoint) {
+			hovered = dataPoint
+		}
+		top, bottom := (*points[4]).MarkerPosition()
+		me := &desktop.MouseEvent{}
+		me.Position = fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)
+		skn.MouseMoved(me)
+		Expect(hovered).NotTo(BeNil())
+		Expect(hovered.Value()).To(BeNumerically("==", 90))
+		Expect(hovered.ColorName()).To(Equal(theme.ColorBlue)) // data keeps its own color
+	})
+})
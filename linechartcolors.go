@@ -0,0 +1,85 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+// SetSeriesColor draws the series, and its legend entry, in any color rather than its points'
+// theme color names, so charts can match a corporate palette; nil restores the points' colors.
+// Points given their own color with SetColor, and color rules, still take precedence
+func (w *LineChartSkn) SetSeriesColor(seriesName string, c color.Color) {
+	w.debugLog("LineChartSkn::SetSeriesColor()")
+	w.mapsLock.Lock()
+	if c == nil {
+		delete(w.seriesColors, seriesName)
+	} else {
+		if w.seriesColors == nil {
+			w.seriesColors = map[string]color.Color{}
+		}
+		w.seriesColors[seriesName] = c
+	}
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// GetSeriesColor returns the color set for the series, nil when it is drawn in its points' colors
+func (w *LineChartSkn) GetSeriesColor(seriesName string) color.Color {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.seriesColors[seriesName]
+}
+
+// legendColor returns the color of the series' legend entry, as seen under the color-vision deficiency simulation when set,
+// black in monochrome mode
+// caller must hold the mapsLock
+func (w *LineChartSkn) legendColor(seriesName string) color.Color {
+	if w.monochrome {
+		return monochromeInk
+	}
+	return w.cvdColor(w.chosenLegendColor(seriesName))
+}
+
+// chosenLegendColor returns the series color, else that of its first point
+// caller must hold the mapsLock
+func (w *LineChartSkn) chosenLegendColor(seriesName string) color.Color {
+	if c, ok := w.seriesColors[seriesName]; ok {
+		return c
+	}
+	points := w.dataPoints[seriesName]
+	if len(points) == 0 {
+		return theme.ForegroundColor()
+	}
+	if c := (*points[0]).Color(); c != nil {
+		return c
+	}
+	return theme.PrimaryColorNamed((*points[0]).ColorName())
+}
+
+// formatHexColor writes the color as #rrggbbaa for saved state
+func formatHexColor(c color.Color) string {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("#%02x%02x%02x%02x", n.R, n.G, n.B, n.A)
+}
+
+// parseHexColor reads a #rrggbb or #rrggbbaa color
+func parseHexColor(s string) (color.Color, error) {
+	var n color.NRGBA
+	var err error
+	switch len(s) {
+	case 7:
+		n.A = 0xff
+		_, err = fmt.Sscanf(s, "#%02x%02x%02x", &n.R, &n.G, &n.B)
+	case 9:
+		_, err = fmt.Sscanf(s, "#%02x%02x%02x%02x", &n.R, &n.G, &n.B, &n.A)
+	default:
+		err = fmt.Errorf("length %d", len(s))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid color %q: %w", s, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,73 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("RGBA series colors", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+		brand  = color.NRGBA{R: 0x12, G: 0x34, B: 0x56, A: 0xff}
+		accent = color.NRGBA{R: 0xab, G: 0xcd, B: 0xef, A: 0xff}
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 8; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(20+i*5), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should draw the series and its legend entry in the series color", func() {
+		Expect(lc.GetSeriesColor("Testing")).To(BeNil())
+		lc.SetSeriesColor("Testing", brand)
+		Expect(lc.GetSeriesColor("Testing")).To(Equal(brand))
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(BeEmpty())
+		Expect(seriesLines(lc, brand)).To(HaveLen(8))
+		Expect(visibleText(lc, "Testing").Color).To(Equal(brand))
+
+		lc.SetSeriesColor("Testing", nil)
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(HaveLen(8))
+	})
+	It("should let a point's own color override the series color", func() {
+		lc.SetSeriesColor("Testing", brand)
+		(*points[3]).SetColor(accent)
+		Expect((*points[3]).Copy().Color()).To(Equal(accent))
+		lc.SetSeriesColor("Testing", brand) // relayout with the point's color
+
+		Expect(seriesLines(lc, accent)).To(HaveLen(1))
+		Expect(seriesLines(lc, brand)).To(HaveLen(7))
+	})
+	It("should keep series and point colors in saved state", func() {
+		lc.SetSeriesColor("Testing", brand)
+		(*points[1]).SetColor(accent)
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring(`"#123456ff"`))
+		restored, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		Expect(restored.LoadState(&buf)).To(Succeed())
+		Expect(restored.GetSeriesColor("Testing")).To(Equal(brand))
+		Expect(restored.GetDataSeries("Testing")[1].Color()).To(Equal(accent))
+	})
+	It("should accept series colors as options", func() {
+		chart, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithSeriesColor("Testing", brand)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chart.GetSeriesColor("Testing")).To(Equal(brand))
+		_, err = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithSeriesColor("Testing", nil)))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,68 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// Do queues the command to run after any queued before it, on the chart's command goroutine, so
+// compound changes from any goroutine, such as deleting three series, adding two, and retitling,
+// run one at a time in order and the redraws they request are folded into a single refresh once
+// each returns. Commands are not atomic: datapoints applied from other goroutines, and layouts the
+// driver makes, may land between a command's own calls. Returns at once; a command needing the
+// result signals it from inside. A command that panics is logged and the queue carries on
+func (w *LineChartSkn) Do(command func(api LineChart)) {
+	w.debugLog("LineChartSkn::Do()")
+	if command == nil {
+		return
+	}
+	w.commandLock.Lock()
+	w.commands = append(w.commands, command)
+	start := !w.commandsDraining
+	w.commandsDraining = true
+	w.commandLock.Unlock()
+	if start {
+		go w.drainCommands()
+	}
+}
+
+// drainCommands runs the queued commands in order until the queue is empty
+func (w *LineChartSkn) drainCommands() {
+	for {
+		w.commandLock.Lock()
+		if len(w.commands) == 0 {
+			w.commandsDraining = false
+			w.commandLock.Unlock()
+			return
+		}
+		command := w.commands[0]
+		w.commands[0] = nil
+		w.commands = w.commands[1:]
+		w.commandLock.Unlock()
+		w.runCommand(command)
+	}
+}
+
+// runCommand runs one command with refreshes deferred, then catches up on any it requested,
+// unless the app has suspended refreshing meanwhile
+func (w *LineChartSkn) runCommand(command func(api LineChart)) {
+	w.idleLock.Lock()
+	w.commandRunning = true
+	w.idleLock.Unlock()
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Warn("chart command failed", "panic", fmt.Sprint(r))
+		}
+		w.idleLock.Lock()
+		w.commandRunning = false
+		pending := w.refreshPending && !w.refreshSuspended
+		if pending {
+			w.refreshPending = false
+		}
+		w.idleLock.Unlock()
+		if pending {
+			w.Refresh()
+		}
+	}()
+	command(w)
+}
@@ -0,0 +1,120 @@
+package sknlinechart_test
+
+import (
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Queued chart commands", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	series := func(value float32) []*sknlinechart.ChartDatapoint {
+		var points []*sknlinechart.ChartDatapoint
+		for i := 0; i < 5; i++ {
+			point := sknlinechart.NewChartDatapoint(value, theme.ColorOrange, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		return points
+	}
+
+	// settled waits until every command queued so far has run and been redrawn
+	settled := func() {
+		done := make(chan struct{})
+		lc.Do(func(api sknlinechart.LineChart) {
+			close(done)
+		})
+		Eventually(done).Should(BeClosed())
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		for _, name := range []string{"Alpha", "Beta", "Gamma", "Delta"} {
+			Expect(lc.ApplyDataSeries(name, series(40))).To(Succeed())
+		}
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+		win.Close()
+	})
+
+	It("should apply a compound change and redraw once it returns", func() {
+		done := make(chan bool)
+		lc.Do(func(api sknlinechart.LineChart) {
+			defer GinkgoRecover()
+			for _, name := range []string{"Alpha", "Beta", "Gamma"} {
+				Expect(api.DeleteSeries(name)).To(Succeed())
+			}
+			Expect(api.ApplyDataSeries("Epsilon", series(60))).To(Succeed())
+			Expect(api.ApplyDataSeries("Zeta", series(70))).To(Succeed())
+			api.SetTitle("Regrouped")
+			done <- visibleText(lc, "Epsilon") == nil
+		})
+		Eventually(done).Should(Receive(BeTrue()), "not drawn while the command runs")
+
+		settled()
+		Expect(visibleText(lc, "Epsilon")).NotTo(BeNil())
+		Expect(visibleText(lc, "Regrouped")).NotTo(BeNil())
+		Expect(lc.GetSeriesNames()).To(Equal([]string{"Delta", "Epsilon", "Zeta"}))
+	})
+	It("should run commands from many goroutines one at a time in queued order", func() {
+		var (
+			order   []int
+			running int
+			overlap bool
+			lock    sync.Mutex
+			wg      sync.WaitGroup
+		)
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			lc.Do(func(api sknlinechart.LineChart) {
+				defer wg.Done()
+				lock.Lock()
+				running++
+				overlap = overlap || running > 1
+				order = append(order, len(order))
+				lock.Unlock()
+				api.ApplyDataPoint("Delta", series(50)[0])
+				lock.Lock()
+				running--
+				lock.Unlock()
+			})
+		}
+		wg.Wait()
+		Expect(overlap).To(BeFalse())
+		Expect(order).To(HaveLen(20))
+		Expect(lc.GetDataSeries("Delta")).To(HaveLen(25))
+	})
+	It("should carry on after a command panics", func() {
+		lc.Do(func(api sknlinechart.LineChart) {
+			panic("broken command")
+		})
+		lc.Do(func(api sknlinechart.LineChart) {
+			api.SetTitle("Recovered")
+		})
+		settled()
+		Expect(lc.GetTitle()).To(Equal("Recovered"))
+	})
+	It("should leave a suspended chart suspended", func() {
+		lc.SuspendRefresh()
+		lc.Do(func(api sknlinechart.LineChart) {
+			defer GinkgoRecover()
+			Expect(api.ApplyDataSeries("Hidden", series(30))).To(Succeed())
+		})
+		settled()
+		Expect(visibleText(lc, "Hidden")).To(BeNil())
+		lc.ResumeRefresh()
+		Expect(visibleText(lc, "Hidden")).NotTo(BeNil())
+	})
+})
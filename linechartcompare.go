@@ -0,0 +1,79 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+// HoverMode selects what the hover popup displays
+type HoverMode int
+
+const (
+	HoverSinglePoint   HoverMode = iota // the one datapoint under the mouse
+	HoverCompareSeries                  // every series' value at the index under the mouse
+)
+
+// compareRow one line of the compare tooltip
+type compareRow struct {
+	text  string
+	color color.Color // nil for the foreground color
+}
+
+// GetHoverMode returns the active hover popup mode
+func (w *LineChartSkn) GetHoverMode() HoverMode {
+	return w.hoverMode
+}
+
+// SetHoverMode selects single point or multi-series compare hover popups
+func (w *LineChartSkn) SetHoverMode(mode HoverMode) {
+	w.hoverMode = mode
+	w.mapsLock.Lock()
+	w.compareRows = nil
+	w.mouseDisplayStr = ""
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// updateCompareRows collects each series' value at the index under pos, sorted by series name
+// caller must hold the mapsLock
+func (w *LineChartSkn) updateCompareRows(pos fyne.Position) {
+	w.compareRows = nil
+	if !w.isInsidePlotArea(pos) {
+		return
+	}
+	index, _ := w.positionToData(pos)
+	idx := int(math.Round(float64(index)))
+	if !w.isIndexVisible(idx) {
+		return
+	}
+
+	var names []string
+	for key, points := range w.dataPoints {
+		if idx >= 0 && idx < len(points) && !w.hiddenSeries[key] {
+			names = append(names, key)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	sort.Strings(names)
+
+	header := fmt.Sprint(w.Message(MessageIndex), ": ", idx*w.chartXScaleMultiplier)
+	if ts := w.timestampAtIndex(idx); ts != "" {
+		header = fmt.Sprint(header, "    [", ts, "]")
+	}
+	rows := []compareRow{{text: header}}
+	for _, name := range names {
+		point := w.dataPoints[name][idx]
+		rows = append(rows, compareRow{
+			text:  fmt.Sprint(name, ": ", w.pointValueText(name, *point)),
+			color: w.pointColor(name, point),
+		})
+	}
+	w.compareRows = rows
+	w.comparePosition = pos
+}
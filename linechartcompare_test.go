@@ -0,0 +1,67 @@
+package sknlinechart_test
+
+import (
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Compare hover mode", func() {
+	var (
+		lc     sknlinechart.LineChart
+		second sknlinechart.ChartDatapoint
+	)
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "Compare", 20)
+		second = sknlinechart.NewChartDatapoint(5, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Second", &second)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	var collect func(objs []fyne.CanvasObject, rows []string) []string
+	collect = func(objs []fyne.CanvasObject, rows []string) []string {
+		for _, o := range objs {
+			if !o.Visible() {
+				continue
+			}
+			switch t := o.(type) {
+			case *fyne.Container:
+				rows = collect(t.Objects, rows)
+			case *canvas.Text:
+				if strings.HasPrefix(t.Text, "Testing: ") || strings.HasPrefix(t.Text, "Second: ") {
+					rows = append(rows, t.Text)
+				}
+			}
+		}
+		return rows
+	}
+	compareRows := func() []string {
+		return collect(test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects(), nil)
+	}
+
+	It("should default to single point popups", func() {
+		Expect(lc.GetHoverMode()).To(Equal(sknlinechart.HoverSinglePoint))
+	})
+	It("should list every series at the index under the mouse", func() {
+		lc.SetHoverMode(sknlinechart.HoverCompareSeries)
+		Expect(lc.GetHoverMode()).To(Equal(sknlinechart.HoverCompareSeries))
+
+		top, bottom := second.MarkerPosition()
+		me := &desktop.MouseEvent{}
+		me.Position = fyne.NewPos((top.X+bottom.X)/2, 200)
+		lc.(*sknlinechart.LineChartSkn).MouseMoved(me)
+		Expect(compareRows()).To(ConsistOf("Second: 5", HavePrefix("Testing: ")))
+
+		lc.(*sknlinechart.LineChartSkn).MouseOut()
+		Expect(compareRows()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,44 @@
+package sknlinechart_test
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Concurrent producers", func() {
+	It("should accept points from many goroutines while drawing", func() {
+		lc, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithRefreshInterval(0)))
+		win := test.NewWindow(lc)
+		defer win.Close()
+		win.Resize(fyne.NewSize(800, 400))
+
+		var producers sync.WaitGroup
+		for p := 0; p < 4; p++ {
+			producers.Add(1)
+			go func(series string) {
+				defer GinkgoRecover()
+				defer producers.Done()
+				for i := 0; i < 300; i++ {
+					point := sknlinechart.NewChartDatapoint(float32(i%100), theme.ColorOrange, time.Now().Format(time.RFC1123))
+					lc.ApplyDataPoint(series, &point)
+				}
+			}(fmt.Sprint("Sensor ", p))
+		}
+		for i := 0; i < 50; i++ {
+			win.Resize(fyne.NewSize(float32(800+i%2), 400))
+			test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects()
+		}
+		producers.Wait()
+		for p := 0; p < 4; p++ {
+			Expect(lc.GetDataSeries(fmt.Sprint("Sensor ", p))).To(HaveLen(151))
+		}
+	})
+})
@@ -0,0 +1,66 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// IsCrosshairEnabled returns state of the crosshair tracking the mouse pointer
+func (w *LineChartSkn) IsCrosshairEnabled() bool {
+	return w.enableCrosshair
+}
+
+// SetCrosshairEnabled enables a vertical and horizontal line following the mouse
+// with index/timestamp and value readouts at the plot edges
+func (w *LineChartSkn) SetCrosshairEnabled(enable bool) {
+	w.enableCrosshair = enable
+	if !enable {
+		w.crosshairActive = false
+	}
+	w.Refresh()
+}
+
+// crosshairReadout composes the x and y readout text for the current crosshair position
+// caller must hold the mapsLock
+func (w *LineChartSkn) crosshairReadout() (string, string) {
+	index, value := w.positionToData(w.crosshairPosition)
+	idx := int(math.Round(float64(index)))
+	xText := fmt.Sprint(w.Message(MessageIndex), ": ", idx*w.chartXScaleMultiplier)
+	yText := fmt.Sprintf("%s: %.2f", w.Message(MessageValue), value)
+	if w.xAxis != nil {
+		xText = fmt.Sprint(w.Message(MessageX), ": ", formatXValue(w.xAxisValue(index)))
+	} else if w.timeSpan != nil {
+		return w.clipText(fmt.Sprint(w.Message(MessageTime), ": ", w.formatAxisTime(w.xTime(index)))), yText
+	}
+	if ts := w.timestampAtIndex(idx); ts != "" {
+		xText = fmt.Sprint(xText, "  [", ts, "]")
+	}
+	return w.clipText(xText), yText
+}
+
+// timestampAtIndex returns the timestamp of the first series, by name, holding a point at index
+// caller must hold the mapsLock
+func (w *LineChartSkn) timestampAtIndex(index int) string {
+	if point := w.pointAtIndex(index); point != nil {
+		return point.Timestamp()
+	}
+	return ""
+}
+
+// pointAtIndex returns the point of the first series, by name, holding a point at index, nil when none does
+// caller must hold the mapsLock
+func (w *LineChartSkn) pointAtIndex(index int) ChartDatapoint {
+	var names []string
+	for key := range w.dataPoints {
+		names = append(names, key)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		points := w.dataPoints[name]
+		if index >= 0 && index < len(points) {
+			return *points[index]
+		}
+	}
+	return nil
+}
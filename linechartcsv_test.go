@@ -0,0 +1,79 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("CSV export", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	// export returns the records written with the options
+	export := func(opts sknlinechart.CSVOptions) [][]string {
+		var out bytes.Buffer
+		Expect(lc.ExportCSV(&out, opts)).To(Succeed())
+		reader := csv.NewReader(&out)
+		if opts.Delimiter != 0 {
+			reader.Comma = opts.Delimiter
+		}
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		Expect(err).NotTo(HaveOccurred())
+		return records
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithRefreshInterval(0)))
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+		for i := 0; i < 20; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(i), theme.ColorOrange, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("CPU", &point)
+			if i < 10 {
+				point := sknlinechart.NewChartDatapoint(float32(50+i), theme.ColorBlue, time.Now().Format(time.RFC1123))
+				lc.ApplyDataPoint("Memory", &point)
+			}
+		}
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should write a row per index with each series' value, empty without a point", func() {
+		records := export(sknlinechart.CSVOptions{})
+		Expect(records).To(HaveLen(21))
+		Expect(records[0]).To(Equal([]string{"index", "timestamp", "CPU", "Memory"}))
+		Expect(records[1][2:]).To(Equal([]string{"0", "50"}))
+		Expect(records[15][0]).To(Equal("14"))
+		Expect(records[15][2:]).To(Equal([]string{"14", ""}))
+	})
+	It("should write the zoom window's indexes alone unless every point is asked for", func() {
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 5, XMax: 12, YMin: 0, YMax: 100})).To(Succeed())
+		records := export(sknlinechart.CSVOptions{})
+		Expect(records).To(HaveLen(9))
+		Expect(records[1][0]).To(Equal("5"))
+		Expect(records[8][0]).To(Equal("12"))
+		Expect(export(sknlinechart.CSVOptions{AllPoints: true})).To(HaveLen(21))
+	})
+	It("should write the series asked for with the delimiter asked for", func() {
+		records := export(sknlinechart.CSVOptions{Delimiter: ';', Series: []string{"Memory"}})
+		Expect(records[0]).To(Equal([]string{"index", "timestamp", "Memory"}))
+		Expect(records).To(HaveLen(11))
+		Expect(records[10][2]).To(Equal("59"))
+	})
+	It("should refuse unknown series", func() {
+		var out bytes.Buffer
+		Expect(lc.ExportCSV(&out, sknlinechart.CSVOptions{Series: []string{"Disk"}})).To(HaveOccurred())
+	})
+})
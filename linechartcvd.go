@@ -0,0 +1,127 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+)
+
+// CVDMode color-vision deficiency simulated by the chart's colors, a developer aid for checking palettes
+type CVDMode int
+
+const (
+	CVDNone CVDMode = iota
+	CVDProtanopia
+	CVDDeuteranopia
+	CVDTritanopia
+)
+
+func (m CVDMode) String() string {
+	switch m {
+	case CVDNone:
+		return "None"
+	case CVDProtanopia:
+		return "Protanopia"
+	case CVDDeuteranopia:
+		return "Deuteranopia"
+	case CVDTritanopia:
+		return "Tritanopia"
+	}
+	return fmt.Sprint("CVDMode(", int(m), ")")
+}
+
+// cvdMatrices linear RGB transforms of each deficiency at full severity, from Machado, Oliveira and Fernandes (2009)
+var cvdMatrices = map[CVDMode][3][3]float64{
+	CVDProtanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	CVDDeuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	CVDTritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// SetCVDSimulation redraws the series, legend, and overlay colors as seen with the color-vision
+// deficiency, so dashboard authors can check their palettes stay distinguishable before shipping.
+// Saved state and exported data keep the true colors; CVDNone shows them again
+func (w *LineChartSkn) SetCVDSimulation(mode CVDMode) error {
+	w.debugLog("LineChartSkn::SetCVDSimulation() ENTER")
+	if _, ok := cvdMatrices[mode]; !ok && mode != CVDNone {
+		w.debugLog("LineChartSkn::SetCVDSimulation() ERROR EXIT")
+		return fmt.Errorf("SetCVDSimulation() unknown mode: %v", mode)
+	}
+	w.mapsLock.Lock()
+	w.cvdMode = mode
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetCVDSimulation() EXIT")
+	return nil
+}
+
+// GetCVDSimulation returns the color-vision deficiency being simulated, CVDNone when showing true colors
+func (w *LineChartSkn) GetCVDSimulation() CVDMode {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.cvdMode
+}
+
+// cvdColor returns the color as seen with the simulated deficiency, unchanged when none is set,
+// and gray in monochrome mode
+// caller must hold the mapsLock
+func (w *LineChartSkn) cvdColor(c color.Color) color.Color {
+	if w.monochrome && c != nil {
+		return grayColor(c)
+	}
+	if w.cvdMode == CVDNone || c == nil {
+		return c
+	}
+	return simulateCVD(c, w.cvdMode)
+}
+
+// simulateCVD applies the deficiency's transform to the color in linear RGB, keeping its alpha
+func simulateCVD(c color.Color, mode CVDMode) color.Color {
+	m, ok := cvdMatrices[mode]
+	if !ok {
+		return c
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	rgb := [3]float64{srgbToLinear(n.R), srgbToLinear(n.G), srgbToLinear(n.B)}
+	var out [3]uint8
+	for row := range m {
+		out[row] = linearToSRGB(m[row][0]*rgb[0] + m[row][1]*rgb[1] + m[row][2]*rgb[2])
+	}
+	return color.NRGBA{R: out[0], G: out[1], B: out[2], A: n.A}
+}
+
+// srgbToLinear converts an sRGB channel to linear light, 0-1
+func srgbToLinear(v uint8) float64 {
+	s := float64(v) / 255
+	if s <= 0.04045 {
+		return s / 12.92
+	}
+	return math.Pow((s+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB converts linear light to an sRGB channel, clamping out of gamut values
+func linearToSRGB(l float64) uint8 {
+	if l <= 0 {
+		return 0
+	}
+	if l >= 1 {
+		return 255
+	}
+	s := 12.92 * l
+	if l > 0.0031308 {
+		s = 1.055*math.Pow(l, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(s * 255))
+}
@@ -0,0 +1,81 @@
+package sknlinechart_test
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Color-vision deficiency simulation", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	red := color.NRGBA{R: 220, G: 40, B: 40, A: 255}
+	green := color.NRGBA{R: 40, G: 180, B: 40, A: 255}
+
+	// distance between two colors as drawn, summed over the channels
+	distance := func(a, b color.Color) int {
+		na, nb := color.NRGBAModel.Convert(a).(color.NRGBA), color.NRGBAModel.Convert(b).(color.NRGBA)
+		abs := func(v int) int {
+			if v < 0 {
+				return -v
+			}
+			return v
+		}
+		return abs(int(na.R)-int(nb.R)) + abs(int(na.G)-int(nb.G)) + abs(int(na.B)-int(nb.B))
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		for name, c := range map[string]color.Color{"Alarm": red, "Normal": green} {
+			var points []*sknlinechart.ChartDatapoint
+			for i := 0; i < 5; i++ {
+				point := sknlinechart.NewChartDatapoint(float32(20+i*10), theme.ColorBlue, time.Now().Format(time.RFC1123))
+				points = append(points, &point)
+			}
+			Expect(lc.ApplyDataSeries(name, points)).To(Succeed())
+			lc.SetSeriesColor(name, c)
+		}
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should draw red and green nearly alike as seen with deuteranopia", func() {
+		Expect(seriesLines(lc, red)).NotTo(BeEmpty())
+		Expect(lc.SetCVDSimulation(sknlinechart.CVDDeuteranopia)).To(Succeed())
+		Expect(lc.GetCVDSimulation()).To(Equal(sknlinechart.CVDDeuteranopia))
+		Expect(seriesLines(lc, red)).To(BeEmpty())
+
+		alarm, normal := visibleText(lc, "Alarm").Color, visibleText(lc, "Normal").Color
+		Expect(distance(alarm, normal)).To(BeNumerically("<", distance(red, green)/2))
+	})
+	It("should keep the true colors in the chart's state and restore them", func() {
+		Expect(lc.SetCVDSimulation(sknlinechart.CVDProtanopia)).To(Succeed())
+		Expect(lc.GetSeriesColor("Alarm")).To(Equal(red))
+
+		Expect(lc.SetCVDSimulation(sknlinechart.CVDNone)).To(Succeed())
+		Expect(seriesLines(lc, red)).NotTo(BeEmpty())
+		Expect(visibleText(lc, "Alarm").Color).To(Equal(red))
+	})
+	It("should simulate tritanopia as well", func() {
+		Expect(lc.SetCVDSimulation(sknlinechart.CVDTritanopia)).To(Succeed())
+		Expect(visibleText(lc, "Normal").Color).NotTo(Equal(green))
+	})
+	It("should reject unknown modes", func() {
+		Expect(lc.SetCVDSimulation(sknlinechart.CVDMode(9))).To(HaveOccurred())
+		Expect(lc.GetCVDSimulation()).To(Equal(sknlinechart.CVDNone))
+		_, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithCVDSimulation(sknlinechart.CVDMode(9))))
+		Expect(err).To(HaveOccurred())
+	})
+})
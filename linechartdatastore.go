@@ -0,0 +1,309 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// ChartDataView a widget drawing series held by a ChartDataStore, such as a line chart, sparkline,
+// histogram, or gauge. Each view receives its own copies of the points, in the order they were applied;
+// views must not change the store from these calls, and should return quickly since ingestion waits on them
+type ChartDataView interface {
+	// DataStoreAppended is called after points are appended to the series
+	DataStoreAppended(seriesName string, points []ChartDatapoint)
+	// DataStoreDeleted is called after the series is removed from the store
+	DataStoreDeleted(seriesName string)
+}
+
+// ChartDataSnapshot an immutable view of every series in a ChartDataStore at one epoch. Readers hold
+// it as long as they like without blocking ingestion, and ingestion never changes what it holds
+type ChartDataSnapshot struct {
+	epoch  uint64
+	series map[string]storeSeries
+}
+
+// storeSeries one series of a snapshot
+type storeSeries struct {
+	id       uint64 // unique to this series in the store, a series deleted and added again gets a new id
+	appended uint64 // points ever appended to the series, including those rolled off
+	points   []ChartDatapoint
+}
+
+// Epoch returns the count of changes made to the store when the snapshot was taken
+func (s *ChartDataSnapshot) Epoch() uint64 {
+	return s.epoch
+}
+
+// SeriesNames returns the names of the series held, sorted
+func (s *ChartDataSnapshot) SeriesNames() []string {
+	names := make([]string, 0, len(s.series))
+	for name := range s.series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Series returns the series' points, oldest first, nil when the series is not held. The points
+// are shared with other readers and must not be changed; Copy any point that needs changing
+func (s *ChartDataSnapshot) Series(seriesName string) []ChartDatapoint {
+	return s.series[seriesName].points
+}
+
+// ChartDataStore series storage shared by any number of views, so one ingestion pipeline can drive
+// several synchronized widgets; points are applied once to the store rather than to each widget.
+// Every change publishes a new ChartDataSnapshot, copying on write, so readers never block writers
+type ChartDataStore struct {
+	lock       sync.Mutex // serializes writers, keeping views in step with the snapshots
+	current    atomic.Pointer[ChartDataSnapshot]
+	nextID     uint64
+	pointLimit int
+	views      []ChartDataView
+}
+
+// NewChartDataStore creates an empty store keeping the latest pointLimit points of each series,
+// the line chart's default of 150 when pointLimit is below one
+func NewChartDataStore(pointLimit int) *ChartDataStore {
+	if pointLimit < 1 {
+		pointLimit = XPointLimit
+	}
+	s := &ChartDataStore{pointLimit: pointLimit}
+	s.current.Store(&ChartDataSnapshot{series: map[string]storeSeries{}})
+	return s
+}
+
+// Snapshot returns the store's contents as of the latest change, without locking
+func (s *ChartDataStore) Snapshot() *ChartDataSnapshot {
+	return s.current.Load()
+}
+
+// Attach adds the view, which is first given copies of every series already held, then each later change
+func (s *ChartDataStore) Attach(view ChartDataView) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.views = append(s.views, view)
+	snap := s.Snapshot()
+	for _, name := range snap.SeriesNames() {
+		view.DataStoreAppended(name, copyDatapoints(snap.Series(name)))
+	}
+}
+
+// Detach removes the view, which keeps the points it was given
+func (s *ChartDataStore) Detach(view ChartDataView) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for idx, v := range s.views {
+		if v == view {
+			s.views = append(s.views[:idx], s.views[idx+1:]...)
+			return
+		}
+	}
+}
+
+// ApplyDataPoint appends the datapoint to the series, shifting out the oldest point once the
+// store's point limit is reached, and passes a copy to each attached view
+func (s *ChartDataStore) ApplyDataPoint(seriesName string, point *ChartDatapoint) {
+	s.ApplyDataPoints(seriesName, []ChartDatapoint{*point})
+}
+
+// ApplyDataPoints appends many datapoints to the series as ApplyDataPoint does
+func (s *ChartDataStore) ApplyDataPoints(seriesName string, points []ChartDatapoint) {
+	if len(points) == 0 {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	snap := s.Snapshot()
+	series, ok := snap.series[seriesName]
+	if !ok {
+		s.nextID++
+		series.id = s.nextID
+	}
+	start := len(series.points) + len(points) - s.pointLimit
+	if start < 0 {
+		start = 0
+	}
+	var kept []ChartDatapoint
+	if start < len(series.points) {
+		kept = series.points[start:]
+	}
+	fresh := make([]ChartDatapoint, 0, len(kept)+len(points)) // copied on write, the old slice may still be read
+	fresh = append(fresh, kept...)
+	fresh = append(fresh, copyDatapoints(points)...)
+	if len(fresh) > s.pointLimit { // a batch longer than the limit
+		fresh = fresh[len(fresh)-s.pointLimit:]
+	}
+	series.points = fresh
+	series.appended += uint64(len(points))
+	s.publish(snap, seriesName, &series)
+
+	for _, view := range s.views {
+		view.DataStoreAppended(seriesName, copyDatapoints(points))
+	}
+}
+
+// DeleteSeries removes the series from the store and its views
+func (s *ChartDataStore) DeleteSeries(seriesName string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	snap := s.Snapshot()
+	if _, ok := snap.series[seriesName]; !ok {
+		return fmt.Errorf("DeleteSeries() series not found: %s", seriesName)
+	}
+	s.publish(snap, seriesName, nil)
+
+	for _, view := range s.views {
+		view.DataStoreDeleted(seriesName)
+	}
+	return nil
+}
+
+// publish replaces the series, or removes it when nil, in a new snapshot following snap
+// caller must hold the lock
+func (s *ChartDataStore) publish(snap *ChartDataSnapshot, seriesName string, series *storeSeries) {
+	next := &ChartDataSnapshot{epoch: snap.epoch + 1, series: make(map[string]storeSeries, len(snap.series)+1)}
+	for name, held := range snap.series {
+		next.series[name] = held
+	}
+	if series == nil {
+		delete(next.series, seriesName)
+	} else {
+		next.series[seriesName] = *series
+	}
+	s.current.Store(next)
+}
+
+// SeriesNames returns the names of the series held, sorted
+func (s *ChartDataStore) SeriesNames() []string {
+	return s.Snapshot().SeriesNames()
+}
+
+// Series returns copies of the series' points, oldest first, nil when the series is not held
+func (s *ChartDataStore) Series(seriesName string) []ChartDatapoint {
+	points := s.Snapshot().Series(seriesName)
+	if points == nil {
+		return nil
+	}
+	return copyDatapoints(points)
+}
+
+// Latest returns a copy of the series' newest point, false when the series is empty or not held
+func (s *ChartDataStore) Latest(seriesName string) (ChartDatapoint, bool) {
+	points := s.Snapshot().Series(seriesName)
+	if len(points) == 0 {
+		return nil, false
+	}
+	return points[len(points)-1].Copy(), true
+}
+
+// copyDatapoints returns independent copies of the points
+func copyDatapoints(points []ChartDatapoint) []ChartDatapoint {
+	copies := make([]ChartDatapoint, 0, len(points))
+	for _, point := range points {
+		copies = append(copies, point.Copy())
+	}
+	return copies
+}
+
+// chartStoreView draws a store's series on a line chart. Store changes only schedule a sync, which
+// reads the latest snapshot on its own goroutine, so a chart busy drawing never holds up ingestion.
+// The chart keeps its own copies of the points since marker positions belong to each view
+type chartStoreView struct {
+	chart     *LineChartSkn
+	store     *ChartDataStore
+	scheduled atomic.Bool
+	lock      sync.Mutex // serializes syncs
+	detached  bool
+	seen      map[string]storeSeries // the id and appended count of each series last synced
+}
+
+// DataStoreAppended schedules a sync with the store
+func (v *chartStoreView) DataStoreAppended(string, []ChartDatapoint) {
+	v.schedule()
+}
+
+// DataStoreDeleted schedules a sync with the store
+func (v *chartStoreView) DataStoreDeleted(string) {
+	v.schedule()
+}
+
+// schedule starts a sync unless one is already waiting to start
+func (v *chartStoreView) schedule() {
+	if v.scheduled.CompareAndSwap(false, true) {
+		go v.sync()
+	}
+}
+
+// sync brings the chart up to the store's latest snapshot: series the chart has not seen are
+// replaced whole, others get only the points appended since the last sync
+func (v *chartStoreView) sync() {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.scheduled.Store(false)
+	if v.detached {
+		return
+	}
+	snap := v.store.Snapshot()
+	for name := range v.seen {
+		if _, ok := snap.series[name]; !ok {
+			_ = v.chart.DeleteSeries(name)
+			delete(v.seen, name)
+		}
+	}
+	for name, series := range snap.series {
+		seen, ok := v.seen[name]
+		fresh := int(series.appended - seen.appended)
+		if !ok || seen.id != series.id || fresh > len(series.points) {
+			_ = v.chart.ClearSeries(name)
+			fresh = len(series.points)
+		}
+		if fresh > 0 {
+			v.chart.ApplyDataPoints(name, copyDatapoints(series.points[len(series.points)-fresh:]))
+		}
+		v.seen[name] = series
+	}
+}
+
+// AttachDataStore draws the store's series on this chart, in place of any points the chart holds
+// of the same names, and follows every later change; replaces any attachment to another store.
+// Other series added directly to the chart are kept. The chart catches up with the store on its
+// own goroutine, so ingestion into the store is never held up by the chart drawing
+func (w *LineChartSkn) AttachDataStore(store *ChartDataStore) {
+	w.debugLog("LineChartSkn::AttachDataStore() ENTER")
+	w.DetachDataStore()
+	view := &chartStoreView{chart: w, store: store, seen: map[string]storeSeries{}}
+	w.mapsLock.Lock()
+	w.dataStoreView = view
+	w.mapsLock.Unlock()
+	store.Attach(view)
+	view.schedule() // an empty store tells the view nothing on attach
+	w.debugLog("LineChartSkn::AttachDataStore() EXIT")
+}
+
+// DetachDataStore stops following the attached store, the chart keeps the points it holds
+func (w *LineChartSkn) DetachDataStore() {
+	w.debugLog("LineChartSkn::DetachDataStore()")
+	w.mapsLock.Lock()
+	view := w.dataStoreView
+	w.dataStoreView = nil
+	w.mapsLock.Unlock()
+	if view == nil {
+		return
+	}
+	view.store.Detach(view)
+	view.lock.Lock()
+	view.detached = true
+	view.lock.Unlock()
+}
+
+// GetDataStore returns the store the chart follows, nil when none is attached
+func (w *LineChartSkn) GetDataStore() *ChartDataStore {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	if w.dataStoreView == nil {
+		return nil
+	}
+	return w.dataStoreView.store
+}
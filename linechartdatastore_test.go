@@ -0,0 +1,120 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+// gaugeView a minimal view keeping only the latest value of each series
+type gaugeView struct {
+	latest map[string]float32
+}
+
+func (g *gaugeView) DataStoreAppended(seriesName string, points []sknlinechart.ChartDatapoint) {
+	g.latest[seriesName] = points[len(points)-1].Value()
+}
+
+func (g *gaugeView) DataStoreDeleted(seriesName string) {
+	delete(g.latest, seriesName)
+}
+
+var _ = Describe("Shared chart data store", func() {
+	var (
+		store  *sknlinechart.ChartDataStore
+		first  sknlinechart.LineChart
+		second sknlinechart.LineChart
+		gauge  *gaugeView
+	)
+
+	point := func(value float32) *sknlinechart.ChartDatapoint {
+		dp := sknlinechart.NewChartDatapoint(value, theme.ColorOrange, time.Now().Format(time.RFC1123))
+		return &dp
+	}
+
+	// values returns the values of the chart's series, charts catch up with the store asynchronously
+	values := func(lc sknlinechart.LineChart) func() []float32 {
+		return func() []float32 {
+			var values []float32
+			for _, point := range lc.GetDataSeries("Humidity") {
+				values = append(values, point.Value())
+			}
+			return values
+		}
+	}
+
+	BeforeEach(func() {
+		store = sknlinechart.NewChartDataStore(5)
+		store.ApplyDataPoint("Humidity", point(40))
+		first, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		second, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		first.Resize(fyne.NewSize(800, 400))
+		second.Resize(fyne.NewSize(400, 200))
+		gauge = &gaugeView{latest: map[string]float32{}}
+	})
+	AfterEach(func() {
+		first.DetachDataStore()
+		second.DetachDataStore()
+	})
+
+	It("should drive every attached view from one ingestion", func() {
+		first.AttachDataStore(store)
+		second.AttachDataStore(store)
+		store.Attach(gauge)
+		Expect(first.GetDataStore()).To(BeIdenticalTo(store))
+		Eventually(values(first)).Should(Equal([]float32{40}))
+		Expect(gauge.latest["Humidity"]).To(BeNumerically("==", 40))
+
+		store.ApplyDataPoints("Humidity", []sknlinechart.ChartDatapoint{*point(45), *point(50)})
+		Eventually(values(first)).Should(Equal([]float32{40, 45, 50}))
+		Eventually(values(second)).Should(Equal([]float32{40, 45, 50}))
+		Expect(gauge.latest["Humidity"]).To(BeNumerically("==", 50))
+
+		Expect(store.DeleteSeries("Humidity")).To(Succeed())
+		Eventually(first.GetSeriesNames).Should(BeEmpty())
+		Expect(gauge.latest).NotTo(HaveKey("Humidity"))
+		Expect(store.DeleteSeries("Humidity")).To(HaveOccurred())
+	})
+	It("should keep each view's marker positions apart from the store", func() {
+		first.AttachDataStore(store)
+		store.ApplyDataPoint("Humidity", point(60))
+		Eventually(values(first)).Should(Equal([]float32{40, 60}))
+
+		latest, ok := store.Latest("Humidity")
+		Expect(ok).To(BeTrue())
+		Expect(latest.Value()).To(BeNumerically("==", 60))
+		stored, _ := latest.MarkerPosition()
+		Expect(stored.IsZero()).To(BeTrue())
+	})
+	It("should roll off the oldest points at the store's limit", func() {
+		for i := 0; i < 8; i++ {
+			store.ApplyDataPoint("Humidity", point(float32(i)))
+		}
+		Expect(store.Series("Humidity")).To(HaveLen(5))
+		Expect(store.Series("Humidity")[0].Value()).To(BeNumerically("==", 3))
+		Expect(store.SeriesNames()).To(Equal([]string{"Humidity"}))
+	})
+	It("should leave snapshots already taken unchanged by later ingestion", func() {
+		snap := store.Snapshot()
+		for i := 0; i < 8; i++ {
+			store.ApplyDataPoint("Humidity", point(float32(i)))
+		}
+		Expect(snap.Series("Humidity")).To(HaveLen(1))
+		Expect(snap.Series("Humidity")[0].Value()).To(BeNumerically("==", 40))
+		Expect(store.Snapshot().Epoch()).To(Equal(snap.Epoch() + 8))
+	})
+	It("should stop following the store once detached, keeping its points", func() {
+		first.ApplyDataPoint("Humidity", point(99))
+		first.AttachDataStore(store)
+		Eventually(values(first)).Should(Equal([]float32{40})) // replaced by the store's series
+		first.DetachDataStore()
+		Expect(first.GetDataStore()).To(BeNil())
+
+		store.ApplyDataPoint("Humidity", point(70))
+		Consistently(values(first), "50ms").Should(Equal([]float32{40}))
+	})
+})
@@ -0,0 +1,187 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DerivedKind how a derived series is computed from its source
+type DerivedKind int
+
+const (
+	DerivedMovingAvg DerivedKind = iota // mean of the latest window points
+	DerivedEMA                          // exponential moving average, smoothing 2/(window+1)
+	DerivedRate                         // change per second over window points, per point when timestamps are unreadable
+)
+
+// String returns the short name used in derived series names
+func (k DerivedKind) String() string {
+	switch k {
+	case DerivedMovingAvg:
+		return "MA"
+	case DerivedEMA:
+		return "EMA"
+	case DerivedRate:
+		return "Rate"
+	}
+	return fmt.Sprint("DerivedKind(", int(k), ")")
+}
+
+// valid returns true for a known kind
+func (k DerivedKind) valid() bool {
+	return k >= DerivedMovingAvg && k <= DerivedRate
+}
+
+// derivedSeries a series computed from another as its points arrive
+type derivedSeries struct {
+	source string
+	kind   DerivedKind
+	window int
+}
+
+// validate checks the kind and window
+func (d derivedSeries) validate() error {
+	if !d.kind.valid() {
+		return fmt.Errorf("unknown derived kind: %v", d.kind)
+	}
+	if d.window < 1 {
+		return fmt.Errorf("[%s] derived window must be at least one point: %d", d.source, d.window)
+	}
+	return nil
+}
+
+// AddDerivedSeries adds a series the chart computes from the source series, a moving average, an
+// exponential moving average, or a rate of change over window points, kept up to date as points
+// arrive so apps need not smooth data before plotting. Returns the derived series' name, such as
+// "Temperature MA(10)". Averages start from the first point, over fewer than window points until
+// that many have arrived; rates are missing until window points precede them. Missing source points
+// are missing in the derived series. The source may be added later, but may not itself be derived
+func (w *LineChartSkn) AddDerivedSeries(source string, kind DerivedKind, window int) (string, error) {
+	w.debugLog("LineChartSkn::AddDerivedSeries() ENTER")
+	derived := derivedSeries{source: source, kind: kind, window: window}
+	name := fmt.Sprintf("%s %s(%d)", source, kind, window)
+	err := derived.validate()
+	w.mapsLock.Lock()
+	if err != nil {
+		err = fmt.Errorf("AddDerivedSeries() %w", err)
+	} else if source == "" {
+		err = fmt.Errorf("AddDerivedSeries() source series name is empty")
+	} else if _, ok := w.derived[source]; ok {
+		err = fmt.Errorf("AddDerivedSeries() source is itself a derived series: %s", source)
+	} else if points, ok := w.dataPoints[name]; ok && len(points) > 0 && w.derived[name] == (derivedSeries{}) {
+		err = fmt.Errorf("AddDerivedSeries() series already holds data: %s", name)
+	}
+	if err != nil {
+		w.mapsLock.Unlock()
+		w.debugLog("LineChartSkn::AddDerivedSeries() ERROR EXIT")
+		return "", err
+	}
+	if w.derived == nil {
+		w.derived = map[string]derivedSeries{}
+	}
+	w.derived[name] = derived
+	w.updateDerived()
+	w.dataSeriesAdded = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::AddDerivedSeries() EXIT")
+	return name, nil
+}
+
+// RemoveDerivedSeries stops deriving the series and deletes it, false when it is not a derived series
+func (w *LineChartSkn) RemoveDerivedSeries(name string) bool {
+	w.debugLog("LineChartSkn::RemoveDerivedSeries()")
+	w.mapsLock.Lock()
+	_, ok := w.derived[name]
+	if ok {
+		w.forgetSeries(name)
+		w.relayoutRequired = true
+	}
+	w.mapsLock.Unlock()
+	if ok {
+		w.Refresh()
+	}
+	return ok
+}
+
+// GetDerivedSeries returns the source, kind, and window of a derived series, false when it is not one
+func (w *LineChartSkn) GetDerivedSeries(name string) (string, DerivedKind, int, bool) {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	derived, ok := w.derived[name]
+	return derived.source, derived.kind, derived.window, ok
+}
+
+// updateDerived recomputes every derived series from its source, after the ratios it may be derived from
+// caller must hold the mapsLock
+func (w *LineChartSkn) updateDerived() {
+	names := make([]string, 0, len(w.derived))
+	for name := range w.derived {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w.storeSamples(name, w.derivedSamples(w.derived[name]))
+	}
+}
+
+// derivedSamples computes one sample for each of the source's points
+// caller must hold the mapsLock
+func (w *LineChartSkn) derivedSamples(derived derivedSeries) []derivedSample {
+	source := w.dataPoints[derived.source]
+	samples := make([]derivedSample, 0, len(source))
+	alpha := 2 / float32(derived.window+1)
+	var ema float32
+	seeded := false
+	for idx, point := range source {
+		sample := derivedSample{colorName: (*point).ColorName(), timestamp: (*point).Timestamp()}
+		v := (*point).Value()
+		usable := isFinite(v) && !(*point).IsMissing()
+		switch derived.kind {
+		case DerivedMovingAvg:
+			var sum float32
+			count := 0
+			first := idx - derived.window + 1
+			if first < 0 {
+				first = 0
+			}
+			for _, earlier := range source[first : idx+1] {
+				if ev := (*earlier).Value(); isFinite(ev) && !(*earlier).IsMissing() {
+					sum += ev
+					count++
+				}
+			}
+			if count > 0 {
+				sample.value = sum / float32(count)
+			}
+			sample.missing = !usable
+		case DerivedEMA:
+			if usable {
+				if !seeded {
+					ema, seeded = v, true
+				} else {
+					ema += alpha * (v - ema)
+				}
+			}
+			sample.value, sample.missing = ema, !usable
+		case DerivedRate:
+			sample.missing = true
+			if idx >= derived.window && usable {
+				earlier := *source[idx-derived.window]
+				if ev := earlier.Value(); isFinite(ev) && !earlier.IsMissing() {
+					sample.value, sample.missing = (v-ev)/float32(derived.window), false
+					from, fromOK := earlier.Time()
+					to, toOK := (*point).Time()
+					if seconds := to.Sub(from).Seconds(); fromOK && toOK && seconds > 0 {
+						sample.value = (v - ev) / float32(seconds)
+					}
+				}
+			}
+		}
+		if sample.missing {
+			sample.value = 0
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
@@ -0,0 +1,105 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Derived series", func() {
+	var (
+		lc    sknlinechart.LineChart
+		start = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	apply := func(seconds int, value float32) {
+		point := sknlinechart.NewChartDatapointAt(value, theme.ColorBlue, start.Add(time.Duration(seconds)*time.Second))
+		lc.ApplyDataPoint("Temperature", &point)
+	}
+	values := func(series string) []any {
+		var out []any
+		for _, point := range lc.GetDataSeries(series) {
+			if point.IsMissing() {
+				out = append(out, "missing")
+				continue
+			}
+			out = append(out, point.Value())
+		}
+		return out
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithRefreshInterval(0))) // redraw on every point
+		lc.Resize(fyne.NewSize(800, 400))
+		for idx, value := range []float32{10, 20, 30, 40} {
+			apply(idx*10, value)
+		}
+	})
+
+	It("should keep a moving average up to date as points arrive", func() {
+		name, err := lc.AddDerivedSeries("Temperature", sknlinechart.DerivedMovingAvg, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("Temperature MA(3)"))
+		Expect(values(name)).To(Equal([]any{float32(10), float32(15), float32(20), float32(30)}))
+		Expect(lc.GetDataSeries(name)[3].Timestamp()).To(Equal(lc.GetDataSeries("Temperature")[3].Timestamp()))
+
+		apply(40, 80)
+		Expect(values(name)).To(HaveLen(5))
+		Expect(values(name)[4]).To(Equal(float32(50)))
+	})
+	It("should compute exponential averages and rates per second", func() {
+		ema, err := lc.AddDerivedSeries("Temperature", sknlinechart.DerivedEMA, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values(ema)).To(Equal([]any{float32(10), float32(15), float32(22.5), float32(31.25)}))
+
+		rate, err := lc.AddDerivedSeries("Temperature", sknlinechart.DerivedRate, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values(rate)).To(Equal([]any{"missing", "missing", float32(1), float32(1)}))
+
+		source, kind, window, ok := lc.GetDerivedSeries(rate)
+		Expect(ok).To(BeTrue())
+		Expect(source).To(Equal("Temperature"))
+		Expect(kind).To(Equal(sknlinechart.DerivedRate))
+		Expect(window).To(Equal(2))
+	})
+	It("should carry missing source points through", func() {
+		missing := sknlinechart.NewMissingDatapoint(theme.ColorBlue, start.Add(40*time.Second).Format(time.RFC1123))
+		lc.ApplyDataPoint("Temperature", &missing)
+		name, _ := lc.AddDerivedSeries("Temperature", sknlinechart.DerivedMovingAvg, 2)
+		Expect(values(name)[4]).To(Equal("missing"))
+		apply(50, 60)
+		Expect(values(name)[5]).To(Equal(float32(60)))
+	})
+	It("should follow a renamed source, stop when removed, and keep derived series in saved state", func() {
+		name, _ := lc.AddDerivedSeries("Temperature", sknlinechart.DerivedEMA, 4)
+		Expect(lc.RenameSeries("Temperature", "Temp")).To(Succeed())
+		source, _, _, _ := lc.GetDerivedSeries(name)
+		Expect(source).To(Equal("Temp"))
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		_, kind, window, ok := restored.GetDerivedSeries(name)
+		Expect(ok).To(BeTrue())
+		Expect([]any{kind, window}).To(Equal([]any{sknlinechart.DerivedEMA, 4}))
+
+		Expect(lc.RemoveDerivedSeries(name)).To(BeTrue())
+		Expect(lc.RemoveDerivedSeries(name)).To(BeFalse())
+		Expect(lc.GetSeriesNames()).NotTo(ContainElement(name))
+	})
+	It("should reject bad windows, unknown kinds, and derived sources", func() {
+		_, err := lc.AddDerivedSeries("Temperature", sknlinechart.DerivedMovingAvg, 0)
+		Expect(err).To(HaveOccurred())
+		_, err = lc.AddDerivedSeries("Temperature", sknlinechart.DerivedKind(9), 3)
+		Expect(err).To(HaveOccurred())
+		name, _ := lc.AddDerivedSeries("Temperature", sknlinechart.DerivedMovingAvg, 3)
+		_, err = lc.AddDerivedSeries(name, sknlinechart.DerivedEMA, 3)
+		Expect(err).To(HaveOccurred())
+	})
+})
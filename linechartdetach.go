@@ -0,0 +1,130 @@
+package sknlinechart
+
+import (
+	"errors"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+)
+
+// DetachSeries opens the series in its own window on a chart which mirrors every later update
+// of the series, leaving this chart unchanged. Closing the window stops the mirroring
+func (w *LineChartSkn) DetachSeries(seriesName string) (LineChart, error) {
+	w.debugLog("LineChartSkn::DetachSeries() ENTER")
+	app := fyne.CurrentApp()
+	if app == nil {
+		w.debugLog("LineChartSkn::DetachSeries() ERROR EXIT")
+		return nil, errors.New("DetachSeries() no active fyne application")
+	}
+
+	state := w.State()
+	series, ok := state.Series[seriesName]
+	if !ok {
+		w.debugLog("LineChartSkn::DetachSeries() ERROR EXIT")
+		return nil, fmt.Errorf("DetachSeries() series not found: %s", seriesName)
+	}
+	state.Title = seriesName
+	state.Series = map[string][]ChartStatePoint{seriesName: series}
+
+	w.mapsLock.RLock()
+	limit := w.seriesPointLimit(seriesName)
+	w.mapsLock.RUnlock()
+	detached, err := NewWithOptions(NewChartOptions(WithXLimit(w.dataPointXLimit), WithSeriesPointLimit(seriesName, limit)))
+	if err != nil {
+		w.debugLog("LineChartSkn::DetachSeries() ERROR EXIT")
+		return nil, err
+	}
+	err = detached.ApplyState(state)
+	if err != nil {
+		w.debugLog("LineChartSkn::DetachSeries() ERROR EXIT")
+		return nil, err
+	}
+	mirror := detached.(*LineChartSkn)
+	mirror.translator.Store(w.translator.Load()) // speaks the same language as the chart it came from
+
+	w.mapsLock.Lock()
+	if w.detachedCharts == nil {
+		w.detachedCharts = map[string][]*LineChartSkn{}
+	}
+	w.detachedCharts[seriesName] = append(w.detachedCharts[seriesName], mirror)
+	w.mapsLock.Unlock()
+
+	size := w.Size()
+	if size.IsZero() {
+		size = w.MinSize()
+	}
+	win := app.NewWindow(seriesName)
+	win.SetContent(container.NewPadded(detached))
+	win.Resize(size)
+	win.SetOnClosed(func() {
+		w.removeDetachedChart(seriesName, mirror)
+	})
+	win.Show()
+
+	w.debugLog("LineChartSkn::DetachSeries() EXIT")
+	return detached, nil
+}
+
+// IsSeriesDetached returns true while the series is open in a detached window
+func (w *LineChartSkn) IsSeriesDetached(seriesName string) bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return len(w.detachedCharts[seriesName]) > 0
+}
+
+// removeDetachedChart stops mirroring the series into the chart
+func (w *LineChartSkn) removeDetachedChart(seriesName string, mirror *LineChartSkn) {
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	charts := w.detachedCharts[seriesName]
+	for idx, chart := range charts {
+		if chart == mirror {
+			charts = append(charts[:idx], charts[idx+1:]...)
+			break
+		}
+	}
+	if len(charts) == 0 {
+		delete(w.detachedCharts, seriesName)
+		return
+	}
+	w.detachedCharts[seriesName] = charts
+}
+
+// mirrorDataPoint forwards a copy of the new datapoint to each detached chart of the series
+func (w *LineChartSkn) mirrorDataPoint(seriesName string, point *ChartDatapoint) {
+	for _, chart := range w.detachedChartsFor(seriesName) {
+		dp := (*point).Copy()
+		chart.ApplyDataPoint(seriesName, &dp)
+	}
+}
+
+// mirrorDataPoints forwards copies of a batch of new datapoints to each detached chart of the series
+func (w *LineChartSkn) mirrorDataPoints(seriesName string, points []*ChartDatapoint) {
+	for _, chart := range w.detachedChartsFor(seriesName) {
+		batch := make([]ChartDatapoint, 0, len(points))
+		for _, point := range points {
+			batch = append(batch, (*point).Copy())
+		}
+		chart.ApplyDataPoints(seriesName, batch)
+	}
+}
+
+// mirrorDataSeries forwards a copy of the replacement series to each detached chart of the series
+func (w *LineChartSkn) mirrorDataSeries(seriesName string, points []*ChartDatapoint) {
+	for _, chart := range w.detachedChartsFor(seriesName) {
+		series := make([]*ChartDatapoint, 0, len(points))
+		for _, point := range points {
+			dp := (*point).Copy()
+			series = append(series, &dp)
+		}
+		_ = chart.ApplyDataSeries(seriesName, series)
+	}
+}
+
+// detachedChartsFor returns the charts mirroring the series
+func (w *LineChartSkn) detachedChartsFor(seriesName string) []*LineChartSkn {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return append([]*LineChartSkn(nil), w.detachedCharts[seriesName]...)
+}
@@ -0,0 +1,74 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+// windowShowing returns the open window displaying the chart, nil when none
+func windowShowing(app fyne.App, chart sknlinechart.LineChart) fyne.Window {
+	for _, win := range app.Driver().AllWindows() {
+		if c, ok := win.Content().(*fyne.Container); ok && len(c.Objects) == 1 && c.Objects[0] == chart {
+			return win
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Detached series windows", func() {
+	var (
+		app fyne.App
+		lc  sknlinechart.LineChart
+	)
+
+	BeforeEach(func() {
+		app = test.NewApp()
+		lc, _ = makeUI("Testing", "Detach", 20)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+	})
+
+	It("should reject an unknown series", func() {
+		before := len(app.Driver().AllWindows())
+		_, err := lc.DetachSeries("Unknown")
+		Expect(err).To(HaveOccurred())
+		Expect(app.Driver().AllWindows()).To(HaveLen(before))
+	})
+	It("should open the series in its own window and mirror its updates", func() {
+		detached, err := lc.DetachSeries("Testing")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lc.IsSeriesDetached("Testing")).To(BeTrue())
+		Expect(windowShowing(app, detached)).NotTo(BeNil())
+		Expect(detached.GetTitle()).To(Equal("Testing"))
+		Expect(detached.State().Series["Testing"]).To(HaveLen(20))
+
+		point := sknlinechart.NewChartDatapoint(42, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Testing", &point)
+		other := sknlinechart.NewChartDatapoint(7, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Other", &other)
+
+		series := detached.State().Series
+		Expect(series).To(HaveLen(1))
+		Expect(series["Testing"]).To(HaveLen(21))
+		Expect(series["Testing"][20].Value).To(BeNumerically("==", 42))
+		Expect(lc.State().Series["Testing"]).To(HaveLen(21))
+	})
+	It("should stop mirroring when the window closes", func() {
+		detached, err := lc.DetachSeries("Testing")
+		Expect(err).NotTo(HaveOccurred())
+		windowShowing(app, detached).Close()
+		Expect(lc.IsSeriesDetached("Testing")).To(BeFalse())
+
+		point := sknlinechart.NewChartDatapoint(42, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Testing", &point)
+		Expect(detached.State().Series["Testing"]).To(HaveLen(20))
+	})
+})
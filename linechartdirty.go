@@ -0,0 +1,90 @@
+package sknlinechart
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// markDirty records that the series changed since its last layout, from the point at index from on;
+// points appended to a series which is not yet full are repositioned alone, anything else from 0
+// caller must hold the mapsLock
+func (w *LineChartSkn) markDirty(seriesName string, from int) {
+	if w.dirtySeries == nil {
+		w.dirtySeries = map[string]int{}
+	}
+	if earlier, ok := w.dirtySeries[seriesName]; ok && earlier < from {
+		from = earlier
+	}
+	w.dirtySeries[seriesName] = from
+}
+
+// relayoutsAll true when every series must be repositioned on this refresh, rather than those changed;
+// time spacing and stacking move every point as any series grows
+// caller must hold the mapsLock
+func (r *lineChartRenderer) relayoutsAll() bool {
+	return r.widget.relayoutRequired || r.widget.timeSpan != nil || r.widget.stack != nil
+}
+
+// layoutAppended positions the points appended to the series from index from on, joining the first to
+// the point before it, leaving the rest of the series where it was laid out. False when the series is
+// drawn in a way which cannot be extended point by point, it must then be laid out whole
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutAppended(series string, from int) bool {
+	data := r.widget.dataPoints[series]
+	base := r.virtualBase[series]
+	if from <= 0 || from >= len(data) || from-1 < base || base+len(r.dataPoints[series]) < len(data) ||
+		r.widget.hiddenSeries[series] || r.widget.drawsPooledLines(series) || r.widget.pointStride() != 1 ||
+		r.downsampled[series] || r.virtualIndexes[series] != nil || r.widget.downsampleKeep(series) != nil {
+		return false
+	}
+	lastPoint, ok := r.appendedPosition(series, from-1)
+	if !ok {
+		return false // the line cannot start from a point which is not drawn
+	}
+
+	strokeSize := r.seriesStroke(series)
+	_, markerSize := r.widget.seriesMarker(series)
+	half := markerSize / 2
+	for idx := from; idx < len(data); idx++ {
+		thisPoint, ok := r.appendedPosition(series, idx)
+		if !ok || r.widget.isGapBefore(series, idx) {
+			return false
+		}
+		point := data[idx]
+		c := r.seriesColor(series, point)
+
+		dpm := r.dataPointMarkers[series][idx-base]
+		zt := fyne.NewPos(thisPoint.X-half, thisPoint.Y-half)
+		zb := fyne.NewPos(thisPoint.X+half, thisPoint.Y+half)
+		dpm.Position1, dpm.Position2 = zt, zb
+		dpm.FillColor = c
+		(*point).SetMarkerPosition(&zt, &zb)
+		if r.widget.markersAllowed() {
+			dpm.Show()
+		} else {
+			dpm.Hide()
+		}
+
+		dpv := r.dataPoints[series][idx-base]
+		dpv.StrokeColor = c
+		dpv.StrokeWidth = strokeSize
+		dpv.Position1 = thisPoint
+		dpv.Position2 = lastPoint
+		dpv.Show()
+		lastPoint = thisPoint
+	}
+	return true
+}
+
+// appendedPosition returns where the series' point is drawn, false when it is not drawn
+// caller must hold the mapsLock
+func (r *lineChartRenderer) appendedPosition(series string, idx int) (fyne.Position, bool) {
+	point := r.widget.dataPoints[series][idx]
+	x := r.widget.pointX(idx, *point)
+	if !isFinite((*point).Value()) || (*point).IsMissing() || !r.widget.isXVisible(x) {
+		return fyne.Position{}, false
+	}
+	pos := r.widget.dataToPosition(x, r.widget.plotValue(series, idx, *point))
+	return fyne.NewPos(float32(math.Trunc(float64(pos.X))), float32(math.Trunc(float64(pos.Y)))), true
+}
@@ -0,0 +1,78 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Incremental layout", func() {
+	var (
+		lc          sknlinechart.LineChart
+		win         fyne.Window
+		cpu, memory []*sknlinechart.ChartDatapoint
+	)
+
+	// forget clears the point's marker position, left alone unless the point is laid out again
+	forget := func(point *sknlinechart.ChartDatapoint) {
+		(*point).SetMarkerPosition(&fyne.Position{}, &fyne.Position{})
+	}
+	apply := func(series string, value float32) *sknlinechart.ChartDatapoint {
+		point := sknlinechart.NewChartDatapoint(value, theme.ColorOrange, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint(series, &point)
+		return &point
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithRefreshInterval(0)))
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+		cpu, memory = nil, nil
+		for i := 0; i < 5; i++ {
+			cpu = append(cpu, apply("CPU", float32(20+i)))
+			memory = append(memory, apply("Memory", float32(60+i)))
+		}
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should reposition only the series which changed", func() {
+		forget(cpu[2])
+		last := apply("Memory", 70)
+		Expect(markerX(*last)).To(BeNumerically(">", markerX(*memory[4])))
+		Expect(markerX(*cpu[2])).To(BeZero())
+	})
+	It("should compute only the new segment when appending", func() {
+		forget(memory[1])
+		last := apply("Memory", 70)
+		Expect(markerX(*memory[1])).To(BeZero())
+		Expect(markerX(*last)).To(BeNumerically(">", markerX(*memory[4])))
+
+		joined := 0
+		for _, line := range seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange)) {
+			if line.Position1.X == markerX(*last) && line.Position2.X == markerX(*memory[4]) {
+				joined++
+			}
+		}
+		Expect(joined).To(Equal(1))
+	})
+	It("should move every point along once a full series rolls", func() {
+		Expect(lc.SetSeriesPointLimit("CPU", 4)).To(Succeed())
+		apply("CPU", 30)
+		Expect(lc.GetDataSeries("CPU")).To(HaveLen(5))
+		third := markerX(*cpu[3])
+		apply("CPU", 31)
+		Expect(markerX(*cpu[3])).To(BeNumerically("<", third))
+	})
+	It("should lay out every series again once resized", func() {
+		forget(cpu[2])
+		win.Resize(fyne.NewSize(900, 400))
+		Expect(markerX(*cpu[2])).To(BeNumerically(">", 0))
+	})
+})
@@ -0,0 +1,139 @@
+package sknlinechart
+
+import "math"
+
+// downsamplePixelsPerPoint plot width, in pixels, given to each drawn point once a series is downsampled
+const downsamplePixelsPerPoint float32 = 2
+
+// IsDownsamplingEnabled returns true when series with more points than the plot has room for are downsampled
+func (w *LineChartSkn) IsDownsamplingEnabled() bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.enableDownsampling
+}
+
+// SetDownsampling draws series holding more visible points than the plot's width has room for, one
+// per two pixels, through the points picked by Largest-Triangle-Three-Buckets, so large histories
+// keep their peaks and shape while drawing a few hundred segments. Every point stays in the series
+// and hovering finds any of them. On by default
+func (w *LineChartSkn) SetDownsampling(enable bool) {
+	w.debugLog("LineChartSkn::SetDownsampling()")
+	w.mapsLock.Lock()
+	w.enableDownsampling = enable
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// gridColumns the x axis grid lines and labels, one per index up to XPointLimit
+func (w *LineChartSkn) gridColumns() int {
+	if w.dataPointXLimit > XPointLimit {
+		return XPointLimit
+	}
+	return w.dataPointXLimit
+}
+
+// gridIndex returns the datapoint index under grid column col
+func (w *LineChartSkn) gridIndex(col int) int {
+	columns := w.gridColumns()
+	if columns < 2 {
+		return col
+	}
+	return int(math.Round(float64(col) * float64(w.dataPointXLimit-1) / float64(columns-1)))
+}
+
+// downsampleBudget the points a downsampled series draws across the plot's width
+func (w *LineChartSkn) downsampleBudget() int {
+	budget := int((w.plotMax.X - w.plotMin.X) / downsamplePixelsPerPoint)
+	if budget < 3 {
+		budget = 3
+	}
+	return budget
+}
+
+// downsampleKeep returns which of the series' points to draw, nil when all are drawn;
+// series still being refined after loading draw fewer
+// caller must hold the mapsLock
+func (w *LineChartSkn) downsampleKeep(series string) []bool {
+	refine, refining := w.refining[series]
+	if !w.enableDownsampling && !refining {
+		return nil
+	}
+	budget := w.downsampleBudget()
+	if !w.enableDownsampling || (refining && refine < budget) {
+		budget = refine
+	}
+	data := w.dataPoints[series]
+	if len(data) <= budget {
+		return nil
+	}
+	var indexes []int
+	var xs, ys []float32
+	for idx, point := range data {
+		x := w.pointX(idx, *point)
+		if !isFinite((*point).Value()) || (*point).IsMissing() || !w.isXVisible(x) {
+			continue
+		}
+		indexes = append(indexes, idx)
+		xs = append(xs, x)
+		ys = append(ys, w.plotValue(series, idx, *point))
+	}
+	if len(indexes) <= budget {
+		return nil
+	}
+	keep := make([]bool, len(data))
+	for _, picked := range lttb(xs, ys, budget) {
+		keep[indexes[picked]] = true
+	}
+	return keep
+}
+
+// lttb picks threshold of the points, always the first and last, that best keep the line's visual
+// shape: the points are split into buckets and from each the one forming the largest triangle with
+// the previous pick and the next bucket's average is kept. Returns positions in xs/ys, ascending
+func lttb(xs, ys []float32, threshold int) []int {
+	n := len(xs)
+	if threshold >= n || threshold < 3 {
+		picked := make([]int, n)
+		for i := range picked {
+			picked[i] = i
+		}
+		return picked
+	}
+	picked := make([]int, 0, threshold)
+	picked = append(picked, 0)
+	every := float64(n-2) / float64(threshold-2)
+	a := 0
+	for bucket := 0; bucket < threshold-2; bucket++ {
+		start := int(float64(bucket)*every) + 1
+		end := int(float64(bucket+1)*every) + 1
+		nextStart, nextEnd := end, int(float64(bucket+2)*every)+1
+		if nextEnd > n {
+			nextEnd = n
+		}
+		var avgX, avgY float64
+		for i := nextStart; i < nextEnd; i++ {
+			avgX += float64(xs[i])
+			avgY += float64(ys[i])
+		}
+		if count := nextEnd - nextStart; count > 0 {
+			avgX /= float64(count)
+			avgY /= float64(count)
+		}
+
+		best, bestArea := start, -1.0
+		ax, ay := float64(xs[a]), float64(ys[a])
+		for i := start; i < end; i++ {
+			area := (ax-avgX)*(float64(ys[i])-ay) - (ax-float64(xs[i]))*(avgY-ay)
+			if area < 0 {
+				area = -area
+			}
+			if area > bestArea {
+				best, bestArea = i, area
+			}
+		}
+		picked = append(picked, best)
+		a = best
+	}
+	return append(picked, n-1)
+}
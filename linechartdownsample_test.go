@@ -0,0 +1,81 @@
+package sknlinechart_test
+
+import (
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Downsampling large series", func() {
+	const count = 20000
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	// wave a slow sine with a single spike in its middle
+	wave := func() []*sknlinechart.ChartDatapoint {
+		ts := time.Now().Format(time.RFC1123)
+		points := make([]*sknlinechart.ChartDatapoint, 0, count)
+		for i := 0; i < count; i++ {
+			value := float32(50 + 20*math.Sin(float64(i)/500))
+			if i == count/2+7 {
+				value = 125
+			}
+			point := sknlinechart.NewChartDatapoint(value, theme.ColorOrange, ts)
+			points = append(points, &point)
+		}
+		return points
+	}
+
+	BeforeEach(func() {
+		var err error
+		lc, err = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithXLimit(count), sknlinechart.WithProgressiveLoading(false))) // drawn in full detail at once
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lc.ApplyDataSeries("Wave", wave())).To(Succeed())
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should keep every point but draw a few hundred segments", func() {
+		Expect(lc.GetDataSeries("Wave")).To(HaveLen(count))
+		Expect(lc.IsDownsamplingEnabled()).To(BeTrue())
+
+		lines := seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange))
+		Expect(len(lines)).To(BeNumerically(">", 100))
+		Expect(len(lines)).To(BeNumerically("<", 500))
+	})
+	It("should keep the spike a plain decimation would miss", func() {
+		// peak returns the highest point drawn, the smallest y
+		peak := func() float32 {
+			top := float32(math.MaxFloat32)
+			for _, line := range seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange)) {
+				if line.Position1.Y < top {
+					top = line.Position1.Y
+				}
+			}
+			return top
+		}
+		downsampled := peak()
+		lc.SetDownsampling(false)
+		Expect(downsampled).To(Equal(peak()))
+	})
+	It("should draw every point once downsampling is off", func() {
+		lc.SetDownsampling(false)
+		Expect(lc.IsDownsamplingEnabled()).To(BeFalse())
+		Expect(len(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange)))).To(BeNumerically(">", count/2))
+	})
+	It("should label the x axis across the whole point limit", func() {
+		Expect(visibleText(lc, "19999")).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,199 @@
+package sknlinechart
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"sort"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+)
+
+// SnapshotFormat selects the file type written by chart exports
+type SnapshotFormat int
+
+const (
+	SnapshotPNG SnapshotFormat = iota
+	SnapshotCSV
+	SnapshotSVG
+)
+
+// Extension returns the file extension used for the format
+func (f SnapshotFormat) Extension() string {
+	switch f {
+	case SnapshotCSV:
+		return "csv"
+	case SnapshotSVG:
+		return "svg"
+	default:
+		return "png"
+	}
+}
+
+// writeSnapshot writes the chart in the requested format
+func (w *LineChartSkn) writeSnapshot(out io.Writer, format SnapshotFormat) error {
+	switch format {
+	case SnapshotCSV:
+		return w.writeCSV(out)
+	case SnapshotPNG:
+		return w.writePNG(out)
+	case SnapshotSVG:
+		return w.ExportSVG(out)
+	}
+	return fmt.Errorf("writeSnapshot() unknown format: %d", format)
+}
+
+// writePNG encodes the on-screen image of the chart as png
+func (w *LineChartSkn) writePNG(out io.Writer) error {
+	img, err := w.Snapshot()
+	if err != nil {
+		return err
+	}
+	return png.Encode(out, img)
+}
+
+// Snapshot returns the chart as currently drawn, at the canvas' scale with bounds starting at 0,0,
+// for embedding in PDFs, emails, or web responses generated in-process. The chart must be displayed
+// on a canvas, a window or an offscreen software canvas
+func (w *LineChartSkn) Snapshot() (image.Image, error) {
+	w.debugLog("LineChartSkn::Snapshot() ENTER")
+	app := fyne.CurrentApp()
+	if app == nil {
+		w.debugLog("LineChartSkn::Snapshot() ERROR EXIT")
+		return nil, errors.New("Snapshot() no active fyne application")
+	}
+	c := app.Driver().CanvasForObject(w)
+	if c == nil {
+		w.debugLog("LineChartSkn::Snapshot() ERROR EXIT")
+		return nil, errors.New("Snapshot() chart is not displayed on a canvas")
+	}
+	full := c.Capture()
+	pos := app.Driver().AbsolutePositionForObject(w)
+	size := w.Size()
+	scale := c.Scale()
+	rect := image.Rect(
+		int(pos.X*scale), int(pos.Y*scale),
+		int((pos.X+size.Width)*scale), int((pos.Y+size.Height)*scale)).Intersect(full.Bounds())
+	img := image.NewNRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	draw.Draw(img, img.Bounds(), full, rect.Min, draw.Src)
+	w.debugLog("LineChartSkn::Snapshot() EXIT")
+	return img, nil
+}
+
+// IsExportContextEnabled returns true when exports carry the chart's analytical context with its data: the
+// annotations column in csv, and annotations, time bands, threshold lines, reference regions, and vertical
+// markers in the state written by SaveState
+func (w *LineChartSkn) IsExportContextEnabled() bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.enableExportContext
+}
+
+// SetExportContext includes the analytical context of the data in exports, so a re-imported file
+// reproduces what the analyst saw. Csv exports gain the annotations column. The state written by
+// SaveState carries the annotations, time bands, threshold lines, reference regions, and vertical
+// markers. Disabled, csv holds the points alone and the state leaves these out. Enabled by default
+func (w *LineChartSkn) SetExportContext(enable bool) {
+	w.debugLog("LineChartSkn::SetExportContext()")
+	w.mapsLock.Lock()
+	w.enableExportContext = enable
+	w.mapsLock.Unlock()
+}
+
+// csvAnnotationsColumn trailing csv column holding each index's annotations, written only when some exist
+const csvAnnotationsColumn = "annotations"
+
+// CSVOptions shapes the csv written by ExportCSV
+type CSVOptions struct {
+	Delimiter rune     // separates the fields, a comma when zero; Excel in locales with decimal commas expects ';'
+	AllPoints bool     // every point held, rather than those within the current zoom window
+	Series    []string // the series written, in column order; every series in name order when empty
+}
+
+// ExportCSV writes one row per index with its timestamp and each series' value, empty where a series
+// has no point and NaN for a missing sample, coarsened by the chart's ExportPrivacy, for handing the
+// data to a spreadsheet. While zoomed only the indexes within the zoom window are written, unless
+// opts.AllPoints is set
+func (w *LineChartSkn) ExportCSV(out io.Writer, opts CSVOptions) error {
+	w.debugLog("LineChartSkn::ExportCSV() ENTER")
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+
+	names := opts.Series
+	if len(names) == 0 {
+		for key := range w.dataPoints {
+			names = append(names, key)
+		}
+		sort.Strings(names)
+	}
+	rows := 0
+	for _, name := range names {
+		points, ok := w.dataPoints[name]
+		if !ok {
+			w.debugLog("LineChartSkn::ExportCSV() ERROR EXIT")
+			return fmt.Errorf("ExportCSV() unknown series: %s", name)
+		}
+		if len(points) > rows {
+			rows = len(points)
+		}
+	}
+	zoomed := w.viewport != nil && !opts.AllPoints
+
+	header := append([]string{"index", "timestamp"}, names...)
+	privacy := w.exportPrivacy
+	annotated := w.enableExportContext && len(w.annotations) > 0 && !privacy.StripMetadata
+	if annotated {
+		header = append(header, csvAnnotationsColumn)
+	}
+	cw := csv.NewWriter(out)
+	if opts.Delimiter != 0 {
+		cw.Comma = opts.Delimiter
+	}
+	err := cw.Write(header)
+	if err != nil {
+		return err
+	}
+	for idx := 0; idx < rows; idx++ {
+		record := make([]string, len(header))
+		record[0] = strconv.Itoa(idx)
+		shown := false
+		for col, name := range names {
+			points := w.dataPoints[name]
+			if idx >= len(points) || (zoomed && !w.isXVisible(w.pointX(idx, *points[idx]))) {
+				continue
+			}
+			shown = true
+			if record[1] == "" {
+				record[1] = privacy.timestamp((*points[idx]).Timestamp())
+			}
+			if (*points[idx]).IsMissing() {
+				record[col+2] = "NaN"
+				continue
+			}
+			record[col+2] = strconv.FormatFloat(float64(privacy.value((*points[idx]).Value())), 'f', -1, 32)
+		}
+		if !shown { // outside the zoom window
+			continue
+		}
+		if annotated {
+			record[len(record)-1] = w.annotationsAt(idx, names)
+		}
+		err = cw.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	w.debugLog("LineChartSkn::ExportCSV() EXIT")
+	return cw.Error()
+}
+
+// writeCSV writes every point of every series for csv snapshots
+func (w *LineChartSkn) writeCSV(out io.Writer) error {
+	return w.ExportCSV(out, CSVOptions{AllPoints: true})
+}
@@ -0,0 +1,86 @@
+package sknlinechart
+
+import (
+	"image/color"
+)
+
+// areaFillOpacity opacity of an area fill drawn in its series' color
+const areaFillOpacity float32 = 0.3
+
+// SetSeriesFill shades the region between the series and the zero baseline, the classic area chart
+// of monitoring dashboards, in fillColor or when nil a translucent shade of the series color.
+// Disabling removes the fill
+func (w *LineChartSkn) SetSeriesFill(seriesName string, enabled bool, fillColor color.Color) {
+	w.debugLog("LineChartSkn::SetSeriesFill()")
+	w.mapsLock.Lock()
+	if enabled {
+		if w.seriesFills == nil {
+			w.seriesFills = map[string]color.Color{}
+		}
+		w.seriesFills[seriesName] = fillColor
+	} else {
+		delete(w.seriesFills, seriesName)
+	}
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// GetSeriesFill returns true with the fill color, nil for a shade of the series color, when the series is filled
+func (w *LineChartSkn) GetSeriesFill(seriesName string) (bool, color.Color) {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	fillColor, ok := w.seriesFills[seriesName]
+	return ok, fillColor
+}
+
+// layoutFills collects the area between each filled, shown series and the baseline, or each stacked
+// series and the layer below it, as bands for the band raster, broken where the series' line is
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutFills() []confidenceBand {
+	var bands []confidenceBand
+	filled := map[string]color.Color{}
+	for series := range r.widget.stack {
+		filled[series] = nil
+	}
+	for series, fillColor := range r.widget.seriesFills {
+		filled[series] = fillColor
+	}
+	for series, fillColor := range filled {
+		data := r.widget.dataPoints[series]
+		if r.widget.hiddenSeries[series] || len(data) < 2 {
+			continue
+		}
+		var band confidenceBand
+		for idx := 1; idx < len(data); idx++ {
+			prev, point := data[idx-1], data[idx]
+			x1, x2 := r.widget.pointX(idx-1, *prev), r.widget.pointX(idx, *point)
+			if !isDrawable(*prev) || !isDrawable(*point) || r.widget.isGapBefore(series, idx) ||
+				!r.widget.isXVisible(x1) || !r.widget.isXVisible(x2) {
+				continue
+			}
+			base1 := r.widget.dataToPosition(x1, r.widget.plotBase(series, idx-1)).Subtract(r.widget.plotMin)
+			line1 := r.widget.dataToPosition(x1, r.widget.plotValue(series, idx-1, *prev)).Subtract(r.widget.plotMin)
+			base2 := r.widget.dataToPosition(x2, r.widget.plotBase(series, idx)).Subtract(r.widget.plotMin)
+			line2 := r.widget.dataToPosition(x2, r.widget.plotValue(series, idx, *point)).Subtract(r.widget.plotMin)
+			band.segments = append(band.segments, bandSegment{
+				x1: base1.X, lower1: base1.Y, upper1: line1.Y,
+				x2: base2.X, lower2: base2.Y, upper2: line2.Y,
+			})
+		}
+		if len(band.segments) == 0 {
+			continue
+		}
+		band.color = r.widget.cvdColor(fillColor)
+		if band.color == nil {
+			band.color = fadeColor(r.seriesColor(series, data[len(data)-1]), areaFillOpacity)
+		}
+		bands = append(bands, band)
+	}
+	return bands
+}
+
+// isDrawable true when the datapoint has a finite value and is not a missing sample
+func isDrawable(point ChartDatapoint) bool {
+	return isFinite(point.Value()) && !point.IsMissing()
+}
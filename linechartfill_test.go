@@ -0,0 +1,87 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Area fill under a series", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+		green  = color.NRGBA{R: 0, G: 0xc0, B: 0, A: 0x80}
+	)
+
+	bandRaster := func() *canvas.Raster {
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if r, ok := o.(*canvas.Raster); ok {
+				return r
+			}
+		}
+		return nil
+	}
+
+	// alphaBelow returns the raster's alpha offset pixels below the point's marker
+	alphaBelow := func(point *sknlinechart.ChartDatapoint, offset int) uint32 {
+		raster := bandRaster()
+		size := raster.Size()
+		img := raster.Generator(int(size.Width), int(size.Height))
+		top, _ := (*point).MarkerPosition()
+		x := int(top.X + 2 - raster.Position().X)
+		y := int(top.Y + 2 - raster.Position().Y)
+		_, _, _, a := img.At(x, y+offset).RGBA()
+		return a
+	}
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 20; i++ {
+			point := sknlinechart.NewChartDatapoint(60, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Traffic": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should shade between the series and the baseline only", func() {
+		Expect(bandRaster().Visible()).To(BeFalse())
+		lc.SetSeriesFill("Traffic", true, green)
+		enabled, c := lc.GetSeriesFill("Traffic")
+		Expect(enabled).To(BeTrue())
+		Expect(c).To(Equal(green))
+
+		Expect(bandRaster().Visible()).To(BeTrue())
+		Expect(alphaBelow(points[10], 20)).To(BeNumerically(">", 0))
+		Expect(alphaBelow(points[10], -20)).To(BeZero())
+
+		lc.SetSeriesFill("Traffic", false, nil)
+		Expect(bandRaster().Visible()).To(BeFalse())
+	})
+	It("should shade in the series color when no fill color is given", func() {
+		lc.SetSeriesFill("Traffic", true, nil)
+		enabled, c := lc.GetSeriesFill("Traffic")
+		Expect(enabled).To(BeTrue())
+		Expect(c).To(BeNil())
+		Expect(alphaBelow(points[10], 20)).To(BeNumerically(">", 0))
+	})
+	It("should keep fills in saved state", func() {
+		lc.SetSeriesFill("Traffic", true, green)
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		enabled, c := restored.GetSeriesFill("Traffic")
+		Expect(enabled).To(BeTrue())
+		Expect(c).To(Equal(green))
+	})
+})
@@ -0,0 +1,74 @@
+package sknlinechart
+
+import (
+	"fmt"
+)
+
+const (
+	forecastDashLength    float32 = 6    // pixel length of each dash of a forecast line
+	forecastDashGap       float32 = 4    // pixel gap between forecast dashes
+	forecastRegionOpacity float32 = 0.08 // opacity of the shading over the future region
+)
+
+// forecastDashPattern dash and gap of forecast lines
+var forecastDashPattern = []float32{forecastDashLength, forecastDashGap}
+
+// SetForecastSeries draws predicted points for the base series beyond its latest datapoint, the "now"
+// boundary, as a dashed line over a shaded future region. Each datapoint later applied to the base
+// series replaces the first forecast point. Empty points removes the forecast
+func (w *LineChartSkn) SetForecastSeries(base string, points []ChartDatapoint) error {
+	w.debugLog("LineChartSkn::SetForecastSeries() ENTER")
+	w.mapsLock.Lock()
+	actual, ok := w.dataPoints[base]
+	var err error
+	switch {
+	case !ok:
+		err = fmt.Errorf("SetForecastSeries() series not found: %s", base)
+	case len(points) == 0:
+		delete(w.forecasts, base)
+	case len(actual)+len(points) > w.dataPointXLimit:
+		err = fmt.Errorf("SetForecastSeries() [%s] datapoints limit exceeded. limit:%d, count:%d", base, w.dataPointXLimit, len(actual)+len(points))
+	default:
+		if w.forecasts == nil {
+			w.forecasts = map[string][]ChartDatapoint{}
+		}
+		forecast := make([]ChartDatapoint, 0, len(points))
+		for _, point := range points {
+			forecast = append(forecast, point.Copy())
+		}
+		w.forecasts[base] = forecast
+	}
+	w.mapsLock.Unlock()
+	if err != nil {
+		w.debugLog("LineChartSkn::SetForecastSeries() ERROR EXIT")
+		return err
+	}
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetForecastSeries() EXIT")
+	return nil
+}
+
+// GetForecastSeries returns a copy of the forecast points of the base series
+func (w *LineChartSkn) GetForecastSeries(base string) []ChartDatapoint {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	var points []ChartDatapoint
+	for _, point := range w.forecasts[base] {
+		points = append(points, point.Copy())
+	}
+	return points
+}
+
+// consumeForecast drops the forecast point overtaken by a new actual datapoint
+// caller must hold the mapsLock
+func (w *LineChartSkn) consumeForecast(base string) {
+	forecast, ok := w.forecasts[base]
+	if !ok {
+		return
+	}
+	if len(forecast) <= 1 {
+		delete(w.forecasts, base)
+		return
+	}
+	w.forecasts[base] = forecast[1:]
+}
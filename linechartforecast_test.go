@@ -0,0 +1,80 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Forecast series", func() {
+	var (
+		lc       sknlinechart.LineChart
+		points   []*sknlinechart.ChartDatapoint
+		forecast []sknlinechart.ChartDatapoint
+	)
+
+	// futureRegion returns the visible shading beyond the "now" boundary, nil when none
+	futureRegion := func() *canvas.Rectangle {
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if r, ok := o.(*canvas.Rectangle); ok && r.Visible() && r.Size().Height > 100 {
+				return r
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		points = nil
+		forecast = nil
+		for i := 0; i < 20; i++ {
+			point := sknlinechart.NewChartDatapoint(50, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		for i := 0; i < 5; i++ {
+			forecast = append(forecast, sknlinechart.NewChartDatapoint(float32(55+i*5), theme.ColorBlue, time.Now().Format(time.RFC1123)))
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should reject unknown series and forecasts past the x limit", func() {
+		Expect(lc.SetForecastSeries("Unknown", forecast)).To(HaveOccurred())
+		long := make([]sknlinechart.ChartDatapoint, 131)
+		for i := range long {
+			long[i] = sknlinechart.NewChartDatapoint(50, theme.ColorBlue, time.Now().Format(time.RFC1123))
+		}
+		Expect(lc.SetForecastSeries("Testing", long)).To(HaveOccurred())
+		Expect(lc.GetForecastSeries("Testing")).To(BeEmpty())
+	})
+	It("should draw dashes over a shaded region beyond the latest datapoint", func() {
+		Expect(futureRegion()).To(BeNil())
+		actualLines := len(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue)))
+
+		Expect(lc.SetForecastSeries("Testing", forecast)).To(Succeed())
+		Expect(len(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue)))).To(BeNumerically(">", actualLines+5))
+		region := futureRegion()
+		Expect(region).NotTo(BeNil())
+		top, bottom := (*points[19]).MarkerPosition()
+		Expect(region.Position().X).To(BeNumerically("~", (top.X+bottom.X)/2, 1))
+
+		Expect(lc.SetForecastSeries("Testing", nil)).To(Succeed())
+		Expect(futureRegion()).To(BeNil())
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(HaveLen(actualLines))
+	})
+	It("should replace forecast points as actual datapoints arrive", func() {
+		Expect(lc.SetForecastSeries("Testing", forecast)).To(Succeed())
+		point := sknlinechart.NewChartDatapoint(56, theme.ColorBlue, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Testing", &point)
+
+		remaining := lc.GetForecastSeries("Testing")
+		Expect(remaining).To(HaveLen(4))
+		Expect(remaining[0].Value()).To(BeNumerically("==", 60))
+	})
+})
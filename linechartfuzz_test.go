@@ -0,0 +1,81 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"github.com/skoona/sknlinechart"
+)
+
+// fuzzChart returns a chart shown in a test window, so ingestion runs through layout and rendering
+func fuzzChart(t *testing.T) sknlinechart.LineChart {
+	lc, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := test.NewWindow(lc)
+	t.Cleanup(w.Close)
+	t.Cleanup(func() { stopRedraws(lc) })
+	w.Resize(fyne.NewSize(600, 300))
+	return lc
+}
+
+// FuzzApplyDataPoint feeds hostile values, names, colors, and timestamps through every ingest path
+func FuzzApplyDataPoint(f *testing.F) {
+	f.Add("Series", float32(50), "blue", "Thu, 01 Jan 2026 00:00:00 UTC", 3)
+	f.Add("", float32(math.NaN()), "", "", 0)
+	f.Add("Inf", float32(math.Inf(1)), "red", "Fri, 31 Dec 99999 23:59:59 UTC", 200)
+	f.Add("Neg", float32(math.Inf(-1)), "no-such-color", "2006-01-02T15:04:05Z", -5)
+	f.Add("Huge", float32(math.MaxFloat32), "blue", "Mon, 01 Jan 0001 00:00:00 UTC", 1000)
+	f.Add(strings.Repeat("long", 4096), float32(-math.MaxFloat32), strings.Repeat("c", 512), strings.Repeat("☃", 4096), 2)
+
+	test.NewApp()
+	f.Fuzz(func(t *testing.T, series string, value float32, colorName, timestamp string, count int) {
+		lc := fuzzChart(t)
+		if count < 0 {
+			count = -count
+		}
+		count %= 300
+		for i := 0; i < count; i++ {
+			point := sknlinechart.NewChartDatapoint(value*float32(i), colorName, timestamp)
+			lc.ApplyDataPoint(series, &point)
+		}
+		point := sknlinechart.NewChartDatapoint(value, colorName, timestamp)
+		lc.ApplyDataPoint(series, nil)
+		lc.ApplyDataPoints(series+"2", []sknlinechart.ChartDatapoint{point, nil})
+		_ = lc.ApplyDataSeries(series+"3", []*sknlinechart.ChartDatapoint{&point, nil})
+		_ = lc.SetTimeWindow(time.Second)
+		lc.(desktop.Hoverable).MouseMoved(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(300, 150)}})
+		lc.Refresh()
+
+		var state bytes.Buffer
+		if err := lc.SaveState(&state); err != nil {
+			t.Fatal(err)
+		}
+		if err := fuzzChart(t).LoadState(&state); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// FuzzLoadState loads arbitrary documents as chart state, which must fail cleanly rather than panic
+func FuzzLoadState(f *testing.F) {
+	f.Add([]byte(`{"schemaVersion":1,"series":{"a":[{"value":1,"colorName":"blue","timestamp":"x"}]}}`))
+	f.Add([]byte(`{"series":{"a":[{"value":1e39}]},"gaps":{"a":[-1,99999]}}`))
+	f.Add([]byte(`{"schemaVersion":1,"annotations":[{"series":"a","index":-5}],"seriesMetadata":{"":{}}}`))
+	f.Add([]byte(`{"schemaVersion":-1}`))
+	f.Add([]byte(`[]`))
+
+	test.NewApp()
+	f.Fuzz(func(t *testing.T, doc []byte) {
+		lc := fuzzChart(t)
+		_ = lc.LoadState(bytes.NewReader(doc))
+		lc.Refresh()
+	})
+}
@@ -0,0 +1,133 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"sort"
+)
+
+// InsertGap breaks the lines of the named series before their next datapoint, so the flat line
+// across a data source outage is not mistaken for real data. Call it when a source disconnects
+// or reconnects; series without datapoints are ignored
+func (w *LineChartSkn) InsertGap(seriesNames ...string) {
+	w.debugLog("LineChartSkn::InsertGap() ENTER")
+	w.mapsLock.Lock()
+	for _, name := range seriesNames {
+		next := len(w.dataPoints[name])
+		if next == 0 {
+			continue
+		}
+		gaps := w.gaps[name]
+		if len(gaps) > 0 && gaps[len(gaps)-1] == next {
+			continue // already broken before the next datapoint
+		}
+		if w.gaps == nil {
+			w.gaps = map[string][]int{}
+		}
+		w.gaps[name] = append(gaps, next)
+	}
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::InsertGap() EXIT")
+}
+
+// GetGaps returns the indexes of the series' datapoints that follow a gap
+func (w *LineChartSkn) GetGaps(seriesName string) []int {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	var gaps []int
+	for _, idx := range w.gaps[seriesName] {
+		if idx < len(w.dataPoints[seriesName]) { // pending until the next datapoint arrives
+			gaps = append(gaps, idx)
+		}
+	}
+	return gaps
+}
+
+// IsGapMarkersEnabled returns true when a vertical event marker is drawn at each gap
+func (w *LineChartSkn) IsGapMarkersEnabled() bool {
+	return w.enableGapMarkers
+}
+
+// SetGapMarkers draws a vertical event marker across the plot at each gap
+func (w *LineChartSkn) SetGapMarkers(enable bool) {
+	w.enableGapMarkers = enable
+	w.Refresh()
+}
+
+// isGapBefore returns true when the line into the datapoint at index is broken
+// caller must hold the mapsLock
+func (w *LineChartSkn) isGapBefore(seriesName string, index int) bool {
+	for _, idx := range w.gaps[seriesName] {
+		if idx == index {
+			return true
+		}
+	}
+	return false
+}
+
+// gapIndexes returns the sorted indexes following a gap in any shown series
+// caller must hold the mapsLock
+func (w *LineChartSkn) gapIndexes() []int {
+	seen := map[int]bool{}
+	var indexes []int
+	for name, gaps := range w.gaps {
+		if w.hiddenSeries[name] {
+			continue
+		}
+		for _, idx := range gaps {
+			if !seen[idx] && idx < len(w.dataPoints[name]) {
+				seen[idx] = true
+				indexes = append(indexes, idx)
+			}
+		}
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// shiftGaps follows a series whose oldest datapoint rolled off, dropping gaps that reach the first point
+// caller must hold the mapsLock
+func (w *LineChartSkn) shiftGaps(seriesName string) {
+	gaps, ok := w.gaps[seriesName]
+	if !ok {
+		return
+	}
+	kept := gaps[:0]
+	for _, idx := range gaps {
+		if idx > 1 {
+			kept = append(kept, idx-1)
+		}
+	}
+	if len(kept) == 0 {
+		delete(w.gaps, seriesName)
+		return
+	}
+	w.gaps[seriesName] = kept
+}
+
+// pointValueText formats the datapoint's value for popups and readouts, noting a missing sample
+// and following the value with the range of its envelope
+// caller must hold the mapsLock
+func (w *LineChartSkn) pointValueText(seriesName string, point ChartDatapoint) string {
+	if point.IsMissing() {
+		return "missing"
+	}
+	if lower, upper, ok := point.Bounds(); ok {
+		return fmt.Sprint(w.valueText(seriesName, point.Value()), " (", lower, "–", upper, ")")
+	}
+	return w.valueText(seriesName, point.Value())
+}
+
+// plotValue returns the value the datapoint is drawn at: the top of its layer when stacking, otherwise
+// its value; a cursor on a missing sample is drawn at the bottom of the viewport
+// caller must hold the mapsLock
+func (w *LineChartSkn) plotValue(seriesName string, index int, point ChartDatapoint) float32 {
+	if point.IsMissing() {
+		return w.currentViewport().YMin
+	}
+	if layer, ok := w.stack[seriesName]; ok && index < len(layer.top) {
+		return layer.top[index]
+	}
+	return point.Value()
+}
@@ -0,0 +1,99 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Gaps on data source outages", func() {
+	var lc sknlinechart.LineChart
+
+	apply := func(count int) {
+		for i := 0; i < count; i++ {
+			point := sknlinechart.NewChartDatapoint(50, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("Testing", &point)
+		}
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithRefreshInterval(0))) // redraw on every point
+		lc.Resize(fyne.NewSize(800, 400))
+		apply(20)
+	})
+
+	It("should break the line before the next datapoint", func() {
+		lines := len(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue)))
+		lc.InsertGap("Testing", "Unknown")
+		lc.InsertGap("Testing") // reconnect after disconnect, still one gap
+		Expect(lc.GetGaps("Testing")).To(BeEmpty())
+
+		apply(5)
+		Expect(lc.GetGaps("Testing")).To(Equal([]int{20}))
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(HaveLen(lines + 4))
+	})
+	It("should draw a vertical event marker at each gap when enabled", func() {
+		lc.InsertGap("Testing")
+		apply(5)
+		Expect(seriesLines(lc, theme.ErrorColor())).To(BeEmpty())
+
+		lc.SetGapMarkers(true)
+		markers := seriesLines(lc, theme.ErrorColor())
+		Expect(markers).To(HaveLen(1))
+		Expect(markers[0].Position1.X).To(Equal(markers[0].Position2.X))
+	})
+	It("should follow rolling data and drop gaps that roll off", func() {
+		lc.InsertGap("Testing")
+		apply(141) // the series holds 151 points, the last 10 rolled off the oldest
+		Expect(lc.GetGaps("Testing")).To(Equal([]int{10}))
+		apply(10)
+		Expect(lc.GetGaps("Testing")).To(BeEmpty())
+	})
+	It("should mark dropouts played by a simulated source", func() {
+		scenario, err := sknlinechart.ParseScenario("series Testing blue\ninterval 1s\nsteady 20 for 5s\ndropout 10s\nsteady 30 for 5s")
+		Expect(err).NotTo(HaveOccurred())
+		samples := scenario.Samples()
+		Expect(samples[5].AfterDropout).To(BeTrue())
+		Expect(samples[4].AfterDropout).To(BeFalse())
+
+		sknlinechart.NewSimulatedSource(scenario).Fill(lc, time.Now())
+		Expect(lc.GetGaps("Testing")).To(Equal([]int{25}))
+	})
+	It("should break the line around missing samples", func() {
+		lines := len(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue)))
+		nan := sknlinechart.NewChartDatapoint(float32(math.NaN()), theme.ColorBlue, time.Now().Format(time.RFC1123))
+		missing := sknlinechart.NewMissingDatapoint(theme.ColorBlue, time.Now().Format(time.RFC1123))
+		Expect(nan.IsMissing()).To(BeTrue())
+		Expect(missing.Copy().IsMissing()).To(BeTrue())
+
+		lc.ApplyDataPoints("Testing", []sknlinechart.ChartDatapoint{nan, missing})
+		apply(2)
+		Expect(lc.GetDataSeries("Testing")).To(HaveLen(24))
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(HaveLen(lines + 1))
+		top, _ := missing.MarkerPosition()
+		Expect(top.IsZero()).To(BeTrue())
+
+		missing.SetMissing(false)
+		lc.SetViewport(lc.GetViewport()) // relayout the series
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(HaveLen(lines + 2))
+	})
+	It("should keep missing samples through a saved state", func() {
+		missing := sknlinechart.NewMissingDatapoint(theme.ColorBlue, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Testing", &missing)
+		var state bytes.Buffer
+		Expect(lc.SaveState(&state)).To(Succeed())
+
+		restored, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		Expect(restored.LoadState(&state)).To(Succeed())
+		series := restored.GetDataSeries("Testing")
+		Expect(series).To(HaveLen(21))
+		Expect(series[20].IsMissing()).To(BeTrue())
+		Expect(series[19].IsMissing()).To(BeFalse())
+	})
+})
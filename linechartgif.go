@@ -0,0 +1,167 @@
+package sknlinechart
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"io"
+	"log/slog"
+	"time"
+)
+
+// maxRecordingFrames frames kept by a recording, the oldest are dropped beyond it so a recording
+// left running holds the latest clip rather than growing without bound
+const maxRecordingFrames = 600
+
+// gifRecording background frame capture state
+type gifRecording struct {
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}     // closed once the capture goroutine returns
+	frames   []*image.Paletted // written by the capture goroutine alone, read once it is done
+	indexes  map[uint32]uint8  // palette index of each color met, charts draw with few colors
+}
+
+// StartRecording captures a frame of the live chart every interval, as Snapshot draws it, until
+// StopRecording encodes them as an animated gif; the latest 600 frames are kept. Replaces any
+// recording already running, discarding its frames
+func (w *LineChartSkn) StartRecording(interval time.Duration) error {
+	w.debugLog("LineChartSkn::StartRecording() ENTER")
+	if interval <= 0 {
+		w.debugLog("LineChartSkn::StartRecording() ERROR EXIT")
+		return fmt.Errorf("StartRecording() interval must be positive: %v", interval)
+	}
+	rec := &gifRecording{
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+		indexes:  map[uint32]uint8{},
+	}
+	w.gifLock.Lock()
+	previous := w.gif
+	w.gif = rec
+	w.gifLock.Unlock()
+	if previous != nil {
+		close(previous.stop)
+		<-previous.done
+	}
+
+	go w.runRecording(rec)
+
+	w.debugLog("LineChartSkn::StartRecording() EXIT")
+	return nil
+}
+
+// StopRecording stops capturing frames and writes those captured as an animated gif looping
+// forever, each frame shown for the recording interval
+func (w *LineChartSkn) StopRecording(out io.Writer) error {
+	w.debugLog("LineChartSkn::StopRecording() ENTER")
+	w.gifLock.Lock()
+	rec := w.gif
+	w.gif = nil
+	w.gifLock.Unlock()
+	if rec == nil {
+		w.debugLog("LineChartSkn::StopRecording() ERROR EXIT")
+		return errors.New("StopRecording() no recording is running")
+	}
+	close(rec.stop)
+	<-rec.done
+
+	if len(rec.frames) == 0 {
+		w.debugLog("LineChartSkn::StopRecording() ERROR EXIT")
+		return errors.New("StopRecording() no frames were captured")
+	}
+	delay := int(rec.interval / (10 * time.Millisecond)) // hundredths of a second
+	if delay < 2 {
+		delay = 2 // viewers slow down shorter delays
+	}
+	anim := &gif.GIF{}
+	for _, frame := range rec.frames {
+		anim.Image = append(anim.Image, frame)
+		anim.Delay = append(anim.Delay, delay)
+		size := frame.Bounds().Max
+		if size.X > anim.Config.Width {
+			anim.Config.Width = size.X
+		}
+		if size.Y > anim.Config.Height {
+			anim.Config.Height = size.Y
+		}
+	}
+	anim.Config.ColorModel = color.Palette(palette.Plan9)
+	err := gif.EncodeAll(out, anim)
+	if err != nil {
+		w.debugLog("LineChartSkn::StopRecording() ERROR EXIT")
+		return fmt.Errorf("StopRecording() encoding gif: %w", err)
+	}
+	w.debugLog("LineChartSkn::StopRecording() EXIT")
+	return nil
+}
+
+// IsRecording returns true while frames are being captured for an animated gif
+func (w *LineChartSkn) IsRecording() bool {
+	w.gifLock.Lock()
+	defer w.gifLock.Unlock()
+	return w.gif != nil
+}
+
+// runRecording captures a frame at once, then every interval until stopped
+func (w *LineChartSkn) runRecording(rec *gifRecording) {
+	defer close(rec.done)
+	ticker := time.NewTicker(rec.interval)
+	defer ticker.Stop()
+	for {
+		err := w.captureFrame(rec)
+		if err != nil {
+			slog.Warn("recording frame failed", "error", err.Error())
+		}
+		select {
+		case <-rec.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// captureFrame adds the chart as drawn to the recording, reduced to the gif palette
+func (w *LineChartSkn) captureFrame(rec *gifRecording) error {
+	img, err := w.Snapshot()
+	if err != nil {
+		return err
+	}
+	frame := image.NewPaletted(img.Bounds(), palette.Plan9)
+	if src, ok := img.(*image.NRGBA); ok {
+		rec.reduce(frame, src)
+	} else {
+		draw.Draw(frame, frame.Bounds(), img, img.Bounds().Min, draw.Src)
+	}
+	if len(rec.frames) == maxRecordingFrames {
+		rec.frames[0] = nil
+		rec.frames = rec.frames[1:]
+	}
+	rec.frames = append(rec.frames, frame)
+	return nil
+}
+
+// reduce sets each pixel of the frame to the palette color nearest the source's, looking each
+// color up once per recording
+func (rec *gifRecording) reduce(frame *image.Paletted, src *image.NRGBA) {
+	bounds := src.Bounds()
+	for y := 0; y < bounds.Dy(); y++ {
+		row := src.Pix[y*src.Stride : y*src.Stride+bounds.Dx()*4]
+		out := frame.Pix[y*frame.Stride:]
+		for x := 0; x < bounds.Dx(); x++ {
+			p := row[x*4 : x*4+4]
+			key := uint32(p[0])<<24 | uint32(p[1])<<16 | uint32(p[2])<<8 | uint32(p[3])
+			idx, ok := rec.indexes[key]
+			if !ok {
+				idx = uint8(frame.Palette.Index(color.NRGBA{R: p[0], G: p[1], B: p[2], A: p[3]}))
+				rec.indexes[key] = idx
+			}
+			out[x] = idx
+		}
+	}
+}
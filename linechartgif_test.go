@@ -0,0 +1,53 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"image/gif"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Animated gif recording", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Recording", "Clip", 20)
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should reject a zero interval and a stop without a recording", func() {
+		Expect(lc.StartRecording(0)).To(HaveOccurred())
+		Expect(lc.IsRecording()).To(BeFalse())
+		var out bytes.Buffer
+		Expect(lc.StopRecording(&out)).To(HaveOccurred())
+		Expect(out.Len()).To(BeZero())
+	})
+	It("should encode the captured frames as a gif", func() {
+		Expect(lc.StartRecording(20 * time.Millisecond)).To(Succeed())
+		Expect(lc.IsRecording()).To(BeTrue())
+		time.Sleep(60 * time.Millisecond)
+
+		var out bytes.Buffer
+		Expect(lc.StopRecording(&out)).To(Succeed())
+		Expect(lc.IsRecording()).To(BeFalse())
+
+		anim, err := gif.DecodeAll(&out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(anim.Image).NotTo(BeEmpty(), "a frame is captured as recording starts")
+		Expect(anim.Delay).To(HaveEach(2))
+		Expect(float32(anim.Config.Width)).To(Equal(lc.Size().Width))
+		Expect(float32(anim.Config.Height)).To(Equal(lc.Size().Height))
+	})
+})
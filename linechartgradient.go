@@ -0,0 +1,85 @@
+package sknlinechart
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+)
+
+// ColorGradient colors a series' points and the segments leading to them by value, blending from Low
+// at Min to High at Max; values beyond the range take the nearer end's color
+type ColorGradient struct {
+	Low  color.Color
+	High color.Color
+	Min  float32
+	Max  float32
+}
+
+// validate checks the gradient has both colors and a value range
+func (g ColorGradient) validate() error {
+	if g.Low == nil || g.High == nil {
+		return errors.New("gradient needs both low and high colors")
+	}
+	if !isFinite(g.Min) || !isFinite(g.Max) || g.Max <= g.Min {
+		return fmt.Errorf("gradient max must be greater than min: %v-%v", g.Min, g.Max)
+	}
+	return nil
+}
+
+// at returns the gradient's color for the value
+func (g ColorGradient) at(value float32) color.Color {
+	fraction := (value - g.Min) / (g.Max - g.Min)
+	if fraction < 0 || !isFinite(fraction) {
+		fraction = 0
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	low := color.NRGBAModel.Convert(g.Low).(color.NRGBA)
+	high := color.NRGBAModel.Convert(g.High).(color.NRGBA)
+	blend := func(a, b uint8) uint8 {
+		return uint8(float32(a) + (float32(b)-float32(a))*fraction + 0.5)
+	}
+	return color.NRGBA{R: blend(low.R, high.R), G: blend(low.G, high.G), B: blend(low.B, high.B), A: blend(low.A, high.A)}
+}
+
+// SetSeriesColorGradient colors each point of the series, and the segment leading to it, on a smooth
+// scale between two colors by its value, such as green when low to red when high, so threshold breaches
+// stand out without a separate alert line. A color rule naming a color takes precedence; nil removes the gradient
+func (w *LineChartSkn) SetSeriesColorGradient(seriesName string, gradient *ColorGradient) error {
+	w.debugLog("LineChartSkn::SetSeriesColorGradient() ENTER")
+	if gradient != nil {
+		if err := gradient.validate(); err != nil {
+			w.debugLog("LineChartSkn::SetSeriesColorGradient() ERROR EXIT")
+			return fmt.Errorf("SetSeriesColorGradient() [%s] %w", seriesName, err)
+		}
+	}
+	w.mapsLock.Lock()
+	w.applySeriesGradient(seriesName, gradient)
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetSeriesColorGradient() EXIT")
+	return nil
+}
+
+// GetSeriesColorGradient returns the series' value gradient, false when it has none
+func (w *LineChartSkn) GetSeriesColorGradient(seriesName string) (ColorGradient, bool) {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	gradient, ok := w.seriesGradients[seriesName]
+	return gradient, ok
+}
+
+// applySeriesGradient stores or removes the series' gradient
+// caller must hold the mapsLock
+func (w *LineChartSkn) applySeriesGradient(seriesName string, gradient *ColorGradient) {
+	if gradient == nil {
+		delete(w.seriesGradients, seriesName)
+		return
+	}
+	if w.seriesGradients == nil {
+		w.seriesGradients = map[string]ColorGradient{}
+	}
+	w.seriesGradients[seriesName] = *gradient
+}
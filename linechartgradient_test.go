@@ -0,0 +1,70 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Series color gradients", func() {
+	var (
+		lc       sknlinechart.LineChart
+		low      = color.NRGBA{R: 0, G: 0, B: 0xff, A: 0xff}
+		high     = color.NRGBA{R: 0xff, G: 0, B: 0, A: 0xff}
+		gradient = &sknlinechart.ColorGradient{Low: low, High: high, Min: 0, Max: 100}
+	)
+
+	BeforeEach(func() {
+		var points []*sknlinechart.ChartDatapoint
+		for _, value := range []float32{0, 50, 100, 140} {
+			point := sknlinechart.NewChartDatapoint(value, theme.ColorOrange, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Load": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should blend each segment's color by the value it leads to", func() {
+		Expect(lc.SetSeriesColorGradient("Load", gradient)).To(Succeed())
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange))).To(BeEmpty())
+		Expect(seriesLines(lc, low)).To(HaveLen(1))
+		Expect(seriesLines(lc, color.NRGBA{R: 0x80, B: 0x80, A: 0xff})).To(HaveLen(1))
+		Expect(seriesLines(lc, high)).To(HaveLen(2)) // clamped beyond the range
+
+		Expect(lc.SetSeriesColorGradient("Load", nil)).To(Succeed())
+		_, ok := lc.GetSeriesColorGradient("Load")
+		Expect(ok).To(BeFalse())
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange))).To(HaveLen(4))
+	})
+	It("should give way to a color rule naming a color", func() {
+		Expect(lc.SetSeriesColorGradient("Load", gradient)).To(Succeed())
+		lc.SetSeriesColorRule("Load", func(v float64) string {
+			if v > 120 {
+				return theme.ColorPurple
+			}
+			return ""
+		})
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorPurple))).To(HaveLen(1))
+		Expect(seriesLines(lc, high)).To(HaveLen(1))
+	})
+	It("should reject incomplete gradients and keep gradients in saved state", func() {
+		Expect(lc.SetSeriesColorGradient("Load", &sknlinechart.ColorGradient{Low: low, Min: 0, Max: 1})).To(HaveOccurred())
+		Expect(lc.SetSeriesColorGradient("Load", &sknlinechart.ColorGradient{Low: low, High: high, Min: 5, Max: 5})).To(HaveOccurred())
+		Expect(lc.SetSeriesColorGradient("Load", gradient)).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		got, ok := restored.GetSeriesColorGradient("Load")
+		Expect(ok).To(BeTrue())
+		Expect(got).To(Equal(*gradient))
+	})
+})
@@ -0,0 +1,156 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+const (
+	defaultHighlightDimOpacity float32 = 0.25 // opacity of the other series while one is highlighted
+	highlightStrokeMultiplier  float32 = 2.0  // stroke width multiplier of the highlighted series
+)
+
+// IsHoverHighlightEnabled returns true when the series nearest the pointer is emphasized
+func (w *LineChartSkn) IsHoverHighlightEnabled() bool {
+	return w.enableHoverHighlight
+}
+
+// SetHoverHighlight emphasizes the series nearest the pointer with a thicker stroke and
+// dims the others, reverting when the pointer leaves the chart
+func (w *LineChartSkn) SetHoverHighlight(enable bool) {
+	w.debugLog("LineChartSkn::SetHoverHighlight()")
+	w.enableHoverHighlight = enable
+	if !enable && w.clearHighlight() {
+		w.Refresh()
+	}
+}
+
+// GetHighlightDimOpacity returns the opacity applied to series other than the highlighted one
+func (w *LineChartSkn) GetHighlightDimOpacity() float32 {
+	return w.highlightDimOpacity
+}
+
+// SetHighlightDimOpacity sets the opacity, 0 to 1, applied to series other than the highlighted one
+func (w *LineChartSkn) SetHighlightDimOpacity(opacity float32) error {
+	if opacity < 0 || opacity > 1 {
+		return fmt.Errorf("SetHighlightDimOpacity() opacity must be between 0 and 1: %v", opacity)
+	}
+	w.mapsLock.Lock()
+	w.highlightDimOpacity = opacity
+	if w.highlightedSeries != "" {
+		w.relayoutRequired = true
+	}
+	w.mapsLock.Unlock()
+	w.Refresh()
+	return nil
+}
+
+// GetHighlightedSeries returns the series currently emphasized, empty when none
+func (w *LineChartSkn) GetHighlightedSeries() string {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.highlightedSeries
+}
+
+// highlightSeriesAt highlights the series whose line passes nearest the position,
+// returns true when the highlighted series changed
+func (w *LineChartSkn) highlightSeriesAt(pos fyne.Position) bool {
+	if !w.enableHoverHighlight {
+		return false
+	}
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	series, _ := w.nearestSeries(pos)
+	if series == w.highlightedSeries {
+		return false
+	}
+	w.highlightedSeries = series
+	w.relayoutRequired = true
+	return true
+}
+
+// clearHighlight restores all series, returns true when one was highlighted
+func (w *LineChartSkn) clearHighlight() bool {
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	if w.highlightedSeries == "" {
+		return false
+	}
+	w.highlightedSeries = ""
+	w.relayoutRequired = true
+	return true
+}
+
+// nearestSeries finds the series whose line segments pass closest to pos within the snap radius
+// caller must hold the mapsLock
+func (w *LineChartSkn) nearestSeries(pos fyne.Position) (string, bool) {
+	var (
+		match string
+		found bool
+	)
+	best := math.MaxFloat64
+	for key, points := range w.dataPoints {
+		var last fyne.Position
+		for _, point := range points {
+			top, bottom := (*point).MarkerPosition()
+			if top.IsZero() {
+				continue // not laid out or outside the viewport
+			}
+			center := fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)
+			if last.IsZero() {
+				last = center
+			}
+			distance := segmentDistance(pos, last, center)
+			if distance <= float64(w.hoverSnapRadius) && distance < best {
+				best = distance
+				match, found = key, true
+			}
+			last = center
+		}
+	}
+	return match, found
+}
+
+// segmentDistance returns the distance from p to the line segment a-b
+func segmentDistance(p, a, b fyne.Position) float64 {
+	dx, dy := float64(b.X-a.X), float64(b.Y-a.Y)
+	px, py := float64(p.X-a.X), float64(p.Y-a.Y)
+	lengthSq := dx*dx + dy*dy
+	if lengthSq == 0 {
+		return math.Hypot(px, py)
+	}
+	t := math.Max(0, math.Min(1, (px*dx+py*dy)/lengthSq))
+	return math.Hypot(px-t*dx, py-t*dy)
+}
+
+// seriesColor returns the point's color as drawn, chosen by any color rule, dimmed when stale or when another series is highlighted
+// caller must hold the mapsLock
+func (r *lineChartRenderer) seriesColor(series string, point *ChartDatapoint) color.Color {
+	c := r.widget.styledColor(series, r.widget.pointColor(series, point))
+	if r.staleSeries[series] {
+		c = dimColor(c)
+	}
+	if highlighted := r.widget.highlightedSeries; highlighted != "" && highlighted != series {
+		c = fadeColor(c, r.widget.highlightDimOpacity)
+	}
+	return c
+}
+
+// seriesStroke returns the stroke width of the series' lines, thicker when highlighted
+// caller must hold the mapsLock
+func (r *lineChartRenderer) seriesStroke(series string) float32 {
+	if series == r.widget.highlightedSeries {
+		return r.widget.styledStrokeSize(series) * highlightStrokeMultiplier
+	}
+	return r.widget.styledStrokeSize(series)
+}
+
+// fadeColor scales the color's alpha by opacity
+func fadeColor(c color.Color, opacity float32) color.Color {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	n.A = uint8(float32(n.A) * opacity)
+	return n
+}
@@ -0,0 +1,110 @@
+package sknlinechart_test
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+// seriesLines returns the visible lines drawn in the given color, ignoring alpha
+func seriesLines(lc sknlinechart.LineChart, c color.Color) []*canvas.Line {
+	want := color.NRGBAModel.Convert(c).(color.NRGBA)
+	var lines []*canvas.Line
+	var walk func(objs []fyne.CanvasObject)
+	walk = func(objs []fyne.CanvasObject) {
+		for _, o := range objs {
+			switch v := o.(type) {
+			case *fyne.Container:
+				walk(v.Objects)
+			case *canvas.Line:
+				got := color.NRGBAModel.Convert(v.StrokeColor).(color.NRGBA)
+				if v.Visible() && got.R == want.R && got.G == want.G && got.B == want.B {
+					lines = append(lines, v)
+				}
+			}
+		}
+	}
+	walk(test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects())
+	return lines
+}
+
+// markerX returns the horizontal center of the point's marker
+func markerX(point sknlinechart.ChartDatapoint) float32 {
+	top, bottom := point.MarkerPosition()
+	return (top.X + bottom.X) / 2
+}
+
+var _ = Describe("Hover series highlight", func() {
+	var (
+		lc   sknlinechart.LineChart
+		skn  *sknlinechart.LineChartSkn
+		low  []*sknlinechart.ChartDatapoint
+		high []*sknlinechart.ChartDatapoint
+	)
+
+	BeforeEach(func() {
+		low, high = nil, nil
+		for i := 0; i < 10; i++ {
+			a := sknlinechart.NewChartDatapoint(10, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			b := sknlinechart.NewChartDatapoint(100, theme.ColorRed, time.Now().Format(time.RFC1123))
+			low = append(low, &a)
+			high = append(high, &b)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Low": low, "High": high}),
+			sknlinechart.WithHoverHighlight(true, 0.5)))
+		lc.Resize(fyne.NewSize(800, 400))
+		skn = lc.(*sknlinechart.LineChartSkn)
+	})
+
+	It("should reject an opacity outside 0 to 1", func() {
+		Expect(lc.SetHighlightDimOpacity(1.5)).To(HaveOccurred())
+		Expect(lc.GetHighlightDimOpacity()).To(BeNumerically("==", 0.5))
+	})
+	It("should emphasize the series nearest the pointer and dim the others", func() {
+		top, bottom := (*low[3]).MarkerPosition()
+		next, _ := (*low[4]).MarkerPosition()
+		move := &desktop.MouseEvent{}
+		move.Position = fyne.NewPos((top.X+next.X)/2, (top.Y+bottom.Y)/2+3) // on the line between markers
+		skn.MouseMoved(move)
+
+		Expect(lc.GetHighlightedSeries()).To(Equal("Low"))
+		blue := seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))
+		red := seriesLines(lc, theme.PrimaryColorNamed(theme.ColorRed))
+		Expect(blue).NotTo(BeEmpty())
+		Expect(red).NotTo(BeEmpty())
+		Expect(blue[0].StrokeWidth).To(BeNumerically(">", red[0].StrokeWidth))
+		_, _, _, redAlpha := red[0].StrokeColor.RGBA()
+		_, _, _, blueAlpha := blue[0].StrokeColor.RGBA()
+		Expect(redAlpha).To(BeNumerically("<", blueAlpha))
+	})
+	It("should revert on mouse out", func() {
+		top, bottom := (*high[3]).MarkerPosition()
+		move := &desktop.MouseEvent{}
+		move.Position = fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)
+		skn.MouseMoved(move)
+		Expect(lc.GetHighlightedSeries()).To(Equal("High"))
+
+		skn.MouseOut()
+		Expect(lc.GetHighlightedSeries()).To(BeEmpty())
+		blue := seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))
+		red := seriesLines(lc, theme.PrimaryColorNamed(theme.ColorRed))
+		Expect(blue[0].StrokeWidth).To(Equal(red[0].StrokeWidth))
+	})
+	It("should not highlight when disabled", func() {
+		lc.SetHoverHighlight(false)
+		top, bottom := (*high[3]).MarkerPosition()
+		move := &desktop.MouseEvent{}
+		move.Position = fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)
+		skn.MouseMoved(move)
+		Expect(lc.GetHighlightedSeries()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,73 @@
+package sknlinechart
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// defaultHoverSnapRadius pixel distance from the mouse within which the closest datapoint is selected
+const defaultHoverSnapRadius float32 = 10
+
+// GetHoverSnapRadius returns the pixel radius searched for the datapoint nearest the mouse
+func (w *LineChartSkn) GetHoverSnapRadius() float32 {
+	return w.hoverSnapRadius
+}
+
+// SetHoverSnapRadius sets the pixel radius searched for the datapoint nearest the mouse,
+// zero requires the pointer to be over the marker itself
+func (w *LineChartSkn) SetHoverSnapRadius(pixels float32) {
+	if pixels < 0 {
+		pixels = 0
+	}
+	w.hoverSnapRadius = pixels
+}
+
+// localizeEvent recomputes the event's Position, relative to the chart, from its AbsolutePosition so
+// hit testing matches the marker positions when the chart is nested in padded or scrolled containers,
+// or receives events forwarded by a wrapping widget, at any canvas scale
+func (w *LineChartSkn) localizeEvent(ev *fyne.PointEvent) {
+	app := fyne.CurrentApp()
+	if app == nil || ev.AbsolutePosition.IsZero() {
+		return // synthesized events carry only the local position
+	}
+	driver := app.Driver()
+	if driver.CanvasForObject(w) == nil {
+		return
+	}
+	ev.Position = ev.AbsolutePosition.Subtract(driver.AbsolutePositionForObject(w))
+}
+
+// nearestDatapoint finds the on-screen datapoint closest to pos within the snap radius
+// caller must hold the mapsLock
+func (w *LineChartSkn) nearestDatapoint(pos fyne.Position) (string, int, *ChartDatapoint, bool) {
+	var (
+		matchKey   string
+		matchIdx   int
+		matchPoint *ChartDatapoint
+		found      bool
+	)
+	if pos.IsZero() {
+		return matchKey, matchIdx, matchPoint, found
+	}
+	best := math.MaxFloat64
+	for key, points := range w.dataPoints {
+		for idx, point := range points {
+			top, bottom := (*point).MarkerPosition()
+			if top.IsZero() {
+				continue // not laid out or outside the viewport
+			}
+			if pos.X > top.X && pos.X < bottom.X && pos.Y > top.Y-1 && pos.Y < bottom.Y {
+				return key, idx, point, true // directly over the marker
+			}
+			dx := float64(pos.X - (top.X+bottom.X)/2)
+			dy := float64(pos.Y - (top.Y+bottom.Y)/2)
+			distance := math.Sqrt(dx*dx + dy*dy)
+			if distance <= float64(w.hoverSnapRadius) && distance < best {
+				best = distance
+				matchKey, matchIdx, matchPoint, found = key, idx, point, true
+			}
+		}
+	}
+	return matchKey, matchIdx, matchPoint, found
+}
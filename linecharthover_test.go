@@ -0,0 +1,69 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Hover popup snapping", func() {
+	var (
+		lc      sknlinechart.LineChart
+		hovered sknlinechart.ChartDatapoint
+		near    *desktop.MouseEvent
+		points  []*sknlinechart.ChartDatapoint
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(10*i), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+		hovered = nil
+		lc.SetOnHoverPointCallback(func(series string, dataPoint sknlinechart.ChartDatapoint) {
+			hovered = dataPoint
+		})
+
+		top, bottom := (*points[5]).MarkerPosition()
+		near = &desktop.MouseEvent{}
+		near.Position = fyne.NewPos((top.X+bottom.X)/2+4, (top.Y+bottom.Y)/2+4)
+	})
+
+	It("should default to a usable snap radius", func() {
+		Expect(lc.GetHoverSnapRadius()).To(BeNumerically(">", 0))
+	})
+	It("should show the nearest datapoint within the snap radius", func() {
+		lc.(*sknlinechart.LineChartSkn).MouseMoved(near)
+		Expect(hovered).NotTo(BeNil())
+	})
+	It("should require a marker hit when the radius is zero", func() {
+		lc.SetHoverSnapRadius(0)
+		lc.(*sknlinechart.LineChartSkn).MouseMoved(near)
+		Expect(hovered).To(BeNil())
+	})
+	It("should match from the absolute position when nested in padded and scrolled containers", func() {
+		w := test.NewWindow(container.NewPadded(container.NewScroll(container.NewPadded(lc))))
+		defer w.Close()
+		w.Resize(fyne.NewSize(900, 500))
+		origin := fyne.CurrentApp().Driver().AbsolutePositionForObject(lc)
+		Expect(origin.IsZero()).To(BeFalse())
+
+		top, bottom := (*points[5]).MarkerPosition()
+		near.AbsolutePosition = origin.Add(fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2))
+		near.Position = near.AbsolutePosition // position relative to the window, as a forwarding wrapper would send
+		lc.(*sknlinechart.LineChartSkn).MouseMoved(near)
+		Expect(hovered).NotTo(BeNil())
+		Expect(hovered.Value()).To(BeNumerically("==", 50))
+	})
+})
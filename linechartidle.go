@@ -0,0 +1,93 @@
+package sknlinechart
+
+import "fyne.io/fyne/v2"
+
+// Refresh redraws the chart; while refreshes are suspended the redraw is deferred until ResumeRefresh,
+// and while a command queued with Do runs until it returns. Toggles changed since are saved to preferences bound by BindPreferences
+func (w *LineChartSkn) Refresh() {
+	w.syncPreferences()
+	w.idleLock.Lock()
+	w.remeasurePixels = true // the canvas may have been rescaled or the chart moved
+	w.idleLock.Unlock()
+	w.refreshData()
+}
+
+// refreshData redraws the chart for newly ingested datapoints, keeping the device pixel grid
+// measured by the last full refresh or layout, finding it means walking the window's objects
+func (w *LineChartSkn) refreshData() {
+	w.idleLock.Lock()
+	if w.refreshSuspended || w.commandRunning {
+		w.refreshPending = true
+		w.idleLock.Unlock()
+		return
+	}
+	w.cancelCoalesced()
+	w.idleLock.Unlock()
+	w.BaseWidget.Refresh()
+}
+
+// SuspendRefresh stops redrawing the chart, new datapoints continue to be buffered
+func (w *LineChartSkn) SuspendRefresh() {
+	w.debugLog("LineChartSkn::SuspendRefresh()")
+	w.idleLock.Lock()
+	w.refreshSuspended = true
+	w.idleLock.Unlock()
+}
+
+// ResumeRefresh restarts redrawing and catches up on anything applied while suspended
+func (w *LineChartSkn) ResumeRefresh() {
+	w.debugLog("LineChartSkn::ResumeRefresh() ENTER")
+	w.idleLock.Lock()
+	pending := w.refreshPending
+	w.refreshSuspended = false
+	w.refreshPending = false
+	w.idleLock.Unlock()
+
+	if pending {
+		w.mapsLock.Lock()
+		w.relayoutRequired = true
+		w.mapsLock.Unlock()
+		w.Refresh()
+	}
+	w.debugLog("LineChartSkn::ResumeRefresh() EXIT")
+}
+
+// IsRefreshSuspended returns true while redraws are being deferred
+func (w *LineChartSkn) IsRefreshSuspended() bool {
+	w.idleLock.Lock()
+	defer w.idleLock.Unlock()
+	return w.refreshSuspended
+}
+
+// EnableIdleSuspend suspends refreshes when the app loses focus or is minimized, and resumes
+// them when it returns to the foreground. Usually called with fyne.CurrentApp().Lifecycle().
+//
+// Note: replaces any entered/exited foreground hooks already set on the lifecycle;
+// apps needing their own hooks should call SuspendRefresh/ResumeRefresh from them instead.
+func (w *LineChartSkn) EnableIdleSuspend(lifecycle fyne.Lifecycle) {
+	w.debugLog("LineChartSkn::EnableIdleSuspend()")
+	if lifecycle == nil {
+		return
+	}
+	w.DisableIdleSuspend()
+	lifecycle.SetOnExitedForeground(w.SuspendRefresh)
+	lifecycle.SetOnEnteredForeground(w.ResumeRefresh)
+	w.idleLock.Lock()
+	w.idleLifecycle = lifecycle
+	w.idleLock.Unlock()
+}
+
+// DisableIdleSuspend removes the lifecycle hooks and resumes refreshing
+func (w *LineChartSkn) DisableIdleSuspend() {
+	w.debugLog("LineChartSkn::DisableIdleSuspend()")
+	w.idleLock.Lock()
+	lifecycle := w.idleLifecycle
+	w.idleLifecycle = nil
+	w.idleLock.Unlock()
+
+	if lifecycle != nil {
+		lifecycle.SetOnExitedForeground(nil)
+		lifecycle.SetOnEnteredForeground(nil)
+	}
+	w.ResumeRefresh()
+}
@@ -0,0 +1,78 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+// lifecycleTrigger exposes the test app's lifecycle event triggers
+type lifecycleTrigger interface {
+	TriggerEnteredForeground()
+	TriggerExitedForeground()
+}
+
+var _ = Describe("Idle aware refresh", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "Idle", 10)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+	})
+
+	visibleLines := func() int {
+		count := 0
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if l, ok := o.(*canvas.Line); ok && l.Visible() && l.StrokeWidth == lc.GetLineStrokeSize() {
+				count++
+			}
+		}
+		return count
+	}
+	addPoints := func(count int) {
+		for i := 0; i < count; i++ {
+			point := sknlinechart.NewChartDatapoint(42, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("Testing", &point)
+		}
+	}
+
+	It("should buffer data while suspended and catch up on resume", func() {
+		Expect(visibleLines()).To(Equal(10))
+		lc.SuspendRefresh()
+		Expect(lc.IsRefreshSuspended()).To(BeTrue())
+		addPoints(5)
+		Expect(visibleLines()).To(Equal(10))
+
+		lc.ResumeRefresh()
+		Expect(lc.IsRefreshSuspended()).To(BeFalse())
+		Expect(visibleLines()).To(Equal(15))
+	})
+	It("should follow the app lifecycle", func() {
+		app := test.NewApp()
+		lc.EnableIdleSuspend(app.Lifecycle())
+		trigger, ok := app.Lifecycle().(lifecycleTrigger)
+		Expect(ok).To(BeTrue())
+
+		trigger.TriggerExitedForeground()
+		Expect(lc.IsRefreshSuspended()).To(BeTrue())
+		addPoints(3)
+		Expect(visibleLines()).To(Equal(10))
+
+		trigger.TriggerEnteredForeground()
+		Expect(lc.IsRefreshSuspended()).To(BeFalse())
+		Expect(visibleLines()).To(Equal(13))
+
+		lc.DisableIdleSuspend()
+		trigger.TriggerExitedForeground()
+		Expect(lc.IsRefreshSuspended()).To(BeFalse())
+	})
+})
@@ -0,0 +1,259 @@
+package sknlinechart
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+// importTag struct tag naming a field for ImportStructs when its Go name differs, ex: `chart:"value"`
+const importTag = "chart"
+
+// ImportStructs appends a slice of the app's own structs, such as []Reading, to the series as
+// datapoints, as ApplyDataPoints does. valueField and timeField name the fields holding each point's
+// value and time, by Go field name or by a `chart:"name"` tag. Values may be any integer or float,
+// a nil pointer to one is a missing point; times may be a time.Time or a timestamp string, and
+// timeField may be empty when the structs carry none. Points take the color name of the series'
+// latest point, blue for a new series. Nothing is imported when any element cannot be converted
+func (w *LineChartSkn) ImportStructs(seriesName string, slice interface{}, valueField, timeField string) error {
+	w.debugLog("LineChartSkn::ImportStructs() ENTER")
+	colorName := theme.ColorBlue
+	w.mapsLock.RLock()
+	if points := w.dataPoints[seriesName]; len(points) > 0 {
+		colorName = (*points[len(points)-1]).ColorName()
+	}
+	w.mapsLock.RUnlock()
+
+	points, err := datapointsFromSlice(reflect.ValueOf(slice), valueField, timeField, colorName)
+	if err != nil {
+		w.debugLog("LineChartSkn::ImportStructs() ERROR EXIT")
+		return fmt.Errorf("ImportStructs() [%s] %w", seriesName, err)
+	}
+	w.ApplyDataPoints(seriesName, points)
+	w.debugLog("LineChartSkn::ImportStructs() EXIT")
+	return nil
+}
+
+// DatapointsFromStructs converts the app's own structs into datapoints of the color name, naming the
+// value and time fields as ImportStructs does; use it to prepare points for ApplyDataSeries
+func DatapointsFromStructs[T any](items []T, valueField, timeField, colorName string) ([]ChartDatapoint, error) {
+	points, err := datapointsFromSlice(reflect.ValueOf(items), valueField, timeField, colorName)
+	if err != nil {
+		return nil, fmt.Errorf("DatapointsFromStructs() %w", err)
+	}
+	return points, nil
+}
+
+// datapointsFromSlice converts each struct, or pointer to one, of the slice into a datapoint
+func datapointsFromSlice(slice reflect.Value, valueField, timeField, colorName string) ([]ChartDatapoint, error) {
+	if slice.Kind() != reflect.Slice && slice.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected a slice of structs, got %v", slice.Kind())
+	}
+	elem := slice.Type().Elem()
+	if elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a slice of structs, got a slice of %v", elem)
+	}
+	valueIndex, ok := importField(elem, valueField)
+	if !ok {
+		return nil, fmt.Errorf("%v has no value field %q", elem, valueField)
+	}
+	timeIndex := -1
+	if timeField != "" {
+		if timeIndex, ok = importField(elem, timeField); !ok {
+			return nil, fmt.Errorf("%v has no time field %q", elem, timeField)
+		}
+	}
+
+	points := make([]ChartDatapoint, 0, slice.Len())
+	for idx := 0; idx < slice.Len(); idx++ {
+		item := slice.Index(idx)
+		if item.Kind() == reflect.Pointer {
+			if item.IsNil() {
+				return nil, fmt.Errorf("element %d is nil", idx)
+			}
+			item = item.Elem()
+		}
+		value, missing, err := importValue(item.Field(valueIndex))
+		if err != nil {
+			return nil, fmt.Errorf("element %d field %q: %w", idx, valueField, err)
+		}
+		var point ChartDatapoint
+		if timeIndex < 0 {
+			point = NewChartDatapoint(value, colorName, "")
+		} else {
+			switch t := item.Field(timeIndex).Interface().(type) {
+			case time.Time:
+				point = NewChartDatapointAt(value, colorName, t)
+			case string:
+				point = NewChartDatapoint(value, colorName, t)
+			default:
+				return nil, fmt.Errorf("element %d field %q: expected a time.Time or string, got %T", idx, timeField, t)
+			}
+		}
+		point.SetMissing(missing)
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+// importField finds the exported field of the struct type with the Go name or chart tag
+func importField(t reflect.Type, name string) (int, bool) {
+	for idx := 0; idx < t.NumField(); idx++ {
+		field := t.Field(idx)
+		if !field.IsExported() {
+			continue
+		}
+		tag, _, _ := strings.Cut(field.Tag.Get(importTag), ",")
+		if field.Name == name || (tag != "" && tag == name) {
+			return idx, true
+		}
+	}
+	return -1, false
+}
+
+// importValue reads a numeric field, true when it is a nil pointer standing for a missing sample
+func importValue(v reflect.Value) (float32, bool, error) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return 0, true, nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		return float32(f), math.IsNaN(f), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float32(v.Int()), false, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float32(v.Uint()), false, nil
+	}
+	return 0, false, fmt.Errorf("expected a number, got %v", v.Type())
+}
+
+// csvPalette theme color names given to series loaded from csv, in column order
+var csvPalette = []string{
+	theme.ColorBlue, theme.ColorRed, theme.ColorGreen, theme.ColorOrange,
+	theme.ColorPurple, theme.ColorYellow, theme.ColorBrown, theme.ColorGray,
+}
+
+// ColumnMapping names the csv columns LoadCSV reads, by their header
+type ColumnMapping struct {
+	Timestamp  string            // the timestamp column, "timestamp" when empty
+	Series     map[string]string // the series each value column loads, keyed by its header; when nil every other column but index and annotations, named by its header
+	ColorNames []string          // theme color names given to the series in column order, repeating; csvPalette when empty
+	Delimiter  rune              // separates the fields, a comma when zero
+}
+
+// LoadCSV replaces the chart's data with the series read from csv, as by ReplaceAllDataSeries, for
+// viewing log extracts offline. The header names the columns; each row gives a point of each mapped
+// series at the row's timestamp, an empty cell none and NaN a missing sample, so the chart's own
+// ExportCSV reads back as written. Nothing changes when a value cannot be parsed or a series holds
+// more points than its point limit
+func (w *LineChartSkn) LoadCSV(in io.Reader, mapping ColumnMapping) error {
+	w.debugLog("LineChartSkn::LoadCSV() ENTER")
+	series, err := datapointsFromCSV(in, mapping)
+	if err == nil {
+		err = w.ReplaceAllDataSeries(series)
+	}
+	if err != nil {
+		w.debugLog("LineChartSkn::LoadCSV() ERROR EXIT")
+		return fmt.Errorf("LoadCSV() %w", err)
+	}
+	w.debugLog("LineChartSkn::LoadCSV() EXIT")
+	return nil
+}
+
+// datapointsFromCSV reads the mapped columns of each row into their series' datapoints
+func datapointsFromCSV(in io.Reader, mapping ColumnMapping) (map[string][]*ChartDatapoint, error) {
+	reader := csv.NewReader(in)
+	if mapping.Delimiter != 0 {
+		reader.Comma = mapping.Delimiter
+	}
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("no header row")
+	}
+	header := records[0]
+	timestampHeader := mapping.Timestamp
+	if timestampHeader == "" {
+		timestampHeader = "timestamp"
+	}
+	palette := mapping.ColorNames
+	if len(palette) == 0 {
+		palette = csvPalette
+	}
+
+	timestampCol := -1
+	names := map[int]string{} // series by column
+	var columns []int
+	for col, name := range header {
+		switch {
+		case name == timestampHeader:
+			timestampCol = col
+			continue
+		case mapping.Series != nil:
+			if seriesName, ok := mapping.Series[name]; ok {
+				names[col] = seriesName
+			}
+		case name != "index" && name != csvAnnotationsColumn:
+			names[col] = name
+		}
+		if _, ok := names[col]; ok {
+			columns = append(columns, col)
+		}
+	}
+	if timestampCol < 0 {
+		return nil, fmt.Errorf("no %q column", timestampHeader)
+	}
+	for name := range mapping.Series {
+		found := false
+		for _, h := range header {
+			found = found || h == name
+		}
+		if !found {
+			return nil, fmt.Errorf("no %q column", name)
+		}
+	}
+
+	series := map[string][]*ChartDatapoint{}
+	for idx, col := range columns {
+		colorName := palette[idx%len(palette)]
+		for row, record := range records[1:] {
+			if col >= len(record) || record[col] == "" {
+				continue
+			}
+			timestamp := ""
+			if timestampCol < len(record) {
+				timestamp = record[timestampCol]
+			}
+			var point ChartDatapoint
+			if strings.EqualFold(record[col], "NaN") {
+				point = NewMissingDatapoint(colorName, timestamp)
+			} else {
+				value, err := strconv.ParseFloat(strings.TrimSpace(record[col]), 32)
+				if err != nil {
+					return nil, fmt.Errorf("row %d, column %s: %w", row+2, header[col], err)
+				}
+				point = NewChartDatapoint(float32(value), colorName, timestamp)
+			}
+			series[names[col]] = append(series[names[col]], &point)
+		}
+	}
+	return series, nil
+}
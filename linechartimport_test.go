@@ -0,0 +1,140 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Importing structs", func() {
+	type reading struct {
+		Celsius  float64
+		Taken    time.Time
+		internal int
+	}
+	type tagged struct {
+		Level *int32 `json:"level" chart:"value"`
+		Stamp string `chart:"time"`
+	}
+	var (
+		lc    sknlinechart.LineChart
+		start = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+	})
+
+	It("should append each struct as a datapoint by field name", func() {
+		readings := []reading{{Celsius: 21.5, Taken: start}, {Celsius: 22, Taken: start.Add(time.Minute)}}
+		Expect(lc.ImportStructs("Temperature", readings, "Celsius", "Taken")).To(Succeed())
+
+		points := lc.GetDataSeries("Temperature")
+		Expect(points).To(HaveLen(2))
+		Expect(points[0].Value()).To(BeNumerically("==", 21.5))
+		ts, ok := points[1].Time()
+		Expect(ok).To(BeTrue())
+		Expect(ts).To(Equal(start.Add(time.Minute)))
+		Expect(points[1].ColorName()).To(Equal(theme.ColorBlue))
+
+		Expect(lc.ImportStructs("Temperature", []*reading{{Celsius: 23, Taken: start.Add(2 * time.Minute)}}, "Celsius", "Taken")).To(Succeed())
+		Expect(lc.GetDataSeries("Temperature")).To(HaveLen(3))
+	})
+	It("should find fields by chart tag and read nil pointers as missing samples", func() {
+		level := int32(7)
+		rows := []tagged{{Level: &level, Stamp: start.Format(time.RFC1123)}, {Stamp: start.Add(time.Minute).Format(time.RFC1123)}}
+		Expect(lc.ImportStructs("Tank", rows, "value", "time")).To(Succeed())
+
+		points := lc.GetDataSeries("Tank")
+		Expect(points[0].Value()).To(BeNumerically("==", 7))
+		Expect(points[0].Timestamp()).To(Equal(start.Format(time.RFC1123)))
+		Expect(points[1].IsMissing()).To(BeTrue())
+	})
+	It("should import nothing when the fields or elements cannot be converted", func() {
+		readings := []reading{{Celsius: 21.5, Taken: start}}
+		Expect(lc.ImportStructs("Temperature", readings, "Fahrenheit", "Taken")).To(HaveOccurred())
+		Expect(lc.ImportStructs("Temperature", readings, "internal", "")).To(HaveOccurred())
+		Expect(lc.ImportStructs("Temperature", readings, "Taken", "")).To(HaveOccurred())
+		Expect(lc.ImportStructs("Temperature", readings, "Celsius", "Celsius")).To(HaveOccurred())
+		Expect(lc.ImportStructs("Temperature", []*reading{nil}, "Celsius", "")).To(HaveOccurred())
+		Expect(lc.ImportStructs("Temperature", 42, "Celsius", "")).To(HaveOccurred())
+		Expect(lc.GetDataSeries("Temperature")).To(BeEmpty())
+	})
+	It("should convert structs for ApplyDataSeries with the generic helper", func() {
+		points, err := sknlinechart.DatapointsFromStructs([]reading{{Celsius: 19, Taken: start}}, "Celsius", "", theme.ColorRed)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(points).To(HaveLen(1))
+		Expect(points[0].ColorName()).To(Equal(theme.ColorRed))
+		Expect(points[0].Timestamp()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Loading csv", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+	})
+
+	It("should read back what ExportCSV wrote", func() {
+		for i := 0; i < 5; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(i), theme.ColorOrange, time.Date(2026, 5, 1, 12, i, 0, 0, time.UTC).Format(time.RFC1123))
+			lc.ApplyDataPoint("CPU", &point)
+		}
+		missing := sknlinechart.NewMissingDatapoint(theme.ColorOrange, time.Date(2026, 5, 1, 12, 5, 0, 0, time.UTC).Format(time.RFC1123))
+		lc.ApplyDataPoint("CPU", &missing)
+		var out bytes.Buffer
+		Expect(lc.ExportCSV(&out, sknlinechart.CSVOptions{})).To(Succeed())
+
+		loaded, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		Expect(loaded.LoadCSV(&out, sknlinechart.ColumnMapping{})).To(Succeed())
+		points := loaded.GetDataSeries("CPU")
+		Expect(points).To(HaveLen(6))
+		Expect(points[3].Value()).To(BeNumerically("==", 3))
+		Expect(points[3].Timestamp()).To(Equal(time.Date(2026, 5, 1, 12, 3, 0, 0, time.UTC).Format(time.RFC1123)))
+		Expect(points[5].IsMissing()).To(BeTrue())
+	})
+	It("should load the mapped columns of a log extract as named series", func() {
+		extract := "when;host;load;temp\n" +
+			"2026-05-01T12:00:00Z;web1;0.5;41\n" +
+			"2026-05-01T12:01:00Z;web1;;42\n" +
+			"2026-05-01T12:02:00Z;web1;0.7;43\n"
+		Expect(lc.LoadCSV(strings.NewReader(extract), sknlinechart.ColumnMapping{
+			Timestamp:  "when",
+			Series:     map[string]string{"load": "Load", "temp": "Temperature"},
+			ColorNames: []string{theme.ColorRed},
+			Delimiter:  ';',
+		})).To(Succeed())
+		Expect(lc.GetSeriesNames()).To(Equal([]string{"Load", "Temperature"}))
+		Expect(lc.GetDataSeries("Load")).To(HaveLen(2))
+		temps := lc.GetDataSeries("Temperature")
+		Expect(temps).To(HaveLen(3))
+		Expect(temps[2].Value()).To(BeNumerically("==", 43))
+		Expect(temps[2].ColorName()).To(Equal(theme.ColorRed))
+	})
+	It("should replace the chart's data", func() {
+		point := sknlinechart.NewChartDatapoint(1, theme.ColorOrange, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Old", &point)
+		Expect(lc.LoadCSV(strings.NewReader("timestamp,New\nnow,1\n"), sknlinechart.ColumnMapping{})).To(Succeed())
+		Expect(lc.GetSeriesNames()).To(Equal([]string{"New"}))
+	})
+	It("should change nothing when the csv cannot be read", func() {
+		point := sknlinechart.NewChartDatapoint(1, theme.ColorOrange, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Old", &point)
+		Expect(lc.LoadCSV(strings.NewReader("timestamp,New\nnow,high\n"), sknlinechart.ColumnMapping{})).To(HaveOccurred())
+		Expect(lc.LoadCSV(strings.NewReader("when,New\nnow,1\n"), sknlinechart.ColumnMapping{})).To(HaveOccurred())
+		Expect(lc.LoadCSV(strings.NewReader("timestamp,New\nnow,1\n"), sknlinechart.ColumnMapping{Series: map[string]string{"Old": "Old"}})).To(HaveOccurred())
+		Expect(lc.GetSeriesNames()).To(Equal([]string{"Old"}))
+	})
+})
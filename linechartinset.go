@@ -0,0 +1,109 @@
+package sknlinechart
+
+import (
+	"errors"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+)
+
+// InsetRect places an inset chart within the parent's plot area, each field a fraction of the plot
+// area's size measured from its top left corner
+type InsetRect struct {
+	X      float32
+	Y      float32
+	Width  float32
+	Height float32
+}
+
+// validate checks the rect lies within the plot area
+func (r InsetRect) validate() error {
+	if r.X < 0 || r.Y < 0 || r.Width <= 0 || r.Height <= 0 || r.X+r.Width > 1 || r.Y+r.Height > 1 {
+		return fmt.Errorf("inset must lie within the plot area: %+v", r)
+	}
+	return nil
+}
+
+// insetChart a chart drawn within this chart's plot area
+type insetChart struct {
+	chart LineChart
+	rect  InsetRect
+}
+
+// AddInsetChart draws child as a picture-in-picture within this chart's plot area, such as a zoomed
+// detail or a related metric, laid out and refreshed with this chart. Adding a child already inset moves it to rect
+func (w *LineChartSkn) AddInsetChart(child LineChart, rect InsetRect) error {
+	w.debugLog("LineChartSkn::AddInsetChart() ENTER")
+	if child == nil || child == LineChart(w) {
+		w.debugLog("LineChartSkn::AddInsetChart() ERROR EXIT")
+		return errors.New("AddInsetChart() child must be another chart")
+	}
+	if err := rect.validate(); err != nil {
+		w.debugLog("LineChartSkn::AddInsetChart() ERROR EXIT")
+		return fmt.Errorf("AddInsetChart() %w", err)
+	}
+	w.mapsLock.Lock()
+	found := false
+	for i := range w.insets {
+		if w.insets[i].chart == child {
+			w.insets[i].rect = rect
+			found = true
+		}
+	}
+	if !found {
+		w.insets = append(w.insets, insetChart{chart: child, rect: rect})
+	}
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::AddInsetChart() EXIT")
+	return nil
+}
+
+// RemoveInsetChart stops drawing child within this chart, false when it was not inset
+func (w *LineChartSkn) RemoveInsetChart(child LineChart) bool {
+	w.debugLog("LineChartSkn::RemoveInsetChart()")
+	w.mapsLock.Lock()
+	removed := false
+	insets := w.insets[:0]
+	for _, inset := range w.insets {
+		if inset.chart == child {
+			removed = true
+			continue
+		}
+		insets = append(insets, inset)
+	}
+	w.insets = insets
+	w.mapsLock.Unlock()
+	if removed {
+		w.Refresh()
+	}
+	return removed
+}
+
+// GetInsetCharts returns the charts drawn within this chart's plot area
+func (w *LineChartSkn) GetInsetCharts() []LineChart {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	var charts []LineChart
+	for _, inset := range w.insets {
+		charts = append(charts, inset.chart)
+	}
+	return charts
+}
+
+// layoutInsets places each inset chart over its share of the plot area
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutInsets() {
+	width, height := r.widget.plotMax.X-r.widget.plotMin.X, r.widget.plotMax.Y-r.widget.plotMin.Y
+	for _, inset := range r.widget.insets {
+		inset.chart.Move(r.widget.plotMin.AddXY(inset.rect.X*width, inset.rect.Y*height))
+		inset.chart.Resize(fyne.NewSize(inset.rect.Width*width, inset.rect.Height*height))
+	}
+}
+
+// refreshInsets refreshes the inset charts along with this chart
+func (r *lineChartRenderer) refreshInsets() {
+	for _, chart := range r.widget.GetInsetCharts() {
+		chart.Refresh()
+	}
+}
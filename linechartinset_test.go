@@ -0,0 +1,55 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Inset charts", func() {
+	var parent, child sknlinechart.LineChart
+
+	objects := func() []fyne.CanvasObject {
+		return test.WidgetRenderer(parent.(*sknlinechart.LineChartSkn)).Objects()
+	}
+
+	BeforeEach(func() {
+		parent, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		child, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		parent.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should lay the child out within the parent's plot area", func() {
+		Expect(parent.AddInsetChart(child, sknlinechart.InsetRect{X: 0.5, Y: 0, Width: 0.5, Height: 0.5})).To(Succeed())
+		Expect(parent.GetInsetCharts()).To(ConsistOf(child))
+		Expect(objects()).To(ContainElement(child))
+
+		pos, size := child.Position(), child.Size()
+		Expect(pos.X).To(BeNumerically(">", 350))
+		Expect(pos.Y).To(BeNumerically(">", 0))
+		Expect(pos.X + size.Width).To(BeNumerically("<", 800))
+		Expect(size.Height).To(BeNumerically("<", 200))
+
+		parent.Resize(fyne.NewSize(1000, 500))
+		Expect(child.Size().Width).To(BeNumerically(">", size.Width))
+
+		Expect(parent.AddInsetChart(child, sknlinechart.InsetRect{X: 0, Y: 0.5, Width: 0.25, Height: 0.5})).To(Succeed())
+		Expect(parent.GetInsetCharts()).To(HaveLen(1))
+		Expect(child.Position().X).To(BeNumerically("<", pos.X))
+	})
+	It("should stop drawing a removed child", func() {
+		Expect(parent.AddInsetChart(child, sknlinechart.InsetRect{X: 0.1, Y: 0.1, Width: 0.3, Height: 0.3})).To(Succeed())
+		Expect(parent.RemoveInsetChart(child)).To(BeTrue())
+		Expect(parent.RemoveInsetChart(child)).To(BeFalse())
+		Expect(parent.GetInsetCharts()).To(BeEmpty())
+		Expect(objects()).NotTo(ContainElement(child))
+	})
+	It("should reject itself and rects outside the plot area", func() {
+		Expect(parent.AddInsetChart(parent, sknlinechart.InsetRect{Width: 0.5, Height: 0.5})).To(HaveOccurred())
+		Expect(parent.AddInsetChart(nil, sknlinechart.InsetRect{Width: 0.5, Height: 0.5})).To(HaveOccurred())
+		Expect(parent.AddInsetChart(child, sknlinechart.InsetRect{X: 0.75, Width: 0.5, Height: 0.5})).To(HaveOccurred())
+		Expect(parent.AddInsetChart(child, sknlinechart.InsetRect{Width: 0, Height: 0.5})).To(HaveOccurred())
+	})
+})
@@ -1,6 +1,14 @@
 package sknlinechart
 
-import "fyne.io/fyne/v2"
+import (
+	"image"
+	"image/color"
+	"io"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+)
 
 // GraphPointSmoothing support for different implementation
 // of averaging or smooth data; current provides rolling average from last x reading.
@@ -19,12 +27,37 @@ type ChartDatapoint interface {
 	ColorName() string
 	SetColorName(n string)
 
+	// Color returns the point's own color, drawn in place of its theme color name; nil when it has none
+	Color() color.Color
+	// SetColor draws the point in any color, such as a corporate palette entry missing from the theme; nil restores its color name
+	SetColor(c color.Color)
+
 	Timestamp() string
 	SetTimestamp(t string)
+	// Time returns the timestamp as read when the point was created or its timestamp set, false when
+	// it is not in a known format; the chart uses it rather than parsing the text while drawing
+	Time() (time.Time, bool)
 
 	// ExternalID string uuid assigned when created
 	ExternalID() string
 
+	// Bounds returns the lower and upper values of the point's confidence interval, false when it has none
+	Bounds() (float32, float32, bool)
+	// SetBounds sets the confidence interval drawn as a translucent band around the series
+	SetBounds(lower, upper float32)
+	ClearBounds()
+
+	// XValue returns the numeric x value the point is plotted at, false when it is plotted at its index
+	XValue() (float32, bool)
+	// SetXValue plots the point at x on a chart with an x axis range, see LineChart.SetXAxisRange
+	SetXValue(x float32)
+	ClearXValue()
+
+	// IsMissing returns true for a sample the source failed to deliver, flagged by SetMissing or
+	// a NaN value; the series' line is broken around it rather than drawn through it
+	IsMissing() bool
+	SetMissing(missing bool)
+
 	// Copy returns a cloned copy of current item
 	Copy() ChartDatapoint
 
@@ -85,8 +118,374 @@ type LineChart interface {
 	// If series has more than 130 points, point 0 will be rolled out making room for this one
 	ApplyDataPoint(seriesName string, newDataPoint *ChartDatapoint)
 
-	// SetMinSize set the minimum size limit for the linechart
+	// ApplyDataPoints appends many datapoints with roll-off and a single Refresh, for backfilling
+	ApplyDataPoints(seriesName string, points []ChartDatapoint)
+
+	// DataSink returns the channel producers send datapoints into, drained and applied in batches by the chart
+	DataSink() chan<- SeriesPoint
+
+	// ImportStructs appends the app's own structs to the series, naming their value and time fields
+	ImportStructs(seriesName string, slice interface{}, valueField, timeField string) error
+
+	// UpdateDataPoint revises the value of an existing datapoint in place, redrawing only its line segments
+	UpdateDataPoint(seriesName string, index int, newValue float32) error
+
+	// ReplaceAllDataSeries swaps the whole dataset, of any size, deleting series it does not contain
+	ReplaceAllDataSeries(newSeries map[string][]*ChartDatapoint) error
+
+	// SetTimeWindow drops datapoints whose timestamps are older than now-d, zero keeps points by count only
+	SetTimeWindow(d time.Duration) error
+	GetTimeWindow() time.Duration
+
+	// SetNonFiniteValuePolicy sets how NaN and infinite datapoint values are handled on ingest, NaN marks a missing sample by default
+	SetNonFiniteValuePolicy(policy NonFiniteValuePolicy)
+	GetNonFiniteValuePolicy() NonFiniteValuePolicy
+
+	// SetMaxTextLength clips popup and readout text to length characters, 0 shows text in full
+	SetMaxTextLength(length int) error
+	GetMaxTextLength() int
+
+	// SetTimeSpacing positions datapoints along the x axis by their timestamps rather than their index
+	SetTimeSpacing(enable bool)
+	IsTimeSpacingEnabled() bool
+
+	// SetYInverted plots increasing values downward with the y labels reversed, for depth or rank
+	SetYInverted(inverted bool)
+	IsYInverted() bool
+
+	// SetStackingMode sums series at each index into stacked bands, or their percentage of the total
+	SetStackingMode(mode StackingMode) error
+	GetStackingMode() StackingMode
+
+	// SetLineInterpolation draws the lines between datapoints straight, as smooth splines, or as steps
+	SetLineInterpolation(mode LineInterpolation) error
+	GetLineInterpolation() LineInterpolation
+
+	// SetXAxisRange plots datapoints carrying an x value at that value on a numeric x axis from min to max
+	SetXAxisRange(min, max float32) error
+	GetXAxisRange() (float32, float32, bool)
+	ClearXAxisRange()
+
+	// SetSeriesPointLimit rolls off a series' oldest datapoints sooner than the chart's point limit, 0 removes the override
+	SetSeriesPointLimit(seriesName string, limit int) error
+	GetSeriesPointLimit(seriesName string) int
+
+	// ClearSeries empties a series while keeping it registered, ClearAllData empties every series
+	ClearSeries(seriesName string) error
+	ClearAllData()
+
+	// GetDataSeries returns copies of the series' current datapoints, after any roll-off
+	GetDataSeries(seriesName string) []ChartDatapoint
+	// GetSeriesNames returns the chart's series names, sorted
+	GetSeriesNames() []string
+
+	// DeleteSeries removes a series and the canvas objects drawing it
+	DeleteSeries(seriesName string) error
+
+	// InsertGap breaks the named series' lines before their next datapoint, call when a data source
+	// disconnects or reconnects; SetGapMarkers adds a vertical event marker at each gap
+	InsertGap(seriesNames ...string)
+	GetGaps(seriesName string) []int
+	SetGapMarkers(enable bool)
+	IsGapMarkersEnabled() bool
+
+	// SetZoomSelection enables dragging a rectangle with the primary mouse button to zoom into that region
+	SetZoomSelection(enable bool)
+	IsZoomSelectionEnabled() bool
+
+	// GetViewport returns the visible data region; the full extent when not zoomed
+	GetViewport() ChartViewport
+
+	// SetViewport zooms the chart to the given index/value region
+	SetViewport(vp ChartViewport) error
+
+	// ResetZoom returns the chart to its full data extent
+	ResetZoom()
+	IsZoomed() bool
+
+	// SetCrosshairEnabled shows lines following the mouse with index and value readouts at the plot edges
+	SetCrosshairEnabled(enable bool)
+	IsCrosshairEnabled() bool
+
+	// SetHoverSnapRadius pixel radius around the mouse searched for the nearest datapoint to display
+	SetHoverSnapRadius(pixels float32)
+	GetHoverSnapRadius() float32
+
+	// SetHoverMode selects a single point popup or one popup comparing every series at the mouse index
+	SetHoverMode(mode HoverMode)
+	GetHoverMode() HoverMode
+
+	// SetRenderQuality trades markers, drawn segments, grid density, and stroke width against CPU usage
+	SetRenderQuality(quality RenderQuality)
+	GetRenderQuality() RenderQuality
+
+	// LastUpdated returns when the series last received data, zero time when unknown
+	LastUpdated(seriesName string) time.Time
+
+	// SetStaleThreshold dims series not updated within threshold so dead feeds stand out; zero disables
+	SetStaleThreshold(threshold time.Duration) error
+	GetStaleThreshold() time.Duration
+	IsSeriesStale(seriesName string) bool
+
+	// EnableAutoSnapshot periodically writes PNG, CSV, or SVG snapshots into dir, rotating old files
+	EnableAutoSnapshot(interval time.Duration, dir string, format SnapshotFormat) error
+	DisableAutoSnapshot()
+	IsAutoSnapshotEnabled() bool
+	SetAutoSnapshotRetention(count int)
+
+	// StartRecording captures frames of the live chart every interval, StopRecording writes them as an animated gif
+	StartRecording(interval time.Duration) error
+	StopRecording(out io.Writer) error
+	IsRecording() bool
+
+	// EnableRecorder appends every ingested point to rotating binary recording files in dir
+	EnableRecorder(dir string, maxFileBytes int64, maxFiles int) error
+	DisableRecorder() error
+	IsRecorderEnabled() bool
+
+	// EnableIdleSuspend suspends refreshes while the app is in the background, catching up on return
+	EnableIdleSuspend(lifecycle fyne.Lifecycle)
+	DisableIdleSuspend()
+
+	// SetTranslator localizes the chart's built-in text by message key, Message returns the text shown for a key
+	SetTranslator(translate func(key string) string)
+	Message(key string) string
+
+	// Do queues a compound change to run after those queued before it, from any goroutine, redrawn once it returns;
+	// commands are run in order but not atomically, other goroutines' calls may land between a command's own
+	Do(command func(api LineChart))
+
+	// SetRefreshInterval limits the redraws caused by incoming datapoints to one per interval, zero redraws on every point
+	SetRefreshInterval(interval time.Duration) error
+	GetRefreshInterval() time.Duration
+	// SuspendRefresh defers redraws while data continues to buffer, ResumeRefresh catches up
+	SuspendRefresh()
+	ResumeRefresh()
+	IsRefreshSuspended() bool
+
+	// SetMouseAction binds a chart action to a mouse button click; ChartActionNone frees the button for the app
+	SetMouseAction(button desktop.MouseButton, action ChartAction)
+	GetMouseAction(button desktop.MouseButton) ChartAction
+
+	// SetOnMouseButtonCallback method to call when a button bound to ChartActionNone is clicked
+	SetOnMouseButtonCallback(f func(button desktop.MouseButton, position fyne.Position))
+
+	// PinTooltip keeps a datapoint's tooltip on screen, shift-click on a datapoint does the same
+	PinTooltip(seriesName string, index int) error
+	ClearPinnedTooltips()
+
+	// SetContextMenuEnabled replaces the secondary button markers toggle with a popup menu of chart options
+	SetContextMenuEnabled(enable bool)
+	IsContextMenuEnabled() bool
+
+	// AddContextMenuItem appends an application item to the popup menu
+	AddContextMenuItem(item *fyne.MenuItem)
+
+	// State returns the chart's labels, settings, and series; ApplyState replaces them
+	State() ChartState
+	ApplyState(state ChartState) error
+
+	// ExportSVG writes the chart as drawn as an svg document, for crisp inclusion in reports and print
+	ExportSVG(out io.Writer) error
+
+	// Snapshot returns the chart as currently drawn, for embedding in documents generated in-process
+	Snapshot() (image.Image, error)
+
+	// ExportCSV writes one row per index with each series' value, those within the zoom window unless opts.AllPoints
+	ExportCSV(out io.Writer, opts CSVOptions) error
+
+	// LoadCSV replaces the chart's data with the series read from the mapped timestamp and value columns
+	LoadCSV(in io.Reader, mapping ColumnMapping) error
+
+	// MarshalJSON encodes the chart as SaveState does, UnmarshalJSON restores it onto a constructed chart
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON(data []byte) error
+
+	// SaveState writes the state as versioned json, LoadState reads it back migrating older versions
+	SaveState(out io.Writer) error
+	LoadState(in io.Reader) error
+
+	// SavePreferences stores the user's toggles, zoom and hidden series under key, RestorePreferences applies them
+	SavePreferences(p fyne.Preferences, key string)
+	RestorePreferences(p fyne.Preferences, key string) error
+
+	// BindPreferences restores the toggles stored under key and saves them again as they change
+	BindPreferences(p fyne.Preferences, key string) error
+	UnbindPreferences()
+
+	// SetExportContext includes annotations with exported csv, and annotations, time bands, thresholds,
+	// reference regions, and vertical markers with exported state, enabled by default
+	SetExportContext(enable bool)
+	IsExportContextEnabled() bool
+
+	// SetExportPrivacy rounds values and coarsens or strips timestamps and metadata in csv and state exports
+	SetExportPrivacy(privacy ExportPrivacy) error
+	GetExportPrivacy() ExportPrivacy
+
+	// SetTouchMode makes a primary tap show the tapped datapoint's value, on by default for mobile devices
+	SetTouchMode(enable bool)
+	IsTouchModeEnabled() bool
+
+	// SetHoverHighlight emphasizes the series nearest the pointer and dims the others to the dim opacity
+	SetHoverHighlight(enable bool)
+	IsHoverHighlightEnabled() bool
+	SetHighlightDimOpacity(opacity float32) error
+	GetHighlightDimOpacity() float32
+	GetHighlightedSeries() string
+
+	// SetForecastSeries draws predicted points beyond the latest datapoint of the base series,
+	// dashed over a shaded future region; empty points removes the forecast
+	SetForecastSeries(base string, points []ChartDatapoint) error
+	GetForecastSeries(base string) []ChartDatapoint
+
+	// RenameSeries moves a series and everything attached to it to a new name
+	RenameSeries(oldName, newName string) error
+	// SetSeriesMetadata attaches units, description, source, or app values to a series; units follow tooltip values
+	SetSeriesMetadata(seriesName string, metadata SeriesMetadata)
+	GetSeriesMetadata(seriesName string) SeriesMetadata
+
+	// SetSeriesColorRule colors each point of the series by its value, nil restores the points' own colors
+	SetSeriesColorRule(seriesName string, rule ColorRule)
+
+	// SetSeriesColorGradient colors each point of the series between two colors by its value, nil removes the gradient
+	SetSeriesColorGradient(seriesName string, gradient *ColorGradient) error
+	GetSeriesColorGradient(seriesName string) (ColorGradient, bool)
+
+	// SetSeriesColor draws the series in any color, not only theme color names; nil restores its points' colors
+	SetSeriesColor(seriesName string, c color.Color)
+	GetSeriesColor(seriesName string) color.Color
+
+	// SetSeriesStyle strokes the series' lines with a width, dash pattern, and opacity; the zero SeriesStyle restores the defaults
+	SetSeriesStyle(seriesName string, style SeriesStyle) error
+	GetSeriesStyle(seriesName string) (SeriesStyle, bool)
+
+	// SetSeriesFill shades the area between the series and the zero baseline; nil fillColor uses the series color
+	SetSeriesFill(seriesName string, enabled bool, fillColor color.Color)
+	GetSeriesFill(seriesName string) (bool, color.Color)
+
+	// SetSeriesPopupBuilder shows the builder's content in the hover popup of the series' points, nil restores the text popup
+	SetSeriesPopupBuilder(seriesName string, builder PopupBuilder)
+
+	// CopyHoveredDatapoint copies the datapoint shown by the hover popup to the clipboard, also bound to the copy shortcut
+	CopyHoveredDatapoint() bool
+	// SetClipboardFormat sets the copied text, its {series}, {timestamp}, {value} and {index} placeholders are replaced
+	SetClipboardFormat(format string)
+	GetClipboardFormat() string
+
+	// SetTimeBands shades recurring time windows, like nights or weekends, behind the datapoints
+	// whose timestamps fall inside them
+	SetTimeBands(bands []TimeBand) error
+	GetTimeBands() []TimeBand
+
+	// SetRenderBackend paints every series into one image with RenderBackendRaster, for dense or many-series charts
+	SetRenderBackend(backend RenderBackend) error
+	GetRenderBackend() RenderBackend
+
+	// SetCVDSimulation redraws the chart's colors as seen with a color-vision deficiency, CVDNone shows the true colors
+	SetCVDSimulation(mode CVDMode) error
+	GetCVDSimulation() CVDMode
+
+	// SetMonochromeMode draws black series told apart by dash pattern and marker on a white background, for print
+	SetMonochromeMode(enable bool)
+	IsMonochromeMode() bool
+
+	// SetDownsampling draws series with more visible points than the plot has room for through an LTTB selection, on by default
+	SetDownsampling(enable bool)
+	IsDownsamplingEnabled() bool
+	// SetProgressiveLoading draws large loaded histories coarse first and refines them over the following frames, on by default
+	SetProgressiveLoading(enable bool)
+	IsProgressiveLoadingEnabled() bool
+	IsRefining() bool
+
+	// SetPixelSnapping aligns grid, crosshair, and marker lines to device pixels so they render crisp, on by default
+	SetPixelSnapping(enable bool)
+	IsPixelSnappingEnabled() bool
+
+	// GetKeyboardCursor returns the series and index stepped to with the arrow keys once the chart has focus
+	GetKeyboardCursor() (string, int, bool)
+
+	// GetLinkGroup returns the ChartLinkGroup sharing this chart's cursor and zoom, nil when not linked
+	GetLinkGroup() *ChartLinkGroup
+
+	// HideSeries and ShowSeries toggle drawing a series without deleting its data
+	HideSeries(seriesName string) error
+	ShowSeries(seriesName string) error
+	IsSeriesVisible(seriesName string) bool
+
+	// SetLegendVisible shows the series legend, clicking an entry hides or shows its series
+	SetLegendVisible(visible bool)
+	IsLegendVisible() bool
+	SetLegendPosition(position LegendPosition)
+	GetLegendPosition() LegendPosition
+
+	// SetLegendValues shows min, avg, max, or last of each series over the viewport beside its legend entry
+	SetLegendValues(columns ...LegendValue)
+	GetLegendValues() []LegendValue
+
+	// AddThresholdLine draws a labeled horizontal line across the plot area at value, for SLOs and limits
+	AddThresholdLine(name string, value float32, lineColor color.Color, labelText string) error
+	RemoveThresholdLine(name string) bool
+	GetThresholdLines() []string
+
+	// AddVerticalMarker flags an event at an index or time with a labeled line across the plot, RemoveVerticalMarker removes it
+	AddVerticalMarker(at MarkerAt, text string, markerColor color.Color) error
+	RemoveVerticalMarker(at MarkerAt) bool
+	GetVerticalMarkers() []VerticalMarker
+
+	// SetStatsOverlayEnabled draws labeled lines at each shown series' min, max, mean, and last value in view,
+	// GetSeriesStats returns them
+	SetStatsOverlayEnabled(enable bool)
+	IsStatsOverlayEnabled() bool
+	GetSeriesStats(seriesName string) (SeriesStats, error)
+
+	// AddReferenceRegion shades a range of values or of the x axis behind the data, RemoveReferenceRegion removes it
+	AddReferenceRegion(name string, axis RegionAxis, from, to float32, fillColor color.Color) error
+	RemoveReferenceRegion(name string) bool
+	GetReferenceRegions() []ReferenceRegion
+
+	// AddAnnotation attaches a text note to a series datapoint, shown as a label above the point
+	AddAnnotation(seriesName string, index int, text string) error
+	RemoveAnnotation(seriesName string, index int) bool
+	GetAnnotations() []Annotation
+
+	// SetAnnotationEditing enables double-click to add or edit annotations and dragging labels to move them
+	SetAnnotationEditing(enable bool)
+	IsAnnotationEditingEnabled() bool
+
+	// DetachSeries opens the series in its own window on a chart mirroring the series' updates
+	DetachSeries(seriesName string) (LineChart, error)
+	IsSeriesDetached(seriesName string) bool
+
+	// AddDerivedSeries adds a moving average, EMA, or rate of a series kept up to date by the chart, returning its name
+	AddDerivedSeries(source string, kind DerivedKind, window int) (string, error)
+	RemoveDerivedSeries(name string) bool
+	GetDerivedSeries(name string) (string, DerivedKind, int, bool)
+
+	// AddRatioSeries derives a series as the ratio of two others paired by timestamp, such as errors over requests
+	AddRatioSeries(name, numeratorSeries, denominatorSeries string) error
+	RemoveRatioSeries(name string) bool
+	GetRatioSeries(name string) (string, string, bool)
+
+	// AddInsetChart draws another chart as a picture-in-picture within the plot area, rect in fractions of it
+	AddInsetChart(child LineChart, rect InsetRect) error
+	RemoveInsetChart(child LineChart) bool
+	GetInsetCharts() []LineChart
+
+	// AttachDataStore draws a shared store's series on this chart and follows its changes
+	AttachDataStore(store *ChartDataStore)
+	DetachDataStore()
+	GetDataStore() *ChartDataStore
+
+	// Metrics returns the ingestion and rendering counters, WritePrometheusMetrics writes them in
+	// the Prometheus text format and SetMetricsRegistry forwards them to the app's registry
+	Metrics() ChartMetrics
+	WritePrometheusMetrics(out io.Writer) error
+	SetMetricsRegistry(registry MetricsRegistry)
+
+	// SetMinSize set the minimum size limit for the linechart, GetMinSize returns it; MinSize
+	// reports it grown to fit the visible labels
 	SetMinSize(s fyne.Size)
+	GetMinSize() fyne.Size
 
 	// EnableDebugLogging turns method entry/exit logging on or off
 	EnableDebugLogging(enable bool)
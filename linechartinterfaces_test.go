@@ -26,4 +26,18 @@ var _ = Describe("Linechartinterfaces", func() {
 		By("LineChart interface should be implemented")
 		Expect(reflect.TypeOf(chart).Implements(cIntf)).To(BeTrue())
 	})
+
+	It("Ensure every constructor returns the same canonical chart", func() {
+		var dataPoints = map[string][]*sknlinechart.ChartDatapoint{}
+		viaNew, _ := sknlinechart.New("Title", "Footer", 1, 10, &dataPoints)
+		viaAlias, _ := sknlinechart.NewLineChart("Title", "Footer", 1, 10, &dataPoints)
+		viaOptions, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		viaOptionsAlias, err := sknlinechart.NewLineChartViaOptions(sknlinechart.NewChartOptions())
+		Expect(err).NotTo(HaveOccurred())
+
+		expected := reflect.TypeOf(viaNew)
+		Expect(reflect.TypeOf(viaAlias)).To(Equal(expected))
+		Expect(reflect.TypeOf(viaOptions)).To(Equal(expected))
+		Expect(reflect.TypeOf(viaOptionsAlias)).To(Equal(expected))
+	})
 })
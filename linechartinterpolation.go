@@ -0,0 +1,110 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// LineInterpolation how the line between two datapoints is drawn
+type LineInterpolation int
+
+const (
+	LineLinear LineInterpolation = iota // straight segments between points, the default
+	LineSpline                          // a smooth Catmull-Rom curve through the points
+	LineStep                            // each value held level until the next point, for counters and state signals
+)
+
+// splineSteps segments each curve between two datapoints is drawn with
+const splineSteps = 8
+
+// String returns the interpolation's name
+func (i LineInterpolation) String() string {
+	switch i {
+	case LineLinear:
+		return "linear"
+	case LineSpline:
+		return "spline"
+	case LineStep:
+		return "step"
+	}
+	return fmt.Sprintf("LineInterpolation(%d)", int(i))
+}
+
+// valid returns true for the known interpolations
+func (i LineInterpolation) valid() bool {
+	return i >= LineLinear && i <= LineStep
+}
+
+// SetLineInterpolation draws the lines between datapoints straight, as smooth splines for dashboards
+// where the trend matters more than the samples, or as steps holding each value until the next point.
+// Splines pass through every point but may overshoot between sharp changes
+func (w *LineChartSkn) SetLineInterpolation(mode LineInterpolation) error {
+	w.debugLog("LineChartSkn::SetLineInterpolation() ENTER")
+	if !mode.valid() {
+		w.debugLog("LineChartSkn::SetLineInterpolation() ERROR EXIT")
+		return fmt.Errorf("SetLineInterpolation() unknown interpolation: %v", mode)
+	}
+	w.mapsLock.Lock()
+	w.lineInterpolation = mode
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetLineInterpolation() EXIT")
+	return nil
+}
+
+// GetLineInterpolation returns how the lines between datapoints are drawn
+func (w *LineChartSkn) GetLineInterpolation() LineInterpolation {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.lineInterpolation
+}
+
+// curvePoint a drawn datapoint of a run of connected points, with the color of the line leading to it
+type curvePoint struct {
+	pos   fyne.Position
+	color color.Color
+}
+
+// curveRun draws the lines joining a run of connected points with the chart's interpolation using the
+// series' pooled lines from index used on, returns the next unused index and the dash phase at the run's end
+// caller must hold the mapsLock
+func (r *lineChartRenderer) curveRun(series string, run []curvePoint, used int, stroke, phase float32) (int, float32) {
+	for i := 1; i < len(run); i++ {
+		from, to, c := run[i-1].pos, run[i].pos, run[i].color
+		switch r.widget.lineInterpolation {
+		case LineStep:
+			corner := fyne.NewPos(to.X, from.Y)
+			used, phase = r.dashSegment(series, used, from, corner, c, stroke, phase)
+			used, phase = r.dashSegment(series, used, corner, to, c, stroke, phase)
+		case LineSpline:
+			before, after := from, to
+			if i > 1 {
+				before = run[i-2].pos
+			}
+			if i+1 < len(run) {
+				after = run[i+1].pos
+			}
+			last := from
+			for step := 1; step <= splineSteps; step++ {
+				next := catmullRom(before, from, to, after, float32(step)/splineSteps)
+				used, phase = r.dashSegment(series, used, last, next, c, stroke, phase)
+				last = next
+			}
+		}
+	}
+	return used, phase
+}
+
+// catmullRom returns the point t of the way along the uniform Catmull-Rom curve from p1 to p2
+func catmullRom(p0, p1, p2, p3 fyne.Position, t float32) fyne.Position {
+	t2, t3 := t*t, t*t*t
+	at := func(a, b, c, d float32) float32 {
+		v := 0.5 * ((2 * b) + (-a+c)*t + (2*a-5*b+4*c-d)*t2 + (-a+3*b-3*c+d)*t3)
+		return float32(math.Round(float64(v)))
+	}
+	return fyne.NewPos(at(p0.X, p1.X, p2.X, p3.X), at(p0.Y, p1.Y, p2.Y, p3.Y))
+}
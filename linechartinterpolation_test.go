@@ -0,0 +1,74 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Line interpolation", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+	)
+
+	center := func(point *sknlinechart.ChartDatapoint) fyne.Position {
+		top, bottom := (*point).MarkerPosition()
+		return fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)
+	}
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 10; i++ {
+			value := float32(20)
+			if i%2 == 1 {
+				value = 60
+			}
+			point := sknlinechart.NewChartDatapoint(value, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"State": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should hold each value level until the next point in step mode", func() {
+		Expect(lc.GetLineInterpolation()).To(Equal(sknlinechart.LineLinear))
+		Expect(lc.SetLineInterpolation(sknlinechart.LineStep)).To(Succeed())
+
+		lines := seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))
+		Expect(lines).To(HaveLen(2 * (len(points) - 1)))
+		for _, line := range lines {
+			Expect(line.Position1.X == line.Position2.X || line.Position1.Y == line.Position2.Y).To(BeTrue())
+		}
+		// the level leaving the third point runs at its height to below the fourth
+		Expect(lines).To(ContainElement(HaveField("Position2", fyne.NewPos(center(points[3]).X, center(points[2]).Y))))
+	})
+	It("should draw a smooth curve through every point in spline mode", func() {
+		Expect(lc.SetLineInterpolation(sknlinechart.LineSpline)).To(Succeed())
+
+		lines := seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))
+		Expect(len(lines)).To(BeNumerically(">", 4*(len(points)-1)))
+		for _, point := range points[1:] { // each curve ends on its point's marker
+			Expect(lines).To(ContainElement(HaveField("Position2", center(point))))
+		}
+
+		Expect(lc.SetLineInterpolation(sknlinechart.LineLinear)).To(Succeed())
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(HaveLen(len(points)))
+	})
+	It("should reject unknown interpolations and keep the mode in saved state", func() {
+		Expect(lc.SetLineInterpolation(sknlinechart.LineInterpolation(9))).To(HaveOccurred())
+		Expect(lc.SetLineInterpolation(sknlinechart.LineStep)).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.GetLineInterpolation()).To(Equal(sknlinechart.LineStep))
+	})
+})
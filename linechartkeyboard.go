@@ -0,0 +1,153 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// FocusGained From the Focusable Interface, the chart takes focus when tapped
+func (w *LineChartSkn) FocusGained() {
+	w.debugLog("LineChartSkn::FocusGained()")
+}
+
+// FocusLost From the Focusable Interface, removes the keyboard cursor
+func (w *LineChartSkn) FocusLost() {
+	w.debugLog("LineChartSkn::FocusLost()")
+	w.hideKeyboardCursor()
+}
+
+// TypedRune From the Focusable Interface, unused
+func (w *LineChartSkn) TypedRune(rune) {}
+
+// TypedKey From the Focusable Interface, steps a cursor snapped to the datapoints of the focused series.
+// Left and Right move one sample, Home and End jump to the first and last, Up and Down focus
+// the previous or next series, and Escape removes the cursor
+func (w *LineChartSkn) TypedKey(key *fyne.KeyEvent) {
+	w.debugLog("LineChartSkn::TypedKey() ENTER")
+	switch key.Name {
+	case fyne.KeyLeft:
+		w.moveKeyboardCursor(0, -1)
+	case fyne.KeyRight:
+		w.moveKeyboardCursor(0, 1)
+	case fyne.KeyHome:
+		w.moveKeyboardCursor(0, math.MinInt32)
+	case fyne.KeyEnd:
+		w.moveKeyboardCursor(0, math.MaxInt32)
+	case fyne.KeyUp:
+		w.moveKeyboardCursor(-1, 0)
+	case fyne.KeyDown:
+		w.moveKeyboardCursor(1, 0)
+	case fyne.KeyEscape:
+		w.hideKeyboardCursor()
+	}
+	w.debugLog("LineChartSkn::TypedKey() EXIT")
+}
+
+// GetKeyboardCursor returns the series and datapoint index under the keyboard cursor, false when it is not shown
+func (w *LineChartSkn) GetKeyboardCursor() (string, int, bool) {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	points := w.dataPoints[w.cursorSeries]
+	if !w.keyboardCursor || len(points) == 0 || w.hiddenSeries[w.cursorSeries] {
+		return "", 0, false
+	}
+	return w.cursorSeries, w.cursorIndexIn(points), true
+}
+
+// moveKeyboardCursor steps the cursor seriesStep series and indexStep samples. The first key
+// press shows the cursor on the highlighted series, at the crosshair or the latest sample
+func (w *LineChartSkn) moveKeyboardCursor(seriesStep, indexStep int) {
+	w.mapsLock.Lock()
+	var names []string
+	for key, points := range w.dataPoints {
+		if len(points) > 0 && !w.hiddenSeries[key] {
+			names = append(names, key)
+		}
+	}
+	if len(names) == 0 {
+		w.mapsLock.Unlock()
+		return
+	}
+	sort.Strings(names)
+	current := sort.SearchStrings(names, w.cursorSeries)
+	if !w.keyboardCursor || current == len(names) || names[current] != w.cursorSeries {
+		w.cursorSeries = names[0]
+		if _, ok := w.dataPoints[w.highlightedSeries]; ok && !w.hiddenSeries[w.highlightedSeries] {
+			w.cursorSeries = w.highlightedSeries
+		}
+		w.cursorIndex = len(w.dataPoints[w.cursorSeries]) - 1
+		if w.crosshairActive {
+			index, _ := w.positionToData(w.crosshairPosition)
+			w.cursorIndex = int(math.Round(float64(index)))
+		}
+	} else {
+		w.cursorSeries = names[(current+seriesStep+len(names))%len(names)]
+		w.cursorIndex += indexStep
+	}
+	w.keyboardCursor = true
+	points := w.dataPoints[w.cursorSeries]
+	w.cursorIndex = w.cursorIndexIn(points)
+	series, point := strings.Clone(w.cursorSeries), (*points[w.cursorIndex]).Copy()
+	pos := w.dataToPosition(w.pointX(w.cursorIndex, point), w.plotValue(series, w.cursorIndex, point))
+	callback := w.OnHoverPointCallback
+	w.mapsLock.Unlock()
+
+	w.broadcastCursor(pos)
+	if callback != nil {
+		callback(series, point)
+	}
+	w.Refresh()
+}
+
+// hideKeyboardCursor removes the keyboard cursor, if shown
+func (w *LineChartSkn) hideKeyboardCursor() {
+	w.mapsLock.Lock()
+	shown := w.keyboardCursor
+	w.keyboardCursor = false
+	w.mapsLock.Unlock()
+	if shown {
+		w.broadcastCursorOut()
+		w.Refresh()
+	}
+}
+
+// cursorIndexIn limits the cursor index to the datapoints of its series
+// caller must hold the mapsLock
+func (w *LineChartSkn) cursorIndexIn(points []*ChartDatapoint) int {
+	if w.cursorIndex >= len(points) {
+		return len(points) - 1
+	}
+	if w.cursorIndex < 0 {
+		return 0
+	}
+	return w.cursorIndex
+}
+
+// keyboardCursorReadout returns the position and readout text of the keyboard cursor, false when it is not shown
+// caller must hold the mapsLock
+func (w *LineChartSkn) keyboardCursorReadout() (fyne.Position, string, string, bool) {
+	points := w.dataPoints[w.cursorSeries]
+	if !w.keyboardCursor || len(points) == 0 || w.hiddenSeries[w.cursorSeries] {
+		return fyne.Position{}, "", "", false
+	}
+	idx := w.cursorIndexIn(points)
+	point := *points[idx]
+	xText := fmt.Sprint(w.pointXText(idx*w.chartXScaleMultiplier, point), "  [", point.Timestamp(), "]")
+	yText := fmt.Sprint(w.cursorSeries, " ", w.Message(MessageValue), ": ", w.pointValueText(w.cursorSeries, point))
+	return w.dataToPosition(w.pointX(idx, point), w.plotValue(w.cursorSeries, idx, point)), w.clipText(xText), w.clipText(yText), true
+}
+
+// requestFocus gives the chart keyboard focus when it is shown on a canvas
+func (w *LineChartSkn) requestFocus() {
+	app := fyne.CurrentApp()
+	if app == nil {
+		return
+	}
+	if c := app.Driver().CanvasForObject(w); c != nil && c.Focused() != w {
+		c.Focus(w)
+	}
+}
@@ -0,0 +1,89 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Keyboard cursor", func() {
+	var lc *sknlinechart.LineChartSkn
+
+	BeforeEach(func() {
+		var cpu, memory []*sknlinechart.ChartDatapoint
+		for i := 0; i < 10; i++ {
+			low := sknlinechart.NewChartDatapoint(float32(10+i), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			cpu = append(cpu, &low)
+			high := sknlinechart.NewChartDatapoint(float32(60+i), theme.ColorRed, time.Now().Format(time.RFC1123))
+			memory = append(memory, &high)
+		}
+		chart, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"CPU": cpu, "Memory": memory})))
+		lc = chart.(*sknlinechart.LineChartSkn)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	press := func(name fyne.KeyName) {
+		lc.TypedKey(&fyne.KeyEvent{Name: name})
+	}
+
+	It("should take focus when tapped", func() {
+		w := test.NewWindow(lc)
+		defer w.Close()
+		test.Tap(lc)
+		Expect(w.Canvas().Focused()).To(Equal(lc))
+	})
+	It("should step one sample at a time along the focused series", func() {
+		_, _, shown := lc.GetKeyboardCursor()
+		Expect(shown).To(BeFalse())
+
+		var hovered []float32
+		lc.SetOnHoverPointCallback(func(series string, dataPoint sknlinechart.ChartDatapoint) {
+			hovered = append(hovered, dataPoint.Value())
+		})
+		press(fyne.KeyLeft) // shows the cursor on the latest sample
+		series, index, shown := lc.GetKeyboardCursor()
+		Expect(shown).To(BeTrue())
+		Expect(series).To(Equal("CPU"))
+		Expect(index).To(Equal(9))
+
+		press(fyne.KeyLeft)
+		press(fyne.KeyLeft)
+		_, index, _ = lc.GetKeyboardCursor()
+		Expect(index).To(Equal(7))
+		Expect(hovered).To(Equal([]float32{19, 18, 17}))
+		Expect(visibleText(lc, "CPU Value: 17")).NotTo(BeNil())
+
+		press(fyne.KeyHome)
+		press(fyne.KeyLeft)
+		_, index, _ = lc.GetKeyboardCursor()
+		Expect(index).To(Equal(0))
+		press(fyne.KeyEnd)
+		press(fyne.KeyRight)
+		_, index, _ = lc.GetKeyboardCursor()
+		Expect(index).To(Equal(9))
+	})
+	It("should switch series and disappear on escape", func() {
+		press(fyne.KeyRight)
+		press(fyne.KeyHome)
+		press(fyne.KeyDown)
+		series, index, _ := lc.GetKeyboardCursor()
+		Expect(series).To(Equal("Memory"))
+		Expect(index).To(Equal(0))
+		Expect(visibleText(lc, "Memory Value: 60")).NotTo(BeNil())
+
+		press(fyne.KeyDown)
+		series, _, _ = lc.GetKeyboardCursor()
+		Expect(series).To(Equal("CPU"))
+
+		press(fyne.KeyEscape)
+		_, _, shown := lc.GetKeyboardCursor()
+		Expect(shown).To(BeFalse())
+		Expect(visibleText(lc, "CPU Value: 10")).To(BeNil())
+	})
+})
@@ -0,0 +1,220 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// LegendPosition where the series legend is placed on the chart
+type LegendPosition int
+
+const (
+	LegendBottom   LegendPosition = iota // one row under the x scale, right aligned
+	LegendTop                            // one row above the plot area
+	LegendRight                          // one column along the right edge of the plot area
+	LegendFloating                       // one column inside the top left corner of the plot area
+)
+
+// LegendValue statistic of a series shown beside its legend entry
+type LegendValue int
+
+const (
+	LegendMin  LegendValue = iota // lowest value
+	LegendAvg                     // mean value
+	LegendMax                     // highest value
+	LegendLast                    // latest value
+)
+
+// String returns the column heading of the statistic
+func (v LegendValue) String() string {
+	switch v {
+	case LegendMin:
+		return "min"
+	case LegendAvg:
+		return "avg"
+	case LegendMax:
+		return "max"
+	case LegendLast:
+		return "last"
+	}
+	return fmt.Sprint("LegendValue(", int(v), ")")
+}
+
+// hiddenLegendOpacity opacity of the legend entry of a hidden series
+const hiddenLegendOpacity float32 = 0.35
+
+// legendBound on-screen area of one legend entry
+type legendBound struct {
+	series   string
+	min, max fyne.Position
+}
+
+// IsLegendVisible returns true when the series legend is displayed
+func (w *LineChartSkn) IsLegendVisible() bool {
+	return w.enableColorLegend
+}
+
+// SetLegendVisible shows or hides the series legend; clicking a legend entry hides or shows its series
+func (w *LineChartSkn) SetLegendVisible(visible bool) {
+	w.debugLog("LineChartSkn::SetLegendVisible()")
+	w.enableColorLegend = visible
+	w.Refresh()
+}
+
+// GetLegendPosition returns where the series legend is placed
+func (w *LineChartSkn) GetLegendPosition() LegendPosition {
+	return w.legendPosition
+}
+
+// SetLegendPosition places the series legend on the top, bottom, or right of the plot, or floating inside it
+func (w *LineChartSkn) SetLegendPosition(position LegendPosition) {
+	w.debugLog("LineChartSkn::SetLegendPosition()")
+	w.mapsLock.Lock()
+	w.legendPosition = position
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// GetLegendValues returns the statistics shown beside each legend entry, in column order
+func (w *LineChartSkn) GetLegendValues() []LegendValue {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return append([]LegendValue(nil), w.legendValues...)
+}
+
+// SetLegendValues shows the given statistics, in order, beside each series in the legend, like a
+// table legend; they are recomputed over the points inside the viewport on every refresh. The legend
+// becomes a column while values are shown; calling with no columns returns it to names only
+func (w *LineChartSkn) SetLegendValues(columns ...LegendValue) {
+	w.debugLog("LineChartSkn::SetLegendValues()")
+	w.mapsLock.Lock()
+	w.legendValues = append([]LegendValue(nil), columns...)
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// legendValuesText formats the series' legend statistics over the drawable points inside the viewport
+// caller must hold the mapsLock
+func (w *LineChartSkn) legendValuesText(series string) string {
+	stats := w.seriesStats(series)
+	cells := make([]string, 0, len(w.legendValues))
+	for _, column := range w.legendValues {
+		var value float32
+		switch column {
+		case LegendMin:
+			value = stats.Min
+		case LegendAvg:
+			value = stats.Mean
+		case LegendMax:
+			value = stats.Max
+		case LegendLast:
+			value = stats.Last
+		}
+		if stats.Count == 0 {
+			cells = append(cells, fmt.Sprintf("%s %7s", column, "-"))
+		} else {
+			cells = append(cells, fmt.Sprintf("%s %7.2f", column, value))
+		}
+	}
+	return strings.Join(cells, "  ")
+}
+
+// layoutLegendValues writes each legend entry's statistics into the column beside the legend,
+// level with its entry, returning the column's size
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutLegendValues() fyne.Size {
+	for len(r.legendValues.Objects) < len(r.colorLegend.Objects) {
+		t := canvas.NewText("", theme.ForegroundColor())
+		t.TextStyle = fyne.TextStyle{Monospace: true}
+		r.legendValues.Add(t)
+	}
+	r.legendValues.Objects = r.legendValues.Objects[:len(r.colorLegend.Objects)]
+
+	var size fyne.Size
+	for idx, o := range r.colorLegend.Objects {
+		name := o.(*canvas.Text)
+		t := r.legendValues.Objects[idx].(*canvas.Text)
+		t.Text = r.widget.legendValuesText(name.Text)
+		t.Color = name.Color
+		t.TextSize = name.TextSize
+		t.Resize(t.MinSize())
+		t.Move(fyne.NewPos(0, name.Position().Y))
+		size = size.Max(fyne.NewSize(t.Size().Width, name.Position().Y+t.Size().Height))
+	}
+	r.legendValues.Resize(size)
+	return size
+}
+
+// HideSeries stops drawing the series while keeping its data
+func (w *LineChartSkn) HideSeries(seriesName string) error {
+	return w.setSeriesVisible(seriesName, false)
+}
+
+// ShowSeries draws a series hidden by HideSeries or its legend entry again
+func (w *LineChartSkn) ShowSeries(seriesName string) error {
+	return w.setSeriesVisible(seriesName, true)
+}
+
+// IsSeriesVisible returns false when the series is hidden or does not exist
+func (w *LineChartSkn) IsSeriesVisible(seriesName string) bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	_, ok := w.dataPoints[seriesName]
+	return ok && !w.hiddenSeries[seriesName]
+}
+
+// setSeriesVisible shows or hides an existing series
+func (w *LineChartSkn) setSeriesVisible(seriesName string, visible bool) error {
+	w.debugLog("LineChartSkn::setSeriesVisible() ENTER")
+	w.mapsLock.Lock()
+	_, ok := w.dataPoints[seriesName]
+	if ok {
+		w.setSeriesHidden(seriesName, !visible)
+	}
+	w.mapsLock.Unlock()
+	if !ok {
+		w.debugLog("LineChartSkn::setSeriesVisible() ERROR EXIT")
+		return fmt.Errorf("setSeriesVisible() series not found: %s", seriesName)
+	}
+	w.Refresh()
+	w.debugLog("LineChartSkn::setSeriesVisible() EXIT")
+	return nil
+}
+
+// toggleLegendAt hides or shows the series whose legend entry is under pos, returns false when none is
+func (w *LineChartSkn) toggleLegendAt(pos fyne.Position) bool {
+	if !w.enableColorLegend {
+		return false
+	}
+	w.mapsLock.Lock()
+	toggled := false
+	for _, b := range w.legendBounds {
+		if pos.X >= b.min.X && pos.X <= b.max.X && pos.Y >= b.min.Y && pos.Y <= b.max.Y {
+			w.setSeriesHidden(b.series, !w.hiddenSeries[b.series])
+			toggled = true
+			break
+		}
+	}
+	w.mapsLock.Unlock()
+	if toggled {
+		w.Refresh()
+	}
+	return toggled
+}
+
+// setSeriesHidden caller must hold the mapsLock
+func (w *LineChartSkn) setSeriesHidden(seriesName string, hidden bool) {
+	if w.hiddenSeries == nil {
+		w.hiddenSeries = map[string]bool{}
+	}
+	if hidden {
+		w.hiddenSeries[seriesName] = true
+	} else {
+		delete(w.hiddenSeries, seriesName)
+	}
+	w.relayoutRequired = true
+}
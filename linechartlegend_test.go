@@ -0,0 +1,149 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+// legendEntry returns the chart-relative center of the visible legend entry for a series, false when none
+func legendEntry(lc sknlinechart.LineChart, series string) (fyne.Position, bool) {
+	var found fyne.Position
+	ok := false
+	var walk func(objs []fyne.CanvasObject, offset fyne.Position)
+	walk = func(objs []fyne.CanvasObject, offset fyne.Position) {
+		for _, o := range objs {
+			switch v := o.(type) {
+			case *fyne.Container:
+				if v.Visible() {
+					walk(v.Objects, offset.Add(v.Position()))
+				}
+			case *canvas.Text:
+				if !ok && v.Visible() && v.Text == series && offset != (fyne.Position{}) {
+					found = offset.Add(v.Position()).AddXY(v.Size().Width/2, v.Size().Height/2)
+					ok = true
+				}
+			}
+		}
+	}
+	walk(test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects(), fyne.Position{})
+	return found, ok
+}
+
+var _ = Describe("Interactive legend", func() {
+	var (
+		lc     sknlinechart.LineChart
+		skn    *sknlinechart.LineChartSkn
+		points []*sknlinechart.ChartDatapoint
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 20; i++ {
+			point := sknlinechart.NewChartDatapoint(50, theme.ColorRed, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+		skn = lc.(*sknlinechart.LineChartSkn)
+	})
+
+	It("should hide and show a series when its entry is clicked", func() {
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorRed))).NotTo(BeEmpty())
+		pos, ok := legendEntry(lc, "Testing")
+		Expect(ok).To(BeTrue())
+
+		skn.Tapped(&fyne.PointEvent{Position: pos})
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorRed))).To(BeEmpty())
+		top, _ := (*points[5]).MarkerPosition()
+		Expect(top.IsZero()).To(BeTrue())
+
+		skn.Tapped(&fyne.PointEvent{Position: pos})
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorRed))).NotTo(BeEmpty())
+		top, _ = (*points[5]).MarkerPosition()
+		Expect(top.IsZero()).To(BeFalse())
+	})
+	It("should place the legend at the requested position", func() {
+		bottom, _ := legendEntry(lc, "Testing")
+		lc.SetLegendPosition(sknlinechart.LegendTop)
+		Expect(lc.GetLegendPosition()).To(Equal(sknlinechart.LegendTop))
+		top, _ := legendEntry(lc, "Testing")
+		Expect(top.Y).To(BeNumerically("<", bottom.Y))
+
+		lc.SetLegendPosition(sknlinechart.LegendFloating)
+		floating, _ := legendEntry(lc, "Testing")
+		Expect(floating.X).To(BeNumerically("<", bottom.X))
+		Expect(floating.Y).To(BeNumerically(">", top.Y))
+	})
+	It("should not toggle series while the legend is hidden", func() {
+		pos, _ := legendEntry(lc, "Testing")
+		lc.SetLegendVisible(false)
+		Expect(lc.IsLegendVisible()).To(BeFalse())
+		_, ok := legendEntry(lc, "Testing")
+		Expect(ok).To(BeFalse())
+
+		skn.Tapped(&fyne.PointEvent{Position: pos})
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorRed))).NotTo(BeEmpty())
+	})
+	It("should hide and show series programmatically", func() {
+		Expect(lc.HideSeries("Unknown")).To(HaveOccurred())
+		Expect(lc.IsSeriesVisible("Unknown")).To(BeFalse())
+		Expect(lc.IsSeriesVisible("Testing")).To(BeTrue())
+		objects := len(test.WidgetRenderer(skn).Objects())
+
+		Expect(lc.HideSeries("Testing")).To(Succeed())
+		Expect(lc.IsSeriesVisible("Testing")).To(BeFalse())
+		Expect(test.WidgetRenderer(skn).Objects()).To(HaveLen(objects - 40))
+		Expect(lc.State().Series["Testing"]).To(HaveLen(20))
+
+		Expect(lc.ShowSeries("Testing")).To(Succeed())
+		Expect(lc.IsSeriesVisible("Testing")).To(BeTrue())
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorRed))).NotTo(BeEmpty())
+	})
+})
+
+var _ = Describe("Legend values", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		var points []sknlinechart.ChartDatapoint
+		for i := 1; i <= 10; i++ {
+			points = append(points, sknlinechart.NewChartDatapoint(float32(i*5), theme.ColorRed, time.Now().Format(time.RFC1123)))
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithLegendValues(sknlinechart.LegendMin, sknlinechart.LegendAvg, sknlinechart.LegendMax, sknlinechart.LegendLast)))
+		lc.ApplyDataPoints("Testing", points)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should show each series' statistics beside its entry", func() {
+		Expect(lc.GetLegendValues()).To(HaveLen(4))
+		values := visibleText(lc, "min    5.00  avg   27.50  max   50.00  last   50.00")
+		Expect(values).NotTo(BeNil())
+		Expect(values.Color).To(Equal(theme.PrimaryColorNamed(theme.ColorRed)))
+		pos, ok := legendEntry(lc, "Testing")
+		Expect(ok).To(BeTrue())
+		Expect(pos.X + values.Size().Width).To(BeNumerically("<", 800)) // the values column fits beside the entry
+	})
+	It("should recompute the statistics over the visible window", func() {
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 0, XMax: 3, YMin: 0, YMax: 100})).To(Succeed())
+		Expect(visibleText(lc, "min    5.00  avg   12.50  max   20.00  last   20.00")).NotTo(BeNil())
+
+		lc.SetLegendValues(sknlinechart.LegendLast)
+		Expect(visibleText(lc, "last   20.00")).NotTo(BeNil())
+	})
+	It("should return the legend to names only without columns", func() {
+		lc.SetLegendValues()
+		Expect(lc.GetLegendValues()).To(BeEmpty())
+		Expect(visibleText(lc, "min    5.00  avg   27.50  max   50.00  last   50.00")).To(BeNil())
+		_, ok := legendEntry(lc, "Testing")
+		Expect(ok).To(BeTrue())
+	})
+})
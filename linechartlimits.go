@@ -0,0 +1,80 @@
+package sknlinechart
+
+import (
+	"fmt"
+)
+
+// SetSeriesPointLimit keeps fewer datapoints for a series than the chart's point limit, as set by
+// WithXLimit, so a slow series can roll off sooner than a fast one sharing the chart. Existing
+// points beyond the new limit are rolled off now; a limit of 0 restores the chart's limit.
+// The series' ring buffer is sized from its limit, so fast feeds roll points off without copying the series
+func (w *LineChartSkn) SetSeriesPointLimit(seriesName string, limit int) error {
+	w.debugLog("LineChartSkn::SetSeriesPointLimit() ENTER")
+	w.mapsLock.Lock()
+	err := w.setSeriesPointLimit(seriesName, limit)
+	w.mapsLock.Unlock()
+	if err != nil {
+		w.debugLog("LineChartSkn::SetSeriesPointLimit() ERROR EXIT")
+		return err
+	}
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetSeriesPointLimit() EXIT")
+	return nil
+}
+
+// GetSeriesPointLimit returns the point limit applied to the series, the chart's limit unless overridden
+func (w *LineChartSkn) GetSeriesPointLimit(seriesName string) int {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.seriesPointLimit(seriesName)
+}
+
+// setSeriesPointLimit validates and stores the limit, rolling off points beyond it
+// caller must hold the mapsLock
+func (w *LineChartSkn) setSeriesPointLimit(seriesName string, limit int) error {
+	if limit < 0 || limit > w.dataPointXLimit {
+		return fmt.Errorf("SetSeriesPointLimit() [%s] limit must be between 1 and the chart's limit of %d, or 0 to remove: %d", seriesName, w.dataPointXLimit, limit)
+	}
+	if limit == 0 || limit == w.dataPointXLimit {
+		delete(w.pointLimits, seriesName)
+		return nil
+	}
+	if w.pointLimits == nil {
+		w.pointLimits = map[string]int{}
+	}
+	w.pointLimits[seriesName] = limit
+
+	if excess := len(w.dataPoints[seriesName]) - limit; excess > 0 {
+		w.metrics.dropped(w.dropOldest(seriesName, excess))
+	}
+	return nil
+}
+
+// dropOldest rolls off the series' count oldest datapoints with their annotations and gaps, returns the count
+// caller must hold the mapsLock
+func (w *LineChartSkn) dropOldest(seriesName string, count int) int {
+	points := w.dataPoints[seriesName]
+	if count > len(points) {
+		count = len(points)
+	}
+	if count <= 0 {
+		return 0
+	}
+	w.dataPoints[seriesName] = append([]*ChartDatapoint(nil), points[count:]...)
+	w.markDirty(seriesName, 0)
+	for i := 0; i < count; i++ {
+		w.shiftAnnotations(seriesName)
+		w.shiftGaps(seriesName)
+	}
+	w.relayoutRequired = true
+	return count
+}
+
+// seriesPointLimit returns the series' own point limit or the chart's
+// caller must hold the mapsLock
+func (w *LineChartSkn) seriesPointLimit(seriesName string) int {
+	if limit, ok := w.pointLimits[seriesName]; ok {
+		return limit
+	}
+	return w.dataPointXLimit
+}
@@ -0,0 +1,73 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Per series point limits", func() {
+	var lc sknlinechart.LineChart
+
+	batch := func(count int) []sknlinechart.ChartDatapoint {
+		var points []sknlinechart.ChartDatapoint
+		for i := 0; i < count; i++ {
+			points = append(points, sknlinechart.NewChartDatapoint(float32(i), theme.ColorBlue, time.Now().Format(time.RFC1123)))
+		}
+		return points
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithXLimit(100),
+			sknlinechart.WithSeriesPointLimit("Slow", 20)))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+	})
+
+	It("should roll each series off at its own limit", func() {
+		lc.ApplyDataPoints("Fast", batch(60))
+		lc.ApplyDataPoints("Slow", batch(60))
+		Expect(lc.GetSeriesPointLimit("Fast")).To(Equal(100))
+		Expect(lc.GetSeriesPointLimit("Slow")).To(Equal(20))
+		Expect(lc.GetDataSeries("Fast")).To(HaveLen(60))
+		slow := lc.GetDataSeries("Slow")
+		Expect(slow).To(HaveLen(21))
+		Expect(slow[20].Value()).To(BeNumerically("==", 59))
+
+		Expect(lc.ApplyDataSeries("Slow", make([]*sknlinechart.ChartDatapoint, 30))).To(HaveOccurred())
+	})
+	It("should roll off existing points when the limit is lowered", func() {
+		lc.ApplyDataPoints("Fast", batch(60))
+		Expect(lc.AddAnnotation("Fast", 5, "rolls off")).To(Succeed())
+		Expect(lc.AddAnnotation("Fast", 55, "stays")).To(Succeed())
+
+		Expect(lc.SetSeriesPointLimit("Fast", 101)).To(HaveOccurred())
+		Expect(lc.SetSeriesPointLimit("Fast", 10)).To(Succeed())
+		fast := lc.GetDataSeries("Fast")
+		Expect(fast).To(HaveLen(10))
+		Expect(fast[0].Value()).To(BeNumerically("==", 50))
+		Expect(lc.GetAnnotations()).To(Equal([]sknlinechart.Annotation{{Series: "Fast", Index: 5, Text: "stays"}}))
+
+		Expect(lc.SetSeriesPointLimit("Fast", 0)).To(Succeed())
+		Expect(lc.GetSeriesPointLimit("Fast")).To(Equal(100))
+	})
+	It("should keep 600 points of a fast series and 50 of a slow one given New's point limit", func() {
+		dataPoints := map[string][]*sknlinechart.ChartDatapoint{}
+		chart, err := sknlinechart.New("Limits", "", 1, 10, &dataPoints, sknlinechart.WithXLimit(600))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chart.SetSeriesPointLimit("Fast", 600)).To(Succeed())
+		Expect(chart.SetSeriesPointLimit("Slow", 50)).To(Succeed())
+		chart.ApplyDataPoints("Fast", batch(700))
+		chart.ApplyDataPoints("Slow", batch(700))
+		Expect(chart.GetDataSeries("Fast")).To(HaveLen(601))
+		Expect(chart.GetDataSeries("Slow")).To(HaveLen(51))
+		stopRedraws(chart)
+	})
+})
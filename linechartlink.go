@@ -0,0 +1,184 @@
+package sknlinechart
+
+import (
+	"errors"
+	"sync"
+
+	"fyne.io/fyne/v2"
+)
+
+// ChartLinkGroup links charts whose datapoint indexes line up, like CPU, memory and network
+// charts stacked on a dashboard: hovering one chart moves a cursor to the same index on the
+// others, and zooming or panning one shows the same index range on the others
+type ChartLinkGroup struct {
+	lock   sync.RWMutex
+	charts []*LineChartSkn
+}
+
+// NewChartLinkGroup creates a group linking the given charts
+func NewChartLinkGroup(charts ...LineChart) (*ChartLinkGroup, error) {
+	g := &ChartLinkGroup{}
+	for _, chart := range charts {
+		err := g.Add(chart)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// Add links the chart to the group, leaving any group it was in before
+func (g *ChartLinkGroup) Add(chart LineChart) error {
+	lc, ok := chart.(*LineChartSkn)
+	if !ok || lc == nil {
+		return errors.New("ChartLinkGroup::Add() chart is not a *LineChartSkn")
+	}
+	if current := lc.GetLinkGroup(); current != nil {
+		if current == g {
+			return nil
+		}
+		current.Remove(lc)
+	}
+	g.lock.Lock()
+	g.charts = append(g.charts, lc)
+	g.lock.Unlock()
+
+	lc.mapsLock.Lock()
+	lc.linkGroup = g
+	lc.mapsLock.Unlock()
+	return nil
+}
+
+// Remove unlinks the chart, returns false when it was not in the group
+func (g *ChartLinkGroup) Remove(chart LineChart) bool {
+	lc, ok := chart.(*LineChartSkn)
+	if !ok {
+		return false
+	}
+	g.lock.Lock()
+	removed := false
+	for idx, member := range g.charts {
+		if member == lc {
+			g.charts = append(g.charts[:idx], g.charts[idx+1:]...)
+			removed = true
+			break
+		}
+	}
+	g.lock.Unlock()
+	if removed {
+		lc.mapsLock.Lock()
+		lc.linkGroup = nil
+		lc.crosshairLinked = false
+		lc.mapsLock.Unlock()
+		lc.Refresh()
+	}
+	return removed
+}
+
+// Charts returns the linked charts in the order they were added
+func (g *ChartLinkGroup) Charts() []LineChart {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	charts := make([]LineChart, 0, len(g.charts))
+	for _, lc := range g.charts {
+		charts = append(charts, lc)
+	}
+	return charts
+}
+
+// others returns the linked charts except source
+func (g *ChartLinkGroup) others(source *LineChartSkn) []*LineChartSkn {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	var charts []*LineChartSkn
+	for _, lc := range g.charts {
+		if lc != source {
+			charts = append(charts, lc)
+		}
+	}
+	return charts
+}
+
+// GetLinkGroup returns the group the chart is linked to, nil when it is not linked
+func (w *LineChartSkn) GetLinkGroup() *ChartLinkGroup {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.linkGroup
+}
+
+// broadcastCursor moves the cursor of the linked charts to the index under pos,
+// hiding it when pos is outside the plot area
+func (w *LineChartSkn) broadcastCursor(pos fyne.Position) {
+	group := w.GetLinkGroup()
+	if group == nil {
+		return
+	}
+	w.mapsLock.RLock()
+	index, _ := w.positionToData(pos)
+	inside := w.isInsidePlotArea(pos)
+	w.mapsLock.RUnlock()
+	for _, lc := range group.others(w) {
+		if inside {
+			lc.showLinkedCursor(index)
+		} else {
+			lc.hideLinkedCursor()
+		}
+	}
+}
+
+// broadcastCursorOut hides the cursor of the linked charts
+func (w *LineChartSkn) broadcastCursorOut() {
+	group := w.GetLinkGroup()
+	if group == nil {
+		return
+	}
+	for _, lc := range group.others(w) {
+		lc.hideLinkedCursor()
+	}
+}
+
+// broadcastViewport shows the index range of vp on the linked charts, nil resets their zoom
+func (w *LineChartSkn) broadcastViewport(vp *ChartViewport) {
+	group := w.GetLinkGroup()
+	if group == nil {
+		return
+	}
+	for _, lc := range group.others(w) {
+		lc.applyLinkedViewport(vp)
+	}
+}
+
+// showLinkedCursor draws a vertical cursor at the index hovered on a linked chart
+func (w *LineChartSkn) showLinkedCursor(index float32) {
+	w.mapsLock.Lock()
+	w.crosshairLinked = true
+	w.crosshairPosition = w.dataToPosition(index, w.currentViewport().YMin)
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// hideLinkedCursor removes the cursor placed by a linked chart
+func (w *LineChartSkn) hideLinkedCursor() {
+	w.mapsLock.Lock()
+	linked := w.crosshairLinked
+	w.crosshairLinked = false
+	w.mapsLock.Unlock()
+	if linked {
+		w.Refresh()
+	}
+}
+
+// applyLinkedViewport zooms to the index range of a linked chart's viewport, keeping this chart's value range
+func (w *LineChartSkn) applyLinkedViewport(source *ChartViewport) {
+	w.mapsLock.Lock()
+	if source == nil {
+		w.viewport = nil
+	} else {
+		vp := w.currentViewport()
+		vp.XMin, vp.XMax = source.XMin, source.XMax
+		w.viewport = &vp
+	}
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
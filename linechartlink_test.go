@@ -0,0 +1,78 @@
+package sknlinechart_test
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+// visibleTextWithPrefix returns the text of the first visible canvas.Text drawn by the chart starting with prefix
+func visibleTextWithPrefix(lc sknlinechart.LineChart, prefix string) string {
+	for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+		if t, ok := o.(*canvas.Text); ok && t.Visible() && strings.HasPrefix(t.Text, prefix) {
+			return t.Text
+		}
+	}
+	return ""
+}
+
+var _ = Describe("Linked charts", func() {
+	var (
+		cpu, memory sknlinechart.LineChart
+		group       *sknlinechart.ChartLinkGroup
+	)
+
+	BeforeEach(func() {
+		cpu, _ = makeUI("CPU", "Linked", 40)
+		memory, _ = makeUI("Memory", "Linked", 40)
+		cpu.Resize(fyne.NewSize(800, 400))
+		memory.Resize(fyne.NewSize(800, 400))
+		var err error
+		group, err = sknlinechart.NewChartLinkGroup(cpu, memory)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should list its charts and move a chart between groups", func() {
+		Expect(group.Charts()).To(Equal([]sknlinechart.LineChart{cpu, memory}))
+		Expect(cpu.GetLinkGroup()).To(BeIdenticalTo(group))
+
+		other, _ := sknlinechart.NewChartLinkGroup()
+		Expect(other.Add(memory)).To(Succeed())
+		Expect(group.Charts()).To(Equal([]sknlinechart.LineChart{cpu}))
+		Expect(memory.GetLinkGroup()).To(BeIdenticalTo(other))
+	})
+	It("should show the hovered index on the linked charts", func() {
+		Expect(visibleTextWithPrefix(memory, "Index: ")).To(BeEmpty())
+
+		me := &desktop.MouseEvent{}
+		me.Position = fyne.NewPos(400, 200)
+		cpu.(*sknlinechart.LineChartSkn).MouseMoved(me)
+		Expect(visibleTextWithPrefix(memory, "Index: ")).NotTo(BeEmpty())
+		Expect(visibleTextWithPrefix(memory, "Value: ")).To(BeEmpty())
+
+		cpu.(*sknlinechart.LineChartSkn).MouseOut()
+		Expect(visibleTextWithPrefix(memory, "Index: ")).To(BeEmpty())
+	})
+	It("should share the zoomed index range but keep each value range", func() {
+		Expect(memory.SetViewport(sknlinechart.ChartViewport{XMin: 0, XMax: 149, YMin: 20, YMax: 80})).To(Succeed())
+		Expect(cpu.GetViewport()).To(Equal(sknlinechart.ChartViewport{XMin: 0, XMax: 149, YMin: 0, YMax: 130}))
+
+		Expect(cpu.SetViewport(sknlinechart.ChartViewport{XMin: 10, XMax: 30, YMin: 0, YMax: 65})).To(Succeed())
+		Expect(memory.GetViewport()).To(Equal(sknlinechart.ChartViewport{XMin: 10, XMax: 30, YMin: 20, YMax: 80}))
+
+		cpu.ResetZoom()
+		Expect(memory.IsZoomed()).To(BeFalse())
+	})
+	It("should stop following once removed", func() {
+		Expect(group.Remove(memory)).To(BeTrue())
+		Expect(group.Remove(memory)).To(BeFalse())
+		Expect(cpu.SetViewport(sknlinechart.ChartViewport{XMin: 10, XMax: 30, YMin: 0, YMax: 65})).To(Succeed())
+		Expect(memory.IsZoomed()).To(BeFalse())
+	})
+})
@@ -0,0 +1,114 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// defaultMarkerSize pixel width of a datapoint marker when its series sets none
+const defaultMarkerSize float32 = 4
+
+// MarkerShape how the datapoint markers of a series are drawn, so overlapping series
+// stay distinguishable in print and for colorblind readers
+type MarkerShape int
+
+const (
+	MarkerCircle   MarkerShape = iota // filled circle, the default
+	MarkerSquare                      // filled square
+	MarkerDiamond                     // filled square stood on a corner
+	MarkerTriangle                    // upward pointing triangle outline
+	MarkerCross                       // upright cross
+)
+
+// String returns the shape's name
+func (s MarkerShape) String() string {
+	switch s {
+	case MarkerCircle:
+		return "circle"
+	case MarkerSquare:
+		return "square"
+	case MarkerDiamond:
+		return "diamond"
+	case MarkerTriangle:
+		return "triangle"
+	case MarkerCross:
+		return "cross"
+	}
+	return fmt.Sprintf("MarkerShape(%d)", int(s))
+}
+
+// seriesMarker returns the marker shape and pixel size of the series, its shape dealt it in monochrome mode
+// caller must hold the mapsLock
+func (w *LineChartSkn) seriesMarker(seriesName string) (MarkerShape, float32) {
+	style := w.seriesStyles[seriesName]
+	if style.MarkerSize <= 0 {
+		style.MarkerSize = defaultMarkerSize
+	}
+	if w.monochrome {
+		style.Marker = w.monochromeMarker(seriesName)
+	}
+	return style.Marker, style.MarkerSize
+}
+
+// drawsPooledLines true when the series' dashes, curves, or marker shapes are drawn by pooled lines,
+// which are laid out with the whole series rather than point by point
+// caller must hold the mapsLock
+func (w *LineChartSkn) drawsPooledLines(seriesName string) bool {
+	shape, _ := w.seriesMarker(seriesName)
+	return shape != MarkerCircle || w.seriesDashPattern(seriesName) != nil || w.lineInterpolation != LineLinear
+}
+
+// shapeMarker draws the series' marker shape centered on pos using its pooled lines from index used on,
+// returns the next unused index
+// caller must hold the mapsLock
+func (r *lineChartRenderer) shapeMarker(series string, used int, pos fyne.Position, c color.Color) int {
+	shape, size := r.widget.seriesMarker(series)
+	half := size / 2
+	var strokes [][2]fyne.Position
+	width := size
+	switch shape {
+	case MarkerSquare: // a line as wide as it is long
+		strokes = [][2]fyne.Position{{fyne.NewPos(pos.X-half, pos.Y), fyne.NewPos(pos.X+half, pos.Y)}}
+	case MarkerDiamond: // the square turned on its corner, spanning size across its diagonals
+		side := half / float32(math.Sqrt2)
+		width = size / float32(math.Sqrt2)
+		strokes = [][2]fyne.Position{{fyne.NewPos(pos.X-side, pos.Y-side), fyne.NewPos(pos.X+side, pos.Y+side)}}
+	case MarkerTriangle:
+		width = fyne.Max(1, size/4)
+		apex := fyne.NewPos(pos.X, pos.Y-half)
+		left := fyne.NewPos(pos.X-half, pos.Y+half)
+		right := fyne.NewPos(pos.X+half, pos.Y+half)
+		strokes = [][2]fyne.Position{{apex, left}, {left, right}, {right, apex}}
+	case MarkerCross:
+		width = fyne.Max(1, size/4)
+		strokes = [][2]fyne.Position{
+			{fyne.NewPos(pos.X-half, pos.Y), fyne.NewPos(pos.X+half, pos.Y)},
+			{fyne.NewPos(pos.X, pos.Y-half), fyne.NewPos(pos.X, pos.Y+half)},
+		}
+	}
+	for _, stroke := range strokes {
+		pool := r.shapeMarkers[series]
+		if used == len(pool) {
+			r.shapeMarkers[series] = append(pool, canvas.NewLine(c))
+		}
+		line := r.shapeMarkers[series][used]
+		line.StrokeColor = c
+		line.StrokeWidth = width
+		line.Position1, line.Position2 = stroke[0], stroke[1]
+		line.Show()
+		line.Refresh()
+		used++
+	}
+	return used
+}
+
+// hideShapeMarkers hides the series' pooled marker lines from index used on
+func (r *lineChartRenderer) hideShapeMarkers(series string, used int) {
+	for _, line := range r.shapeMarkers[series][used:] {
+		line.Hide()
+	}
+}
@@ -0,0 +1,81 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Per-series marker shapes", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+		blue   = theme.PrimaryColorNamed(theme.ColorBlue)
+	)
+
+	// visibleCircles counts the circle markers drawn
+	visibleCircles := func() int {
+		var count int
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if c, ok := o.(*canvas.Circle); ok && c.Visible() {
+				count++
+			}
+		}
+		return count
+	}
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 6; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(20+i*10), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should draw circles by default", func() {
+		Expect(visibleCircles()).To(Equal(6))
+		Expect(seriesLines(lc, blue)).To(HaveLen(6))
+		top, bottom := (*points[2]).MarkerPosition()
+		Expect(bottom.X - top.X).To(BeNumerically("==", 4))
+	})
+	It("should draw each shape in place of the circles", func() {
+		for shape, strokes := range map[sknlinechart.MarkerShape]int{
+			sknlinechart.MarkerSquare:   1,
+			sknlinechart.MarkerDiamond:  1,
+			sknlinechart.MarkerTriangle: 3,
+			sknlinechart.MarkerCross:    2,
+		} {
+			Expect(lc.SetSeriesStyle("Testing", sknlinechart.SeriesStyle{Marker: shape})).To(Succeed(), shape.String())
+			Expect(visibleCircles()).To(BeZero(), shape.String())
+			Expect(seriesLines(lc, blue)).To(HaveLen(6+6*strokes), shape.String())
+		}
+		Expect(lc.SetSeriesStyle("Testing", sknlinechart.SeriesStyle{})).To(Succeed())
+		Expect(visibleCircles()).To(Equal(6))
+		Expect(seriesLines(lc, blue)).To(HaveLen(6))
+	})
+	It("should size the markers and their hover area", func() {
+		Expect(lc.SetSeriesStyle("Testing", sknlinechart.SeriesStyle{Marker: sknlinechart.MarkerSquare, MarkerSize: 10})).To(Succeed())
+		top, bottom := (*points[2]).MarkerPosition()
+		Expect(bottom.X - top.X).To(BeNumerically("==", 10))
+
+		var widest float32
+		for _, line := range seriesLines(lc, blue) {
+			widest = fyne.Max(widest, line.StrokeWidth)
+		}
+		Expect(widest).To(BeNumerically("==", 10))
+	})
+	It("should reject unknown shapes and negative sizes", func() {
+		Expect(lc.SetSeriesStyle("Testing", sknlinechart.SeriesStyle{Marker: sknlinechart.MarkerShape(42)})).To(HaveOccurred())
+		Expect(lc.SetSeriesStyle("Testing", sknlinechart.SeriesStyle{MarkerSize: -1})).To(HaveOccurred())
+		Expect(sknlinechart.MarkerDiamond.String()).To(Equal("diamond"))
+	})
+})
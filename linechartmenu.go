@@ -0,0 +1,124 @@
+package sknlinechart
+
+import (
+	"log/slog"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+)
+
+// SetContextMenuEnabled binds the secondary mouse button to the chart's popup menu,
+// disabling restores the markers toggle
+func (w *LineChartSkn) SetContextMenuEnabled(enable bool) {
+	action := ChartActionToggleDataPointMarkers
+	if enable {
+		action = ChartActionContextMenu
+	}
+	w.SetMouseAction(desktop.MouseButtonSecondary, action)
+}
+
+// IsContextMenuEnabled returns true when any mouse button opens the popup menu
+func (w *LineChartSkn) IsContextMenuEnabled() bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	for _, action := range w.mouseActions {
+		if action == ChartActionContextMenu {
+			return true
+		}
+	}
+	return false
+}
+
+// AddContextMenuItem appends an application item below the chart's own menu items
+func (w *LineChartSkn) AddContextMenuItem(item *fyne.MenuItem) {
+	if item == nil {
+		return
+	}
+	w.mapsLock.Lock()
+	w.contextMenuItems = append(w.contextMenuItems, item)
+	w.mapsLock.Unlock()
+}
+
+// contextMenu builds the popup menu reflecting the chart's current state
+func (w *LineChartSkn) contextMenu() *fyne.Menu {
+	markers := fyne.NewMenuItem(w.Message(MessageMenuMarkers), w.toggleDataPointMarkers)
+	markers.Checked = w.enableDataPointMarkers
+
+	grid := fyne.NewMenuItem(w.Message(MessageMenuGrid), func() {
+		enable := !(w.enableHorizGridLines && w.enableVertGridLines)
+		w.SetHorizGridLines(enable)
+		w.SetVertGridLines(enable)
+		w.Refresh()
+	})
+	grid.Checked = w.enableHorizGridLines && w.enableVertGridLines
+
+	export := fyne.NewMenuItem(w.Message(MessageMenuExportPNG), w.showExportPNGDialog)
+
+	reset := fyne.NewMenuItem(w.Message(MessageMenuResetZoom), w.ResetZoom)
+	reset.Disabled = !w.IsZoomed()
+
+	hovered, ok := w.hoveredDatapointText() // the popup closes as the menu opens
+	copyPoint := fyne.NewMenuItem(w.Message(MessageMenuCopyPoint), func() {
+		w.copyToClipboard(hovered)
+	})
+	copyPoint.Disabled = !ok
+
+	items := []*fyne.MenuItem{markers, grid, export, reset, copyPoint}
+	w.mapsLock.RLock()
+	if len(w.contextMenuItems) > 0 {
+		items = append(items, fyne.NewMenuItemSeparator())
+		items = append(items, w.contextMenuItems...)
+	}
+	w.mapsLock.RUnlock()
+
+	return fyne.NewMenu("", items...)
+}
+
+// showContextMenu pops up the menu at the widget relative position
+func (w *LineChartSkn) showContextMenu(position fyne.Position) {
+	w.debugLog("LineChartSkn::showContextMenu()")
+	c := fyne.CurrentApp().Driver().CanvasForObject(w)
+	if c == nil {
+		return
+	}
+	abs := fyne.CurrentApp().Driver().AbsolutePositionForObject(w).Add(position)
+	widget.ShowPopUpMenuAtPosition(w.contextMenu(), c, abs)
+}
+
+// showExportPNGDialog asks where to save a png image of the chart
+func (w *LineChartSkn) showExportPNGDialog() {
+	win := w.parentWindow()
+	if win == nil {
+		slog.Warn("export png unavailable, chart is not in a window")
+		return
+	}
+	dialog.ShowFileSave(func(out fyne.URIWriteCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, win)
+			return
+		}
+		if out == nil { // cancelled
+			return
+		}
+		defer out.Close()
+		if err = w.writePNG(out); err != nil {
+			dialog.ShowError(err, win)
+		}
+	}, win)
+}
+
+// parentWindow returns the window displaying the chart, nil when not shown
+func (w *LineChartSkn) parentWindow() fyne.Window {
+	c := fyne.CurrentApp().Driver().CanvasForObject(w)
+	if c == nil {
+		return nil
+	}
+	for _, win := range fyne.CurrentApp().Driver().AllWindows() {
+		if win.Canvas() == c {
+			return win
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Chart context menu", func() {
+	var (
+		lc  *sknlinechart.LineChartSkn
+		win fyne.Window
+	)
+
+	BeforeEach(func() {
+		chart, _ := makeUI("Testing", "Menu", 10)
+		lc = chart.(*sknlinechart.LineChartSkn)
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	menuLabels := func() []string {
+		var labels []string
+		top := win.Canvas().Overlays().Top()
+		if top == nil {
+			return labels
+		}
+		for _, o := range test.LaidOutObjects(top) {
+			if t, ok := o.(*canvas.Text); ok && t.Text != "" {
+				labels = append(labels, t.Text)
+			}
+		}
+		return labels
+	}
+
+	It("should be off by default", func() {
+		Expect(lc.IsContextMenuEnabled()).To(BeFalse())
+		lc.TappedSecondary(&fyne.PointEvent{Position: fyne.NewPos(100, 100)})
+		Expect(menuLabels()).To(BeEmpty())
+	})
+	It("should pop up the chart options and application items", func() {
+		lc.SetContextMenuEnabled(true)
+		Expect(lc.IsContextMenuEnabled()).To(BeTrue())
+		Expect(lc.GetMouseAction(desktop.MouseButtonSecondary)).To(Equal(sknlinechart.ChartActionContextMenu))
+		lc.AddContextMenuItem(fyne.NewMenuItem("Pause feed", func() {}))
+
+		lc.TappedSecondary(&fyne.PointEvent{Position: fyne.NewPos(100, 100)})
+		Expect(menuLabels()).To(ContainElements("Show markers", "Show grid", "Export PNG", "Reset zoom", "Pause feed"))
+		Expect(lc.IsDataPointMarkersEnabled()).To(BeTrue())
+
+		lc.SetContextMenuEnabled(false)
+		Expect(lc.GetMouseAction(desktop.MouseButtonSecondary)).To(Equal(sknlinechart.ChartActionToggleDataPointMarkers))
+	})
+})
@@ -0,0 +1,141 @@
+package sknlinechart
+
+// Message keys naming the chart's built-in text, looked up through the translator set with SetTranslator
+const (
+	MessageIndex = "index"
+	MessageX     = "x"
+	MessageTime  = "time"
+	MessageValue = "value"
+
+	MessageMenuMarkers   = "menu.markers"
+	MessageMenuGrid      = "menu.grid"
+	MessageMenuExportPNG = "menu.exportPNG"
+	MessageMenuResetZoom = "menu.resetZoom"
+	MessageMenuCopyPoint = "menu.copyPoint"
+
+	MessageAnnotation = "annotation"
+	MessageSave       = "save"
+	MessageCancel     = "cancel"
+	MessageClose      = "close"
+
+	MessageStatsMin  = "stats.min"
+	MessageStatsMax  = "stats.max"
+	MessageStatsAvg  = "stats.avg"
+	MessageStatsLast = "stats.last"
+
+	MessageSettings              = "settings"
+	MessageSettingsLabels        = "settings.labels"
+	MessageSettingsRanges        = "settings.ranges"
+	MessageSettingsDisplay       = "settings.display"
+	MessageSettingsSeries        = "settings.series"
+	MessageSettingsSeriesHint    = "settings.seriesHint"
+	MessageSettingsPointColors   = "settings.pointColors"
+	MessageSettingsTitle         = "settings.title"
+	MessageSettingsFooter        = "settings.footer"
+	MessageSettingsTopLeft       = "settings.topLeft"
+	MessageSettingsTopRight      = "settings.topRight"
+	MessageSettingsBottomLeft    = "settings.bottomLeft"
+	MessageSettingsBottomRight   = "settings.bottomRight"
+	MessageSettingsLeftScale     = "settings.leftScale"
+	MessageSettingsRightScale    = "settings.rightScale"
+	MessageSettingsValueMin      = "settings.valueMin"
+	MessageSettingsValueMax      = "settings.valueMax"
+	MessageSettingsTimeWindow    = "settings.timeWindow"
+	MessageSettingsLineStroke    = "settings.lineStroke"
+	MessageSettingsMarkers       = "settings.markers"
+	MessageSettingsHorizGrid     = "settings.horizGrid"
+	MessageSettingsVertGrid      = "settings.vertGrid"
+	MessageSettingsLegend        = "settings.legend"
+	MessageSettingsHoverPopup    = "settings.hoverPopup"
+	MessageSettingsCrosshair     = "settings.crosshair"
+	MessageSettingsDownsampling  = "settings.downsampling"
+	MessageSettingsPixelSnapping = "settings.pixelSnapping"
+)
+
+// defaultMessages the English text of each message key
+var defaultMessages = map[string]string{
+	MessageIndex: "Index",
+	MessageX:     "X",
+	MessageTime:  "Time",
+	MessageValue: "Value",
+
+	MessageMenuMarkers:   "Show markers",
+	MessageMenuGrid:      "Show grid",
+	MessageMenuExportPNG: "Export PNG",
+	MessageMenuResetZoom: "Reset zoom",
+	MessageMenuCopyPoint: "Copy datapoint",
+
+	MessageAnnotation: "Annotation",
+	MessageSave:       "Save",
+	MessageCancel:     "Cancel",
+	MessageClose:      "Close",
+
+	MessageStatsMin:  "min",
+	MessageStatsMax:  "max",
+	MessageStatsAvg:  "avg",
+	MessageStatsLast: "last",
+
+	MessageSettings:              "Chart settings",
+	MessageSettingsLabels:        "Labels",
+	MessageSettingsRanges:        "Range and limits",
+	MessageSettingsDisplay:       "Display",
+	MessageSettingsSeries:        "Series",
+	MessageSettingsSeriesHint:    "color and point limit",
+	MessageSettingsPointColors:   "Point colors",
+	MessageSettingsTitle:         "Title",
+	MessageSettingsFooter:        "Footer",
+	MessageSettingsTopLeft:       "Top left",
+	MessageSettingsTopRight:      "Top right",
+	MessageSettingsBottomLeft:    "Bottom left",
+	MessageSettingsBottomRight:   "Bottom right",
+	MessageSettingsLeftScale:     "Left scale",
+	MessageSettingsRightScale:    "Right scale",
+	MessageSettingsValueMin:      "Value min",
+	MessageSettingsValueMax:      "Value max",
+	MessageSettingsTimeWindow:    "Time window",
+	MessageSettingsLineStroke:    "Line stroke",
+	MessageSettingsMarkers:       "Markers",
+	MessageSettingsHorizGrid:     "Horizontal grid",
+	MessageSettingsVertGrid:      "Vertical grid",
+	MessageSettingsLegend:        "Legend",
+	MessageSettingsHoverPopup:    "Hover popup",
+	MessageSettingsCrosshair:     "Crosshair",
+	MessageSettingsDownsampling:  "Downsampling",
+	MessageSettingsPixelSnapping: "Pixel snapping",
+}
+
+// DefaultMessages returns the English catalog of the chart's built-in text by message key,
+// a starting point for translations
+func DefaultMessages() map[string]string {
+	catalog := make(map[string]string, len(defaultMessages))
+	for key, text := range defaultMessages {
+		catalog[key] = text
+	}
+	return catalog
+}
+
+// SetTranslator localizes the chart's built-in text: readouts, popups, menus, and dialogs. The
+// translator is given a message key, see DefaultMessages, and returns its text; an empty result
+// keeps the English text. Series names and the app's own labels are shown as given. nil restores English
+func (w *LineChartSkn) SetTranslator(translate func(key string) string) {
+	w.debugLog("LineChartSkn::SetTranslator()")
+	if translate == nil {
+		w.translator.Store(nil)
+	} else {
+		w.translator.Store(&translate)
+	}
+	w.mapsLock.Lock()
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// Message returns the chart's text for the message key, translated when a translator is set
+func (w *LineChartSkn) Message(key string) string {
+	if translate := w.translator.Load(); translate != nil {
+		if text := (*translate)(key); text != "" {
+			return text
+		}
+	}
+	return defaultMessages[key]
+}
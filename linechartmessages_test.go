@@ -0,0 +1,61 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Localized built-in text", func() {
+	var lc *sknlinechart.LineChartSkn
+
+	german := map[string]string{
+		sknlinechart.MessageIndex: "Index",
+		sknlinechart.MessageValue: "Wert",
+		sknlinechart.MessageSave:  "Speichern",
+	}
+
+	BeforeEach(func() {
+		var points []*sknlinechart.ChartDatapoint
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(10+i), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		chart, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"CPU": points})))
+		lc = chart.(*sknlinechart.LineChartSkn)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should offer the English catalog as a starting point", func() {
+		catalog := sknlinechart.DefaultMessages()
+		Expect(catalog).To(HaveKeyWithValue(sknlinechart.MessageValue, "Value"))
+		Expect(catalog).To(HaveKeyWithValue(sknlinechart.MessageMenuResetZoom, "Reset zoom"))
+		catalog[sknlinechart.MessageValue] = "changed"
+		Expect(lc.Message(sknlinechart.MessageValue)).To(Equal("Value"))
+	})
+	It("should show readouts in the translated text", func() {
+		lc.SetTranslator(func(key string) string { return german[key] })
+		lc.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft})
+		Expect(visibleText(lc, "CPU Wert: 19")).NotTo(BeNil())
+		Expect(visibleText(lc, "CPU Value: 19")).To(BeNil())
+	})
+	It("should keep English for keys the translator leaves empty, and restore it on nil", func() {
+		lc.SetTranslator(func(key string) string { return german[key] })
+		Expect(lc.Message(sknlinechart.MessageSave)).To(Equal("Speichern"))
+		Expect(lc.Message(sknlinechart.MessageCancel)).To(Equal("Cancel"))
+
+		lc.SetTranslator(nil)
+		Expect(lc.Message(sknlinechart.MessageSave)).To(Equal("Save"))
+	})
+	It("should be set from the chart options", func() {
+		chart, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithTranslator(func(key string) string { return german[key] })))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chart.Message(sknlinechart.MessageValue)).To(Equal("Wert"))
+	})
+})
@@ -0,0 +1,141 @@
+package sknlinechart
+
+import (
+	"fmt"
+)
+
+// Well known SeriesMetadata keys
+const (
+	MetadataUnits       = "units"       // shown after values in tooltips, like "°C" or "ms"
+	MetadataDescription = "description" // what the series measures
+	MetadataSource      = "source"      // where the series' data comes from
+)
+
+// SeriesMetadata optional descriptive values for a series, keyed by MetadataUnits and friends or any app key
+type SeriesMetadata map[string]string
+
+// Copy returns an independent copy of the metadata
+func (m SeriesMetadata) Copy() SeriesMetadata {
+	if m == nil {
+		return nil
+	}
+	c := make(SeriesMetadata, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// SetSeriesMetadata attaches descriptive values to the series, nil removes them
+func (w *LineChartSkn) SetSeriesMetadata(seriesName string, metadata SeriesMetadata) {
+	w.debugLog("LineChartSkn::SetSeriesMetadata()")
+	w.mapsLock.Lock()
+	if len(metadata) == 0 {
+		delete(w.seriesMetadata, seriesName)
+	} else {
+		if w.seriesMetadata == nil {
+			w.seriesMetadata = map[string]SeriesMetadata{}
+		}
+		w.seriesMetadata[seriesName] = metadata.Copy()
+	}
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// GetSeriesMetadata returns a copy of the series' descriptive values, nil when it has none
+func (w *LineChartSkn) GetSeriesMetadata(seriesName string) SeriesMetadata {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.seriesMetadata[seriesName].Copy()
+}
+
+// RenameSeries moves a series' data, settings, annotations, and metadata to a new name.
+// Windows opened by DetachSeries stop following a renamed series
+func (w *LineChartSkn) RenameSeries(oldName, newName string) error {
+	w.debugLog("LineChartSkn::RenameSeries() ENTER")
+	w.mapsLock.Lock()
+	var err error
+	if _, ok := w.dataPoints[oldName]; !ok {
+		err = fmt.Errorf("RenameSeries() series not found: %s", oldName)
+	} else if _, ok := w.dataPoints[newName]; ok || newName == "" {
+		err = fmt.Errorf("RenameSeries() series name unavailable: %q", newName)
+	}
+	if err != nil {
+		w.mapsLock.Unlock()
+		w.debugLog("LineChartSkn::RenameSeries() ERROR EXIT")
+		return err
+	}
+
+	w.dataPoints[newName] = w.dataPoints[oldName]
+	delete(w.dataPoints, oldName)
+	renameKey(w.hiddenSeries, oldName, newName)
+	renameKey(w.forecasts, oldName, newName)
+	renameKey(w.lastUpdated, oldName, newName)
+	renameKey(w.colorRules, oldName, newName)
+	renameKey(w.seriesGradients, oldName, newName)
+	renameKey(w.seriesColors, oldName, newName)
+	renameKey(w.seriesStyles, oldName, newName)
+	renameKey(w.seriesFills, oldName, newName)
+	renameKey(w.popupBuilders, oldName, newName)
+	renameKey(w.ratios, oldName, newName)
+	for key, ratio := range w.ratios {
+		if ratio.numerator == oldName {
+			ratio.numerator = newName
+		}
+		if ratio.denominator == oldName {
+			ratio.denominator = newName
+		}
+		w.ratios[key] = ratio
+	}
+	renameKey(w.derived, oldName, newName)
+	for key, derived := range w.derived {
+		if derived.source == oldName {
+			derived.source = newName
+			w.derived[key] = derived
+		}
+	}
+	renameKey(w.seriesMetadata, oldName, newName)
+	renameKey(w.pointLimits, oldName, newName)
+	renameKey(w.pointRings, oldName, newName)
+	renameKey(w.refining, oldName, newName)
+	renameKey(w.gaps, oldName, newName)
+	delete(w.detachedCharts, oldName)
+	for idx := range w.annotations {
+		if w.annotations[idx].Series == oldName {
+			w.annotations[idx].Series = newName
+		}
+	}
+	for idx := range w.pinnedTooltips {
+		if w.pinnedTooltips[idx].series == oldName {
+			w.pinnedTooltips[idx].series = newName
+		}
+	}
+	if w.highlightedSeries == oldName {
+		w.highlightedSeries = newName
+	}
+	if w.cursorSeries == oldName {
+		w.cursorSeries = newName
+	}
+	w.dataSeriesAdded = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::RenameSeries() EXIT")
+	return nil
+}
+
+// renameKey moves the value stored under oldKey to newKey, if any
+func renameKey[V any](m map[string]V, oldKey, newKey string) {
+	if v, ok := m[oldKey]; ok {
+		m[newKey] = v
+		delete(m, oldKey)
+	}
+}
+
+// valueText formats a datapoint value followed by the series' units
+// caller must hold the mapsLock
+func (w *LineChartSkn) valueText(seriesName string, value float32) string {
+	if units := w.seriesMetadata[seriesName][MetadataUnits]; units != "" {
+		return fmt.Sprint(value, " ", units)
+	}
+	return fmt.Sprint(value)
+}
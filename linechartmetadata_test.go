@@ -0,0 +1,81 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Series names and metadata", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		var points []*sknlinechart.ChartDatapoint
+		for i := 0; i < 20; i++ {
+			point := sknlinechart.NewChartDatapoint(50, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should keep independent copies of metadata", func() {
+		Expect(lc.GetSeriesMetadata("Testing")).To(BeNil())
+		metadata := sknlinechart.SeriesMetadata{sknlinechart.MetadataUnits: "°C", sknlinechart.MetadataSource: "sensor-1"}
+		lc.SetSeriesMetadata("Testing", metadata)
+		metadata[sknlinechart.MetadataUnits] = "°F"
+
+		got := lc.GetSeriesMetadata("Testing")
+		Expect(got[sknlinechart.MetadataUnits]).To(Equal("°C"))
+		got[sknlinechart.MetadataSource] = "changed"
+		Expect(lc.GetSeriesMetadata("Testing")[sknlinechart.MetadataSource]).To(Equal("sensor-1"))
+
+		lc.SetSeriesMetadata("Testing", nil)
+		Expect(lc.GetSeriesMetadata("Testing")).To(BeNil())
+	})
+	It("should show units in tooltips and keep metadata in saved state", func() {
+		lc.SetSeriesMetadata("Testing", sknlinechart.SeriesMetadata{sknlinechart.MetadataUnits: "°C"})
+		Expect(lc.PinTooltip("Testing", 3)).To(Succeed())
+		Expect(visibleText(lc, "Testing, Index: 3, Value: 50 °C")).NotTo(BeNil())
+
+		var state bytes.Buffer
+		Expect(lc.SaveState(&state)).To(Succeed())
+		restored, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		Expect(restored.LoadState(&state)).To(Succeed())
+		Expect(restored.GetSeriesMetadata("Testing")).To(Equal(sknlinechart.SeriesMetadata{sknlinechart.MetadataUnits: "°C"}))
+	})
+	It("should rename a series with everything attached to it", func() {
+		lc.SetSeriesMetadata("Testing", sknlinechart.SeriesMetadata{sknlinechart.MetadataDescription: "Outside temperature"})
+		Expect(lc.AddAnnotation("Testing", 4, "door opened")).To(Succeed())
+		Expect(lc.HideSeries("Testing")).To(Succeed())
+
+		Expect(lc.RenameSeries("Unknown", "Other")).To(HaveOccurred())
+		Expect(lc.RenameSeries("Testing", "")).To(HaveOccurred())
+		Expect(lc.RenameSeries("Testing", "Outside")).To(Succeed())
+
+		series := lc.State().Series
+		Expect(series).NotTo(HaveKey("Testing"))
+		Expect(series["Outside"]).To(HaveLen(20))
+		Expect(lc.GetAnnotations()).To(Equal([]sknlinechart.Annotation{{Series: "Outside", Index: 4, Text: "door opened"}}))
+		Expect(lc.GetSeriesMetadata("Outside")[sknlinechart.MetadataDescription]).To(Equal("Outside temperature"))
+		Expect(lc.IsSeriesVisible("Outside")).To(BeFalse())
+
+		Expect(lc.ShowSeries("Outside")).To(Succeed())
+		_, listed := legendEntry(lc, "Outside")
+		Expect(listed).To(BeTrue())
+		_, listed = legendEntry(lc, "Testing")
+		Expect(listed).To(BeFalse())
+	})
+	It("should refuse to rename onto an existing series", func() {
+		point := sknlinechart.NewChartDatapoint(10, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Other", &point)
+		Expect(lc.RenameSeries("Testing", "Other")).To(HaveOccurred())
+		Expect(lc.State().Series["Other"]).To(HaveLen(1))
+	})
+})
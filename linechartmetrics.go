@@ -0,0 +1,191 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Metric names, following the Prometheus naming conventions
+const (
+	MetricPointsIngested  = "sknlinechart_points_ingested_total"
+	MetricPointsDropped   = "sknlinechart_points_dropped_total"
+	MetricRefreshes       = "sknlinechart_refreshes_total"
+	MetricRefreshRate     = "sknlinechart_refresh_rate"
+	MetricRefreshDuration = "sknlinechart_refresh_duration_seconds"
+	MetricLayoutDuration  = "sknlinechart_layout_duration_seconds"
+)
+
+// refreshRateWindow period over which the refresh rate gauge is averaged
+const refreshRateWindow = 10 * time.Second
+
+// MetricsRegistry receives the chart's instrumentation as it happens. Adapt it to a
+// prometheus.Registerer, or any other metrics system, in the application
+type MetricsRegistry interface {
+	AddCounter(name string, delta float64)
+	SetGauge(name string, value float64)
+	ObserveSummary(name string, value float64)
+}
+
+// ChartMetrics snapshot of the chart's ingestion and rendering counters
+type ChartMetrics struct {
+	PointsIngested  uint64 // added by ApplyDataPoint, ApplyDataPoints and ApplyDataSeries
+	PointsDropped   uint64 // rolled off by the point limit, rejected with an over-limit series, or refused by the ingest policies
+	Refreshes       uint64
+	RefreshRate     float64 // refreshes per second over the last full window
+	RefreshDuration time.Duration
+	Layouts         uint64
+	LayoutDuration  time.Duration
+}
+
+// chartMetrics counters updated from the data and render paths
+type chartMetrics struct {
+	pointsIngested  atomic.Uint64
+	pointsDropped   atomic.Uint64
+	refreshes       atomic.Uint64
+	refreshNanos    atomic.Int64
+	layouts         atomic.Uint64
+	layoutNanos     atomic.Int64
+	lock            sync.Mutex
+	registry        MetricsRegistry
+	refreshRate     float64
+	windowStart     time.Time
+	windowRefreshes uint64
+}
+
+// SetMetricsRegistry forwards the chart's metrics to registry as they change, nil stops forwarding
+func (w *LineChartSkn) SetMetricsRegistry(registry MetricsRegistry) {
+	w.debugLog("LineChartSkn::SetMetricsRegistry()")
+	w.metrics.lock.Lock()
+	w.metrics.registry = registry
+	w.metrics.lock.Unlock()
+}
+
+// Metrics returns a snapshot of the chart's ingestion and rendering counters
+func (w *LineChartSkn) Metrics() ChartMetrics {
+	w.metrics.lock.Lock()
+	rate := w.metrics.refreshRate
+	w.metrics.lock.Unlock()
+	return ChartMetrics{
+		PointsIngested:  w.metrics.pointsIngested.Load(),
+		PointsDropped:   w.metrics.pointsDropped.Load(),
+		Refreshes:       w.metrics.refreshes.Load(),
+		RefreshRate:     rate,
+		RefreshDuration: time.Duration(w.metrics.refreshNanos.Load()),
+		Layouts:         w.metrics.layouts.Load(),
+		LayoutDuration:  time.Duration(w.metrics.layoutNanos.Load()),
+	}
+}
+
+// WritePrometheusMetrics writes the metrics in the Prometheus text exposition format,
+// suitable for serving from an http /metrics handler
+func (w *LineChartSkn) WritePrometheusMetrics(out io.Writer) error {
+	m := w.Metrics()
+	families := []struct {
+		name, kind, help string
+		samples          [][2]string
+	}{
+		{MetricPointsIngested, "counter", "Datapoints added to the chart.",
+			[][2]string{{"", strconv.FormatUint(m.PointsIngested, 10)}}},
+		{MetricPointsDropped, "counter", "Datapoints rolled off by the point limit or rejected.",
+			[][2]string{{"", strconv.FormatUint(m.PointsDropped, 10)}}},
+		{MetricRefreshes, "counter", "Chart redraws.",
+			[][2]string{{"", strconv.FormatUint(m.Refreshes, 10)}}},
+		{MetricRefreshRate, "gauge", "Chart redraws per second.",
+			[][2]string{{"", formatMetric(m.RefreshRate)}}},
+		{MetricRefreshDuration, "summary", "Time spent redrawing the chart.",
+			[][2]string{{"_sum", formatMetric(m.RefreshDuration.Seconds())}, {"_count", strconv.FormatUint(m.Refreshes, 10)}}},
+		{MetricLayoutDuration, "summary", "Time spent laying out the chart.",
+			[][2]string{{"_sum", formatMetric(m.LayoutDuration.Seconds())}, {"_count", strconv.FormatUint(m.Layouts, 10)}}},
+	}
+	for _, f := range families {
+		_, err := fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.kind)
+		if err != nil {
+			return err
+		}
+		for _, s := range f.samples {
+			_, err = fmt.Fprintf(out, "%s%s %s\n", f.name, s[0], s[1])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// formatMetric formats a sample value as Prometheus expects
+func formatMetric(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// currentRegistry returns the registry to forward to, nil when none is set
+func (m *chartMetrics) currentRegistry() MetricsRegistry {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.registry
+}
+
+// ingested counts datapoints accepted by the chart
+func (m *chartMetrics) ingested(count int) {
+	if count <= 0 {
+		return
+	}
+	m.pointsIngested.Add(uint64(count))
+	if r := m.currentRegistry(); r != nil {
+		r.AddCounter(MetricPointsIngested, float64(count))
+	}
+}
+
+// dropped counts datapoints rolled off or rejected
+func (m *chartMetrics) dropped(count int) {
+	if count <= 0 {
+		return
+	}
+	m.pointsDropped.Add(uint64(count))
+	if r := m.currentRegistry(); r != nil {
+		r.AddCounter(MetricPointsDropped, float64(count))
+	}
+}
+
+// refreshed records one renderer refresh and updates the windowed refresh rate
+func (m *chartMetrics) refreshed(elapsed time.Duration) {
+	m.refreshes.Add(1)
+	m.refreshNanos.Add(int64(elapsed))
+
+	now := time.Now()
+	m.lock.Lock()
+	registry := m.registry
+	if m.windowStart.IsZero() {
+		m.windowStart = now
+	}
+	m.windowRefreshes++
+	rateChanged := false
+	if window := now.Sub(m.windowStart); window >= refreshRateWindow {
+		m.refreshRate = float64(m.windowRefreshes) / window.Seconds()
+		m.windowStart = now
+		m.windowRefreshes = 0
+		rateChanged = true
+	}
+	rate := m.refreshRate
+	m.lock.Unlock()
+
+	if registry != nil {
+		registry.AddCounter(MetricRefreshes, 1)
+		registry.ObserveSummary(MetricRefreshDuration, elapsed.Seconds())
+		if rateChanged {
+			registry.SetGauge(MetricRefreshRate, rate)
+		}
+	}
+}
+
+// laidOut records one renderer layout
+func (m *chartMetrics) laidOut(elapsed time.Duration) {
+	m.layouts.Add(1)
+	m.layoutNanos.Add(int64(elapsed))
+	if r := m.currentRegistry(); r != nil {
+		r.ObserveSummary(MetricLayoutDuration, elapsed.Seconds())
+	}
+}
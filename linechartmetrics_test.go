@@ -0,0 +1,88 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+// recordingRegistry collects forwarded metrics by name
+type recordingRegistry struct {
+	lock     sync.Mutex
+	counters map[string]float64
+	observed map[string]int
+}
+
+func (r *recordingRegistry) AddCounter(name string, delta float64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.counters[name] += delta
+}
+
+func (r *recordingRegistry) SetGauge(string, float64) {}
+
+func (r *recordingRegistry) ObserveSummary(name string, _ float64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.observed[name]++
+}
+
+var _ = Describe("Chart metrics", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "Metrics", 10)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+	})
+
+	It("should count ingested and rolled off datapoints", func() {
+		for i := 0; i < 200; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(i%100), theme.ColorRed, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("Stream", &point)
+		}
+		m := lc.Metrics()
+		Expect(m.PointsIngested).To(BeNumerically("==", 200))
+		Expect(m.PointsDropped).To(BeNumerically(">", 0))
+		Expect(m.Refreshes).To(BeNumerically(">", 0))
+	})
+	It("should count a rejected series as dropped", func() {
+		many := make([]*sknlinechart.ChartDatapoint, 151)
+		err := lc.ApplyDataSeries("TooMany", many)
+		Expect(err).To(HaveOccurred())
+		Expect(lc.Metrics().PointsDropped).To(BeNumerically("==", 151))
+		Expect(lc.Metrics().PointsIngested).To(BeNumerically("==", 0))
+	})
+	It("should write the Prometheus text format", func() {
+		point := sknlinechart.NewChartDatapoint(42, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Stream", &point)
+
+		var out bytes.Buffer
+		Expect(lc.WritePrometheusMetrics(&out)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("# TYPE sknlinechart_points_ingested_total counter\n"))
+		Expect(out.String()).To(ContainSubstring("\nsknlinechart_points_ingested_total 1\n"))
+		Expect(out.String()).To(ContainSubstring("\nsknlinechart_layout_duration_seconds_count "))
+	})
+	It("should forward metrics to the registry hook", func() {
+		registry := &recordingRegistry{counters: map[string]float64{}, observed: map[string]int{}}
+		lc.SetMetricsRegistry(registry)
+
+		point := sknlinechart.NewChartDatapoint(42, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Stream", &point)
+		lc.Resize(fyne.NewSize(640, 320))
+
+		registry.lock.Lock()
+		defer registry.lock.Unlock()
+		Expect(registry.counters[sknlinechart.MetricPointsIngested]).To(BeNumerically("==", 1))
+		Expect(registry.counters[sknlinechart.MetricRefreshes]).To(BeNumerically(">", 0))
+		Expect(registry.observed[sknlinechart.MetricLayoutDuration]).To(BeNumerically(">", 0))
+	})
+})
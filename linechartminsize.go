@@ -0,0 +1,69 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// text sizes and styles of the border labels, as drawn by the renderer
+const (
+	titleTextSize    float32 = 24
+	footerTextSize   float32 = 16
+	sideTextSize     float32 = 14
+	plotMinDimension float32 = 64 // smallest plot area worth drawing between the labels
+)
+
+// MinSize returns the smallest size the chart is laid out at: the size given to SetMinSize or
+// WithMinSize, grown when the visible border labels need more room. Containers asking the widget
+// and fyne asking its renderer get the same answer
+func (w *LineChartSkn) MinSize() fyne.Size {
+	w.debugLog("LineChartSkn::MinSize()")
+	return w.minSize.Max(w.contentMinSize())
+}
+
+// GetMinSize returns the minimum size set by SetMinSize or WithMinSize, before growing to fit the labels
+func (w *LineChartSkn) GetMinSize() fyne.Size {
+	return w.minSize
+}
+
+// contentMinSize returns the size needed to show the visible border labels around a usable plot area
+func (w *LineChartSkn) contentMinSize() fyne.Size {
+	if fyne.CurrentApp() == nil { // text cannot be measured without a driver
+		return fyne.Size{}
+	}
+	pad := theme.Padding()
+	corner := theme.TextSize()
+	tl := labelSize(w.topLeftLabel, corner, fyne.TextStyle{})
+	tc := labelSize(w.topCenteredLabel, titleTextSize, fyne.TextStyle{Bold: true})
+	tr := labelSize(w.topRightLabel, corner, fyne.TextStyle{})
+	bl := labelSize(w.bottomLeftLabel, corner, fyne.TextStyle{})
+	bc := labelSize(w.bottomCenteredLabel, footerTextSize, fyne.TextStyle{Italic: true})
+	br := labelSize(w.bottomRightLabel, corner, fyne.TextStyle{})
+	left := sideLabelSize(w.leftMiddleLabel)
+	right := sideLabelSize(w.rightMiddleLabel)
+
+	rows := fyne.NewSize(fyne.Max(tl.Width+tc.Width+tr.Width, bl.Width+bc.Width+br.Width)+pad*4, 0)
+	rows.Height = fyne.Max(tl.Height, fyne.Max(tc.Height, tr.Height)) +
+		fyne.Max(bl.Height, fyne.Max(bc.Height, br.Height))
+	width := fyne.Max(rows.Width, left.Width+right.Width+plotMinDimension) + pad*4
+	height := rows.Height + fyne.Max(plotMinDimension, fyne.Max(left.Height, right.Height)) + pad*4
+	return fyne.NewSize(width, height)
+}
+
+// labelSize measures a border label, empty labels are hidden and take no room
+func labelSize(text string, size float32, style fyne.TextStyle) fyne.Size {
+	if text == "" {
+		return fyne.Size{}
+	}
+	return fyne.MeasureText(text, size, style)
+}
+
+// sideLabelSize measures a middle label, drawn one character per line down the side of the plot
+func sideLabelSize(text string) fyne.Size {
+	count := len([]rune(text))
+	if count == 0 {
+		return fyne.Size{}
+	}
+	char := fyne.MeasureText("W", sideTextSize, fyne.TextStyle{Monospace: true})
+	return fyne.NewSize(char.Width, char.Height*float32(count))
+}
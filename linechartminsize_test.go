@@ -0,0 +1,48 @@
+package sknlinechart_test
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Minimum size", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		test.NewApp()
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+	})
+
+	It("should report the same size from the widget and its renderer", func() {
+		renderer := test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn))
+		Expect(lc.MinSize()).To(Equal(renderer.MinSize()))
+
+		lc.SetMinSize(fyne.NewSize(900, 500))
+		Expect(lc.GetMinSize()).To(Equal(fyne.NewSize(900, 500)))
+		Expect(lc.MinSize()).To(Equal(fyne.NewSize(900, 500)))
+		Expect(renderer.MinSize()).To(Equal(lc.MinSize()))
+	})
+	It("should grow past the set size to fit the visible labels", func() {
+		lc.SetMinSize(fyne.NewSize(100, 100))
+		Expect(lc.MinSize()).To(Equal(fyne.NewSize(100, 100)))
+
+		lc.SetTitle(strings.Repeat("Wide Title ", 10))
+		lc.SetMiddleLeftLabel(strings.Repeat("Y", 20))
+		size := lc.MinSize()
+		Expect(size.Width).To(BeNumerically(">", 600))
+		Expect(size.Height).To(BeNumerically(">", 200))
+		Expect(lc.GetMinSize()).To(Equal(fyne.NewSize(100, 100)))
+	})
+	It("should hold its minimum inside a container", func() {
+		lc.SetMinSize(fyne.NewSize(640, 360))
+		win := test.NewWindow(lc)
+		defer win.Close()
+		Expect(win.Content().MinSize()).To(Equal(lc.MinSize()))
+		Expect(lc.Size().Width).To(BeNumerically(">=", 640))
+	})
+})
@@ -0,0 +1,134 @@
+package sknlinechart
+
+import (
+	"image/color"
+	"sort"
+
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+var (
+	monochromeInk   = color.NRGBA{A: 0xff}                            // series, labels, and text
+	monochromePaper = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff} // the background
+	monochromeGrid  = color.NRGBA{R: 0xb0, G: 0xb0, B: 0xb0, A: 0xff} // grid lines, light enough to stay behind the series
+
+	// monochromeDashes and monochromeMarkers are dealt to the series in name order; their counts share
+	// no factor, so the first thirty series each get a different pairing
+	monochromeDashes  = [][]float32{nil, {8, 4}, {2, 3}, {8, 3, 2, 3}, {14, 4}, {4, 4}}
+	monochromeMarkers = []MarkerShape{MarkerCircle, MarkerSquare, MarkerTriangle, MarkerDiamond, MarkerCross}
+)
+
+// SetMonochromeMode draws the chart for print: black series told apart by dash pattern and marker
+// shape, on a white background with black labels and gray grid lines and overlays, whatever the
+// on-screen theme. Snapshot, png exports, and ExportSVG capture it as drawn; disabling restores
+// the theme and series colors and styles
+func (w *LineChartSkn) SetMonochromeMode(enable bool) {
+	w.debugLog("LineChartSkn::SetMonochromeMode()")
+	w.mapsLock.Lock()
+	w.monochrome = enable
+	w.monochromeRanks = nil
+	w.assignMonochromeStyles()
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// IsMonochromeMode returns true while the chart is drawn for print
+func (w *LineChartSkn) IsMonochromeMode() bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.monochrome
+}
+
+// assignMonochromeStyles deals each series its place among the dash patterns and marker shapes by
+// name, dealing them again, and laying out every series, when a series was added or removed
+// caller must hold the mapsLock
+func (w *LineChartSkn) assignMonochromeStyles() {
+	if !w.monochrome {
+		return
+	}
+	current := len(w.monochromeRanks) == len(w.dataPoints)
+	for key := range w.dataPoints {
+		if _, ok := w.monochromeRanks[key]; !ok {
+			current = false
+			break
+		}
+	}
+	if current {
+		return
+	}
+	names := make([]string, 0, len(w.dataPoints))
+	for key := range w.dataPoints {
+		names = append(names, key)
+	}
+	sort.Strings(names)
+	w.monochromeRanks = make(map[string]int, len(names))
+	for rank, key := range names {
+		w.monochromeRanks[key] = rank
+	}
+	w.relayoutRequired = true
+}
+
+// monochromeDashPattern returns the series' dash pattern in monochrome mode, nil draws it solid
+// caller must hold the mapsLock
+func (w *LineChartSkn) monochromeDashPattern(seriesName string) []float32 {
+	return monochromeDashes[w.monochromeRanks[seriesName]%len(monochromeDashes)]
+}
+
+// monochromeMarker returns the series' marker shape in monochrome mode
+// caller must hold the mapsLock
+func (w *LineChartSkn) monochromeMarker(seriesName string) MarkerShape {
+	return monochromeMarkers[w.monochromeRanks[seriesName]%len(monochromeMarkers)]
+}
+
+// inkColor returns the color of the chart's labels, black in monochrome mode
+// caller must hold the mapsLock
+func (w *LineChartSkn) inkColor() color.Color {
+	if w.monochrome {
+		return monochromeInk
+	}
+	return theme.ForegroundColor()
+}
+
+// paperColor returns the color behind the chart, white in monochrome mode
+func (w *LineChartSkn) paperColor() color.Color {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	if w.monochrome {
+		return monochromePaper
+	}
+	return theme.BackgroundColor()
+}
+
+// grayColor returns the color's luminance as a gray of the same alpha
+func grayColor(c color.Color) color.Color {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	y := color.GrayModel.Convert(color.NRGBA{R: n.R, G: n.G, B: n.B, A: 0xff}).(color.Gray).Y
+	return color.NRGBA{R: y, G: y, B: y, A: n.A}
+}
+
+// layoutMonochrome shows the white background and recolors the labels and grid lines for the mode
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutMonochrome() {
+	grid := theme.PrimaryColorNamed(theme.ColorGreen)
+	if r.widget.monochrome {
+		grid = monochromeGrid
+		r.paper.Show()
+	} else {
+		r.paper.Hide()
+	}
+	ink := r.widget.inkColor()
+	labels := []*canvas.Text{r.topLeftDesc, r.topCenteredDesc, r.topRightDesc, r.bottomLeftDesc, r.bottomCenteredDesc, r.bottomRightDesc}
+	labels = append(labels, r.xLabels...)
+	labels = append(labels, r.yLabels...)
+	for _, label := range labels {
+		label.Color = ink
+	}
+	for _, line := range r.xLines {
+		line.StrokeColor = grid
+	}
+	for _, line := range r.yLines {
+		line.StrokeColor = grid
+	}
+}
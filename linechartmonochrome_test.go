@@ -0,0 +1,75 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Monochrome mode", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	black := color.NRGBA{A: 0xff}
+	white := color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithTitle("Print"), sknlinechart.WithRefreshInterval(0)))
+		for i := 0; i < 10; i++ {
+			cpu := sknlinechart.NewChartDatapoint(float32(20+i), theme.ColorOrange, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("CPU", &cpu)
+			memory := sknlinechart.NewChartDatapoint(float32(60-i), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("Memory", &memory)
+		}
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should draw black series told apart by dashes on a white background", func() {
+		solid := len(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange)))
+		Expect(solid).To(BeNumerically(">", 0))
+
+		lc.SetMonochromeMode(true)
+		Expect(lc.IsMonochromeMode()).To(BeTrue())
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange))).To(BeEmpty())
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorBlue))).To(BeEmpty())
+		Expect(len(seriesLines(lc, black))).To(BeNumerically(">", 2*solid), "the second series is dashed")
+
+		objs := test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects()
+		paper, ok := objs[0].(*canvas.Rectangle)
+		Expect(ok).To(BeTrue())
+		Expect(paper.Visible()).To(BeTrue())
+		Expect(paper.FillColor).To(Equal(white))
+		Expect(paper.Size()).To(Equal(lc.Size()))
+		for _, o := range objs {
+			if t, ok := o.(*canvas.Text); ok && t.Text == "Print" {
+				Expect(t.Color).To(Equal(black))
+			}
+		}
+
+		var out bytes.Buffer
+		Expect(lc.ExportSVG(&out)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring(`<rect width="100%" height="100%" fill="rgb(255,255,255)"`))
+	})
+	It("should restore the theme and series colors once disabled", func() {
+		lc.SetMonochromeMode(true)
+		lc.SetMonochromeMode(false)
+		Expect(lc.IsMonochromeMode()).To(BeFalse())
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange))).NotTo(BeEmpty())
+		Expect(test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects()[0].Visible()).To(BeFalse())
+	})
+})
@@ -0,0 +1,86 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+)
+
+// ChartAction chart behavior which can be bound to a mouse button click
+type ChartAction int
+
+const (
+	ChartActionNone                    ChartAction = iota // button is left to the app, see SetOnMouseButtonCallback
+	ChartActionToggleMousePointDisplay                    // toggle the hover popup
+	ChartActionToggleDataPointMarkers                     // toggle the series markers
+	ChartActionToggleCrosshair                            // toggle the crosshair and its readouts
+	ChartActionResetZoom                                  // return to the full data extent
+	ChartActionContextMenu                                // popup menu of chart options
+)
+
+// defaultMouseActions primary toggles the hover popup, secondary toggles markers
+func defaultMouseActions() map[desktop.MouseButton]ChartAction {
+	return map[desktop.MouseButton]ChartAction{
+		desktop.MouseButtonPrimary:   ChartActionToggleMousePointDisplay,
+		desktop.MouseButtonSecondary: ChartActionToggleDataPointMarkers,
+		desktop.MouseButtonTertiary:  ChartActionNone,
+	}
+}
+
+// SetMouseAction binds a chart action to a mouse button click; ChartActionNone frees the button for the app
+func (w *LineChartSkn) SetMouseAction(button desktop.MouseButton, action ChartAction) {
+	w.debugLog("LineChartSkn::SetMouseAction()")
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	if w.mouseActions == nil {
+		w.mouseActions = defaultMouseActions()
+	}
+	w.mouseActions[button] = action
+}
+
+// GetMouseAction returns the chart action bound to a mouse button
+func (w *LineChartSkn) GetMouseAction(button desktop.MouseButton) ChartAction {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.mouseActions[button]
+}
+
+// SetOnMouseButtonCallback method to call when a button bound to ChartActionNone is clicked
+func (w *LineChartSkn) SetOnMouseButtonCallback(f func(button desktop.MouseButton, position fyne.Position)) {
+	w.OnMouseButtonCallback = f
+}
+
+// performMouseAction runs the action bound to the clicked button
+func (w *LineChartSkn) performMouseAction(button desktop.MouseButton, position fyne.Position) {
+	w.debugLog("LineChartSkn::performMouseAction() ENTER. Button: ", button)
+	w.mapsLock.RLock()
+	action := w.mouseActions[button]
+	w.mapsLock.RUnlock()
+
+	switch action {
+	case ChartActionToggleMousePointDisplay:
+		w.enableMousePointDisplay = !w.enableMousePointDisplay
+		w.Refresh()
+	case ChartActionToggleDataPointMarkers:
+		w.toggleDataPointMarkers()
+	case ChartActionToggleCrosshair:
+		w.SetCrosshairEnabled(!w.enableCrosshair)
+	case ChartActionResetZoom:
+		w.ResetZoom()
+	case ChartActionContextMenu:
+		w.showContextMenu(position)
+	default:
+		if w.OnMouseButtonCallback != nil {
+			w.OnMouseButtonCallback(button, position)
+		}
+	}
+	w.debugLog("LineChartSkn::performMouseAction() EXIT")
+}
+
+// toggleDataPointMarkers flips marker display, markers are placed during series layout
+func (w *LineChartSkn) toggleDataPointMarkers() {
+	w.mapsLock.Lock()
+	w.enableDataPointMarkers = !w.enableDataPointMarkers
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
@@ -0,0 +1,53 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Mouse button bindings", func() {
+	var (
+		lc  *sknlinechart.LineChartSkn
+		tap *fyne.PointEvent
+	)
+
+	BeforeEach(func() {
+		chart, _ := makeUI("Testing", "Mouse", 10)
+		lc = chart.(*sknlinechart.LineChartSkn)
+		lc.Resize(fyne.NewSize(800, 400))
+		tap = &fyne.PointEvent{Position: fyne.NewPos(100, 100)}
+	})
+
+	It("should keep the original toggles by default", func() {
+		Expect(lc.GetMouseAction(desktop.MouseButtonPrimary)).To(Equal(sknlinechart.ChartActionToggleMousePointDisplay))
+		Expect(lc.GetMouseAction(desktop.MouseButtonSecondary)).To(Equal(sknlinechart.ChartActionToggleDataPointMarkers))
+
+		lc.Tapped(tap)
+		Expect(lc.IsMousePointDisplayEnabled()).To(BeFalse())
+		lc.TappedSecondary(tap)
+		Expect(lc.IsDataPointMarkersEnabled()).To(BeFalse())
+	})
+	It("should remap a button to another action", func() {
+		lc.SetMouseAction(desktop.MouseButtonPrimary, sknlinechart.ChartActionToggleCrosshair)
+		lc.Tapped(tap)
+		Expect(lc.IsCrosshairEnabled()).To(BeTrue())
+		Expect(lc.IsMousePointDisplayEnabled()).To(BeTrue())
+	})
+	It("should hand unbound buttons to the app callback", func() {
+		var clicked desktop.MouseButton
+		lc.SetOnMouseButtonCallback(func(button desktop.MouseButton, position fyne.Position) {
+			clicked = button
+		})
+		lc.SetMouseAction(desktop.MouseButtonSecondary, sknlinechart.ChartActionNone)
+		lc.TappedSecondary(tap)
+		Expect(lc.IsDataPointMarkersEnabled()).To(BeTrue())
+		Expect(clicked).To(Equal(desktop.MouseButtonSecondary))
+
+		By("reporting the middle button, which has no tap event")
+		lc.MouseUp(&desktop.MouseEvent{PointEvent: *tap, Button: desktop.MouseButtonTertiary})
+		Expect(clicked).To(Equal(desktop.MouseButtonTertiary))
+	})
+})
@@ -3,12 +3,15 @@ package sknlinechart
 import (
 	"errors"
 	"fmt"
+	"image/color"
 	"log"
 	"log/slog"
 	"os"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/theme"
 )
 
@@ -54,20 +57,24 @@ func (o *ChartOptions) Apply(lc *LineChartSkn) error {
 }
 
 // NewLineChartViaOptions Create the Line Chart using ChartOptions model
+//
+// Deprecated: use NewWithOptions, this alias is kept so existing code continues to compile
+func NewLineChartViaOptions(options *ChartOptions) (LineChart, error) {
+	return NewWithOptions(options)
+}
+
+// NewWithOptions Create the Line Chart using ChartOptions model
 // be careful not to exceed the series data point limit, which defaults to 150
 //
 // can return a valid chart object and an error object; errors really should be handled
 // and are caused by data points exceeding the container limit of 150; they will be truncated
-func NewLineChartViaOptions(options *ChartOptions) (LineChart, error) {
-	return NewLineChartViaOptions(options)
-}
 func NewWithOptions(options *ChartOptions) (LineChart, error) {
 
 	w := &LineChartSkn{ // Create this widget with an initial text value
 		dataPoints:              make(map[string][]*ChartDatapoint),
 		dataPointStrokeSize:     2.0,
 		dataSeriesAdded:         true,
-		dataPointXLimit:         150,
+		dataPointXLimit:         XPointLimit,
 		dataPointYLimit:         float32(10 * YPointLimit),
 		chartXScaleMultiplier:   1,
 		chartYScaleMultiplier:   10,
@@ -76,9 +83,15 @@ func NewWithOptions(options *ChartOptions) (LineChart, error) {
 		enableVertGridLines:     true,
 		enableMousePointDisplay: true,
 		enableColorLegend:       true,
+		enablePixelSnapping:     true,
+		enableDownsampling:      true,
+		enableProgressive:       true,
+		refreshInterval:         DefaultRefreshInterval,
+		maxTextLength:           defaultMaxTextLength,
+		nonFinitePolicy:         NonFiniteGap,
+		enableExportContext:     true,
 		mouseDisplayStr:         "",
 		mouseDisplayPosition:    &fyne.Position{},
-		mouseDisplayFrameColor:  string(theme.ColorNameForeground),
 		topLeftLabel:            "",
 		topCenteredLabel:        "",
 		topRightLabel:           "",
@@ -91,6 +104,12 @@ func NewWithOptions(options *ChartOptions) (LineChart, error) {
 		objectsCache:            []fyne.CanvasObject{}, // everything except datapoints, markers, and mousebox
 		mapsLock:                sync.RWMutex{},
 		logger:                  log.New(os.Stdout, "[DEBUG] ", log.Lmicroseconds|log.Lshortfile),
+		snapshotRetention:       defaultAutoSnapshotRetention,
+		hoverSnapRadius:         defaultHoverSnapRadius,
+		mouseActions:            defaultMouseActions(),
+		enableTouchMode:         isMobileDevice(),
+		highlightDimOpacity:     defaultHighlightDimOpacity,
+		draggedAnnotation:       -1,
 	}
 
 	err := options.Apply(w)
@@ -182,10 +201,11 @@ func WithXScaleFactor(maxXScale int) ChartOption {
 	}
 }
 
-// WithXLimit sets the dataPointXLimit value x to value <>150
+// WithXLimit sets the dataPointXLimit value x to value <>150, up to MaxXLimit; beyond 150 the x axis
+// keeps 150 grid columns and the series are downsampled when drawn
 func WithXLimit(xLimit int) ChartOption {
 	return func(lc *LineChartSkn) error {
-		if xLimit <= 0 || xLimit > XPointLimit {
+		if xLimit <= 0 || xLimit > MaxXLimit {
 			return nil
 		}
 		lc.dataPointXLimit = xLimit
@@ -247,6 +267,408 @@ func WithColorLegend(enable bool) ChartOption {
 	}
 }
 
+// WithZoomSelection enables rubber-band zoom by dragging with the primary mouse button
+func WithZoomSelection(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableZoomSelection = enable
+		return nil
+	}
+}
+
+// WithCrosshair enables the crosshair lines and readouts tracking the mouse
+func WithCrosshair(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableCrosshair = enable
+		return nil
+	}
+}
+
+// WithHoverSnapRadius sets the pixel radius searched for the datapoint nearest the mouse
+func WithHoverSnapRadius(pixels float32) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if pixels < 0 {
+			return fmt.Errorf("WithHoverSnapRadius() radius cannot be negative: %v", pixels)
+		}
+		lc.hoverSnapRadius = pixels
+		return nil
+	}
+}
+
+// WithHoverMode selects single point or multi-series compare hover popups
+func WithHoverMode(mode HoverMode) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.hoverMode = mode
+		return nil
+	}
+}
+
+// WithRenderQuality selects how much detail is drawn, default RenderQualityHigh
+func WithRenderQuality(quality RenderQuality) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.renderQuality = quality
+		return nil
+	}
+}
+
+// WithStaleThreshold dims any series not updated within threshold; zero disables dimming
+func WithStaleThreshold(threshold time.Duration) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if threshold < 0 {
+			return fmt.Errorf("WithStaleThreshold() threshold cannot be negative: %v", threshold)
+		}
+		lc.staleThreshold = threshold
+		lc.startStaleWatch()
+		return nil
+	}
+}
+
+// WithMouseAction binds a chart action to a mouse button click, ChartActionNone frees the button
+func WithMouseAction(button desktop.MouseButton, action ChartAction) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.mouseActions[button] = action
+		return nil
+	}
+}
+
+// WithContextMenu binds the secondary mouse button to the popup menu of chart options
+func WithContextMenu(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if enable {
+			lc.mouseActions[desktop.MouseButtonSecondary] = ChartActionContextMenu
+		}
+		return nil
+	}
+}
+
+// WithTouchMode makes a primary tap show the value of the datapoint under it, default on for mobile devices
+func WithTouchMode(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableTouchMode = enable
+		return nil
+	}
+}
+
+// WithHoverHighlight emphasizes the series nearest the pointer and dims the others to opacity, 0 to 1
+func WithHoverHighlight(enable bool, opacity float32) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if opacity < 0 || opacity > 1 {
+			return fmt.Errorf("WithHoverHighlight() opacity must be between 0 and 1: %v", opacity)
+		}
+		lc.enableHoverHighlight = enable
+		lc.highlightDimOpacity = opacity
+		return nil
+	}
+}
+
+// WithAnnotationEditing enables double-click to add or edit annotations and dragging labels to move them
+func WithAnnotationEditing(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableAnnotationEditing = enable
+		return nil
+	}
+}
+
+// WithLegendPosition places the series legend on the top, bottom, or right of the plot, or floating inside it
+func WithLegendPosition(position LegendPosition) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.legendPosition = position
+		return nil
+	}
+}
+
+// WithStatsOverlay draws labeled lines at each shown series' min, max, mean, and last value in view
+func WithStatsOverlay(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableStatsOverlay = enable
+		return nil
+	}
+}
+
+// WithLegendValues shows the given statistics of each series beside its legend entry, like a table legend
+func WithLegendValues(columns ...LegendValue) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.legendValues = append([]LegendValue(nil), columns...)
+		return nil
+	}
+}
+
+// WithExportContext includes annotations with exported csv, and annotations, time bands, thresholds,
+// reference regions, and vertical markers with exported state, true by default
+func WithExportContext(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableExportContext = enable
+		return nil
+	}
+}
+
+// WithExportPrivacy rounds values and coarsens or strips timestamps and metadata in csv and state exports
+func WithExportPrivacy(privacy ExportPrivacy) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if err := privacy.validate(); err != nil {
+			return fmt.Errorf("WithExportPrivacy() %w", err)
+		}
+		lc.exportPrivacy = privacy
+		return nil
+	}
+}
+
+// WithSeriesColor draws the series in any color, not only theme color names, to match a corporate palette
+func WithSeriesColor(seriesName string, c color.Color) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if c == nil {
+			return fmt.Errorf("WithSeriesColor() [%s] color cannot be nil", seriesName)
+		}
+		if lc.seriesColors == nil {
+			lc.seriesColors = map[string]color.Color{}
+		}
+		lc.seriesColors[seriesName] = c
+		return nil
+	}
+}
+
+// WithSeriesStyle strokes the series' lines with the style's width, dash pattern, and opacity
+func WithSeriesStyle(seriesName string, style SeriesStyle) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if err := style.validate(); err != nil {
+			return fmt.Errorf("WithSeriesStyle() [%s] %w", seriesName, err)
+		}
+		lc.applySeriesStyle(seriesName, style)
+		return nil
+	}
+}
+
+// WithSeriesColorGradient colors the series' points and segments between two colors by their value
+func WithSeriesColorGradient(seriesName string, gradient ColorGradient) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if err := gradient.validate(); err != nil {
+			return fmt.Errorf("WithSeriesColorGradient() [%s] %w", seriesName, err)
+		}
+		lc.applySeriesGradient(seriesName, &gradient)
+		return nil
+	}
+}
+
+// WithSeriesFill shades the area under the series in fillColor, nil for a shade of the series color
+func WithSeriesFill(seriesName string, fillColor color.Color) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if lc.seriesFills == nil {
+			lc.seriesFills = map[string]color.Color{}
+		}
+		lc.seriesFills[seriesName] = fillColor
+		return nil
+	}
+}
+
+// WithThresholdLine draws a labeled horizontal line across the plot area at value, nil color for the error color
+func WithThresholdLine(name string, value float32, lineColor color.Color, labelText string) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if name == "" || !isFinite(value) {
+			return errors.New("WithThresholdLine() a name and finite value are required")
+		}
+		lc.setThresholdLine(thresholdLine{name: name, value: value, color: lineColor, label: labelText})
+		return nil
+	}
+}
+
+// WithVerticalMarker flags an event at an index or time with a labeled line across the plot
+func WithVerticalMarker(at MarkerAt, text string, markerColor color.Color) ChartOption {
+	return func(lc *LineChartSkn) error {
+		marker := VerticalMarker{At: at, Text: text, Color: markerColor}
+		if err := marker.validate(); err != nil {
+			return fmt.Errorf("WithVerticalMarker() %w", err)
+		}
+		lc.setVerticalMarker(marker)
+		return nil
+	}
+}
+
+// WithReferenceRegion shades a range of values or of the x axis behind the data
+func WithReferenceRegion(region ReferenceRegion) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if err := region.validate(); err != nil {
+			return fmt.Errorf("WithReferenceRegion() %w", err)
+		}
+		lc.setReferenceRegion(region)
+		return nil
+	}
+}
+
+// WithTimeBands shades recurring time windows, like nights or weekends, behind the data
+func WithTimeBands(bands []TimeBand) ChartOption {
+	return func(lc *LineChartSkn) error {
+		for _, b := range bands {
+			err := b.validate()
+			if err != nil {
+				return err
+			}
+		}
+		lc.timeBands = append([]TimeBand(nil), bands...)
+		return nil
+	}
+}
+
+// WithGapMarkers draws a vertical event marker across the plot at each gap inserted by InsertGap
+func WithGapMarkers(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableGapMarkers = enable
+		return nil
+	}
+}
+
+// WithSeriesPointLimit keeps fewer datapoints for the series than the chart's point limit
+func WithSeriesPointLimit(seriesName string, limit int) ChartOption {
+	return func(lc *LineChartSkn) error {
+		return lc.setSeriesPointLimit(seriesName, limit)
+	}
+}
+
+// WithTranslator localizes the chart's built-in text, see SetTranslator
+func WithTranslator(translate func(key string) string) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if translate != nil {
+			lc.translator.Store(&translate)
+		}
+		return nil
+	}
+}
+
+// WithRefreshInterval limits the redraws caused by incoming datapoints to one per interval, see SetRefreshInterval
+func WithRefreshInterval(interval time.Duration) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if interval < 0 {
+			return fmt.Errorf("WithRefreshInterval() interval must not be negative: %v", interval)
+		}
+		lc.refreshInterval = interval
+		return nil
+	}
+}
+
+// WithRenderBackend selects how series are drawn, see SetRenderBackend
+func WithRenderBackend(backend RenderBackend) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if backend != RenderBackendVector && backend != RenderBackendRaster {
+			return fmt.Errorf("WithRenderBackend() unknown backend: %v", backend)
+		}
+		lc.renderBackend = backend
+		return nil
+	}
+}
+
+// WithCVDSimulation draws the chart's colors as seen with a color-vision deficiency, see SetCVDSimulation
+func WithCVDSimulation(mode CVDMode) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if _, ok := cvdMatrices[mode]; !ok && mode != CVDNone {
+			return fmt.Errorf("WithCVDSimulation() unknown mode: %v", mode)
+		}
+		lc.cvdMode = mode
+		return nil
+	}
+}
+
+// WithDownsampling draws series with more points than the plot has room for through an LTTB selection, on by default
+func WithDownsampling(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableDownsampling = enable
+		return nil
+	}
+}
+
+// WithProgressiveLoading draws large loaded histories coarse first and refines them over the following frames, on by default
+func WithProgressiveLoading(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableProgressive = enable
+		return nil
+	}
+}
+
+// WithPixelSnapping aligns grid, crosshair, and marker lines to device pixels, on by default
+func WithPixelSnapping(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enablePixelSnapping = enable
+		return nil
+	}
+}
+
+// WithTimeWindow keeps only datapoints whose timestamps fall within the last d
+func WithTimeWindow(d time.Duration) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if d < 0 {
+			return fmt.Errorf("WithTimeWindow() duration cannot be negative: %v", d)
+		}
+		lc.timeWindow = d
+		lc.applyTimeWindow()
+		return nil
+	}
+}
+
+// WithXAxisRange plots datapoints carrying an x value on a numeric x axis from min to max
+func WithXAxisRange(min, max float32) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if max <= min {
+			return fmt.Errorf("WithXAxisRange() max must be greater than min: %v-%v", min, max)
+		}
+		lc.xAxis = &xAxisRange{min: min, max: max}
+		return nil
+	}
+}
+
+// WithTimeSpacing positions datapoints along the x axis by their timestamps rather than their index
+func WithTimeSpacing(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableTimeSpacing = enable
+		return nil
+	}
+}
+
+// WithYInverted plots increasing values downward, the y axis labeled from its minimum at the top
+func WithYInverted(inverted bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.yInverted = inverted
+		return nil
+	}
+}
+
+// WithStackingMode stacks the series' values at each index, or their percentage of the total
+func WithStackingMode(mode StackingMode) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if mode < StackingNone || mode > StackingPercent {
+			return fmt.Errorf("WithStackingMode() unknown mode: %v", mode)
+		}
+		lc.stackingMode = mode
+		return nil
+	}
+}
+
+// WithLineInterpolation draws the lines between datapoints straight, as splines, or as steps
+func WithLineInterpolation(mode LineInterpolation) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if !mode.valid() {
+			return fmt.Errorf("WithLineInterpolation() unknown interpolation: %v", mode)
+		}
+		lc.lineInterpolation = mode
+		return nil
+	}
+}
+
+// WithNonFiniteValuePolicy sets how NaN and infinite datapoint values are handled on ingest
+func WithNonFiniteValuePolicy(policy NonFiniteValuePolicy) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.nonFinitePolicy = policy
+		return nil
+	}
+}
+
+// WithMaxTextLength clips popup and readout text to length characters, 0 shows text in full
+func WithMaxTextLength(length int) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if length < 0 {
+			return fmt.Errorf("WithMaxTextLength() length cannot be negative: %d", length)
+		}
+		lc.maxTextLength = length
+		return nil
+	}
+}
+
 // WithDebugLogging activate logger to record method entry/exits
 func WithDebugLogging(enable bool) ChartOption {
 	return func(lc *LineChartSkn) error {
@@ -282,7 +704,8 @@ func WithDataPoints(seriesData map[string][]*ChartDatapoint) ChartOption {
 			}
 		}
 		for key, points := range seriesData {
-			lc.dataPoints[key] = points
+			lc.dataPoints[key], _ = lc.acceptDataSeries(points)
+			lc.touchSeries(key)
 		}
 
 		if len(err.Error()) < 10 {
@@ -0,0 +1,84 @@
+package sknlinechart
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+)
+
+// pinTooltipModifier held while clicking a datapoint pins its tooltip
+const pinTooltipModifier = fyne.KeyModifierShift
+
+// pinnedTooltip identifies a datapoint whose tooltip stays on screen
+type pinnedTooltip struct {
+	series string
+	index  int
+}
+
+// PinTooltip keeps the tooltip of the series datapoint at index on screen until cleared
+func (w *LineChartSkn) PinTooltip(seriesName string, index int) error {
+	w.debugLog("LineChartSkn::PinTooltip() ENTER")
+	w.mapsLock.Lock()
+	err := w.pinTooltip(seriesName, index)
+	w.mapsLock.Unlock()
+	if err != nil {
+		w.debugLog("LineChartSkn::PinTooltip() ERROR EXIT")
+		return err
+	}
+	w.Refresh()
+	w.debugLog("LineChartSkn::PinTooltip() EXIT")
+	return nil
+}
+
+// ClearPinnedTooltips removes every pinned tooltip
+func (w *LineChartSkn) ClearPinnedTooltips() {
+	w.debugLog("LineChartSkn::ClearPinnedTooltips()")
+	w.mapsLock.Lock()
+	w.pinnedTooltips = nil
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// pinTooltip caller must hold the mapsLock
+func (w *LineChartSkn) pinTooltip(seriesName string, index int) error {
+	points, ok := w.dataPoints[seriesName]
+	if !ok {
+		return fmt.Errorf("PinTooltip() series not found: %s", seriesName)
+	}
+	if index < 0 || index >= len(points) {
+		return fmt.Errorf("PinTooltip() [%s] index out of range. index:%d, count:%d", seriesName, index, len(points))
+	}
+	for _, pin := range w.pinnedTooltips {
+		if pin.series == seriesName && pin.index == index {
+			return nil
+		}
+	}
+	w.pinnedTooltips = append(w.pinnedTooltips, pinnedTooltip{series: seriesName, index: index})
+	return nil
+}
+
+// pinTooltipAt pins the datapoint nearest the position, returns false when none is in range
+func (w *LineChartSkn) pinTooltipAt(pos fyne.Position) bool {
+	w.mapsLock.Lock()
+	key, idx, _, matched := w.nearestDatapoint(pos)
+	if matched {
+		_ = w.pinTooltip(key, idx)
+	}
+	w.mapsLock.Unlock()
+	if matched {
+		w.Refresh()
+	}
+	return matched
+}
+
+// pinnedTooltipText composes the readout for a pinned datapoint, empty when it no longer exists
+// caller must hold the mapsLock
+func (w *LineChartSkn) pinnedTooltipText(pin pinnedTooltip) (string, string, *ChartDatapoint) {
+	points := w.dataPoints[pin.series]
+	if pin.index >= len(points) {
+		return "", "", nil
+	}
+	point := points[pin.index]
+	return w.clipText(fmt.Sprint(pin.series, ", ", w.pointXText(pin.index, *point), ", ", w.Message(MessageValue), ": ", w.pointValueText(pin.series, *point))),
+		w.clipText(fmt.Sprint("[", (*point).Timestamp(), "]")), point
+}
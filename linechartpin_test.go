@@ -0,0 +1,80 @@
+package sknlinechart_test
+
+import (
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Pinnable tooltips", func() {
+	var (
+		lc     *sknlinechart.LineChartSkn
+		points []*sknlinechart.ChartDatapoint
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(10*i), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		chart, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc = chart.(*sknlinechart.LineChartSkn)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	var collect func(objs []fyne.CanvasObject, found []string) []string
+	collect = func(objs []fyne.CanvasObject, found []string) []string {
+		for _, o := range objs {
+			if !o.Visible() {
+				continue
+			}
+			switch t := o.(type) {
+			case *fyne.Container:
+				found = collect(t.Objects, found)
+			case *canvas.Text:
+				if strings.HasPrefix(t.Text, "Testing, Index: ") {
+					found = append(found, t.Text)
+				}
+			}
+		}
+		return found
+	}
+	pinned := func() []string {
+		return collect(test.WidgetRenderer(lc).Objects(), nil)
+	}
+
+	It("should pin tooltips by series and index", func() {
+		Expect(lc.PinTooltip("Testing", 2)).To(Succeed())
+		Expect(lc.PinTooltip("Testing", 7)).To(Succeed())
+		Expect(lc.PinTooltip("Testing", 7)).To(Succeed())
+		Expect(pinned()).To(ConsistOf("Testing, Index: 2, Value: 20", "Testing, Index: 7, Value: 70"))
+
+		lc.ClearPinnedTooltips()
+		Expect(pinned()).To(BeEmpty())
+	})
+	It("should reject unknown series and indexes", func() {
+		Expect(lc.PinTooltip("Unknown", 0)).To(HaveOccurred())
+		Expect(lc.PinTooltip("Testing", 10)).To(HaveOccurred())
+	})
+	It("should pin the datapoint under a shift-click without toggling the hover popup", func() {
+		top, bottom := (*points[4]).MarkerPosition()
+		me := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary, Modifier: fyne.KeyModifierShift}
+		me.Position = fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)
+		lc.MouseDown(me)
+		lc.MouseUp(me)
+		lc.Tapped(&me.PointEvent)
+
+		Expect(pinned()).To(ConsistOf("Testing, Index: 4, Value: 40"))
+		Expect(lc.IsMousePointDisplayEnabled()).To(BeTrue())
+	})
+})
@@ -0,0 +1,59 @@
+package sknlinechart
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// pixelGrid aligns hairlines with the device pixels of the canvas showing the chart,
+// a line centered between two pixels is spread over both and renders blurry
+type pixelGrid struct {
+	scale  float32       // device pixels per canvas unit, 0 disables snapping
+	origin fyne.Position // absolute position of the chart on its canvas
+}
+
+// IsPixelSnappingEnabled returns true when grid, crosshair, and marker lines are aligned to device pixels
+func (w *LineChartSkn) IsPixelSnappingEnabled() bool {
+	return w.enablePixelSnapping
+}
+
+// SetPixelSnapping aligns grid, crosshair, and marker lines to the device pixels of the
+// canvas scale so they render crisp, on by default
+func (w *LineChartSkn) SetPixelSnapping(enable bool) {
+	w.enablePixelSnapping = enable
+	w.Refresh()
+}
+
+// pixelGrid returns the device pixel grid of the canvas showing the chart, a no-op grid when
+// snapping is disabled or the chart is not shown
+func (w *LineChartSkn) pixelGrid() pixelGrid {
+	app := fyne.CurrentApp()
+	if !w.enablePixelSnapping || app == nil {
+		return pixelGrid{}
+	}
+	driver := app.Driver()
+	c := driver.CanvasForObject(w)
+	if c == nil {
+		return pixelGrid{}
+	}
+	return pixelGrid{scale: c.Scale(), origin: driver.AbsolutePositionForObject(w)}
+}
+
+// x moves a vertical line's x coordinate to the center of its device pixel column
+func (g pixelGrid) x(v float32) float32 {
+	return g.snap(v, g.origin.X)
+}
+
+// y moves a horizontal line's y coordinate to the center of its device pixel row
+func (g pixelGrid) y(v float32) float32 {
+	return g.snap(v, g.origin.Y)
+}
+
+func (g pixelGrid) snap(v, origin float32) float32 {
+	if g.scale <= 0 {
+		return v
+	}
+	device := math.Floor(float64((v + origin) * g.scale))
+	return (float32(device)+0.5)/g.scale - origin
+}
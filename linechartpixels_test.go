@@ -0,0 +1,54 @@
+package sknlinechart_test
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Pixel snapping", func() {
+	var lc sknlinechart.LineChart
+
+	gridLines := func() []*canvas.Line {
+		var lines []*canvas.Line
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if line, ok := o.(*canvas.Line); ok && line.StrokeWidth == 0.25 {
+				lines = append(lines, line)
+			}
+		}
+		return lines
+	}
+	onPixelCenters := func() bool {
+		for _, line := range gridLines() {
+			x, y := line.Position1.X, line.Position1.Y
+			if line.Position1.X == line.Position2.X && x-float32(math.Floor(float64(x))) != 0.5 {
+				return false
+			}
+			if line.Position1.Y == line.Position2.Y && y-float32(math.Floor(float64(y))) != 0.5 {
+				return false
+			}
+		}
+		return true
+	}
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "Pixels", 20)
+	})
+
+	It("should center grid lines on device pixels when shown", func() {
+		w := test.NewWindow(lc)
+		defer w.Close()
+		w.Resize(fyne.NewSize(801, 403))
+		Expect(lc.IsPixelSnappingEnabled()).To(BeTrue())
+		Expect(gridLines()).To(HaveLen(150 + 14))
+		Expect(onPixelCenters()).To(BeTrue())
+
+		lc.SetPixelSnapping(false)
+		Expect(onPixelCenters()).To(BeFalse())
+	})
+})
@@ -0,0 +1,61 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// pointObjectPool spare line and marker pairs, released when a series shrinks or is removed
+// and reused when one grows, so the renderer holds exactly one pair per datapoint shown
+type pointObjectPool struct {
+	lines   []*canvas.Line
+	markers []*canvas.Circle
+}
+
+// pointObjects returns a line and marker for a newly shown datapoint, reusing released ones when available
+// caller must hold the mapsLock
+func (r *lineChartRenderer) pointObjects(colorName string) (*canvas.Line, *canvas.Circle) {
+	strokeSize := r.widget.dataPointStrokeSize
+	markerSize := strokeSize * 5
+	c := theme.PrimaryColorNamed(colorName)
+	if n := len(r.pool.lines); n > 0 {
+		line, marker := r.pool.lines[n-1], r.pool.markers[n-1]
+		r.pool.lines[n-1], r.pool.markers[n-1] = nil, nil
+		r.pool.lines, r.pool.markers = r.pool.lines[:n-1], r.pool.markers[:n-1]
+		line.StrokeColor, line.StrokeWidth = c, strokeSize
+		marker.FillColor, marker.StrokeWidth = c, strokeSize*2
+		marker.Resize(fyne.NewSize(markerSize, markerSize))
+		return line, marker
+	}
+	line := canvas.NewLine(c)
+	line.StrokeWidth = strokeSize
+	marker := canvas.NewCircle(c)
+	marker.StrokeWidth = strokeSize * 2
+	marker.Resize(fyne.NewSize(markerSize, markerSize))
+	return line, marker
+}
+
+// releasePointObjects trims the series' lines and markers to count, keeping those removed for reuse
+// caller must hold the mapsLock
+func (r *lineChartRenderer) releasePointObjects(series string, count int) {
+	lines, markers := r.dataPoints[series], r.dataPointMarkers[series]
+	for idx := count; idx < len(lines); idx++ {
+		r.releasePointObject(lines[idx], markers[idx])
+		lines[idx], markers[idx] = nil, nil // not held by the series' backing arrays
+	}
+	r.dataPoints[series], r.dataPointMarkers[series] = lines[:count], markers[:count]
+}
+
+// releasePointObject hides the line and marker, keeping them for reuse up to the chart's point limit;
+// beyond it they are left to the garbage collector
+// caller must hold the mapsLock
+func (r *lineChartRenderer) releasePointObject(line *canvas.Line, marker *canvas.Circle) {
+	if len(r.pool.lines) >= r.widget.dataPointXLimit {
+		return
+	}
+	line.Hide()
+	marker.Hide()
+	r.pool.lines = append(r.pool.lines, line)
+	r.pool.markers = append(r.pool.markers, marker)
+}
@@ -0,0 +1,70 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Pooled point objects", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	// markers returns the datapoint markers the renderer holds
+	markers := func() map[*canvas.Circle]bool {
+		found := map[*canvas.Circle]bool{}
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if marker, ok := o.(*canvas.Circle); ok {
+				found[marker] = true
+			}
+		}
+		return found
+	}
+	series := func(count int) []*sknlinechart.ChartDatapoint {
+		var points []*sknlinechart.ChartDatapoint
+		for i := 0; i < count; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(20+i%30), theme.ColorOrange, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		return points
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+		Expect(lc.ApplyDataSeries("Disk", series(100))).To(Succeed())
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should trim the objects of a series replaced by a shorter one", func() {
+		Expect(markers()).To(HaveLen(100))
+		Expect(lc.ApplyDataSeries("Disk", series(10))).To(Succeed())
+		Expect(markers()).To(HaveLen(10))
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange))).To(HaveLen(10))
+	})
+	It("should reuse released objects as a series grows again", func() {
+		before := markers()
+		Expect(lc.ApplyDataSeries("Disk", series(10))).To(Succeed())
+		Expect(lc.ApplyDataSeries("Disk", series(100))).To(Succeed())
+		Expect(markers()).To(Equal(before))
+	})
+	It("should release the objects of removed series", func() {
+		Expect(lc.ApplyDataSeries("Network", series(20))).To(Succeed())
+		Expect(markers()).To(HaveLen(120))
+		Expect(lc.DeleteSeries("Disk")).To(Succeed())
+		Expect(markers()).To(HaveLen(20))
+		Expect(lc.ApplyDataSeries("Memory", series(50))).To(Succeed())
+		Expect(markers()).To(HaveLen(70))
+	})
+})
@@ -0,0 +1,72 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// PopupBuilder returns the content shown in the hover popup for a datapoint, nil falls back to the text popup
+type PopupBuilder func(point ChartDatapoint) fyne.CanvasObject
+
+// SetSeriesPopupBuilder replaces the text popup shown when hovering or tapping the series' points with
+// the content the builder returns, such as a small table, an icon, or a link button. The builder is
+// called with a copy of the point, while the chart is locked, so it must not call back into the chart;
+// nil restores the text popup
+func (w *LineChartSkn) SetSeriesPopupBuilder(seriesName string, builder PopupBuilder) {
+	w.debugLog("LineChartSkn::SetSeriesPopupBuilder()")
+	w.mapsLock.Lock()
+	if builder == nil {
+		delete(w.popupBuilders, seriesName)
+	} else {
+		if w.popupBuilders == nil {
+			w.popupBuilders = map[string]PopupBuilder{}
+		}
+		w.popupBuilders[seriesName] = builder
+	}
+	w.mouseDisplayContent = nil
+	w.mapsLock.Unlock()
+}
+
+// buildPopupContent returns the series' custom popup content for the point, positioned above pos;
+// nil when the series shows the text popup
+// caller must hold the mapsLock
+func (w *LineChartSkn) buildPopupContent(seriesName string, point *ChartDatapoint, pos fyne.Position) fyne.CanvasObject {
+	builder, ok := w.popupBuilders[seriesName]
+	if !ok {
+		return nil
+	}
+	content := builder((*point).Copy())
+	if content == nil {
+		return nil
+	}
+	size := content.MinSize()
+	w.mouseDisplayPosition = &fyne.Position{X: pos.X - (size.Width / 2), Y: pos.Y - size.Height - (2 * theme.Padding())}
+	return content
+}
+
+// setMouseDisplayContent swaps the popup's text for the custom content, or back when content is nil
+func (r *lineChartRenderer) setMouseDisplayContent(content fyne.CanvasObject) {
+	legend := r.mouseDisplayContainer.Objects[1].(*widget.Label)
+	r.mouseDisplayContainer.Objects = r.mouseDisplayContainer.Objects[:2]
+	if content == nil {
+		legend.Show()
+		return
+	}
+	legend.Hide()
+	content.Show()
+	r.mouseDisplayContainer.Objects = append(r.mouseDisplayContainer.Objects, content)
+}
+
+// layoutMouseDisplayContent sizes the popup's frame around its custom content, false when it shows text
+func (r *lineChartRenderer) layoutMouseDisplayContent() (fyne.Size, bool) {
+	if len(r.mouseDisplayContainer.Objects) < 3 {
+		return fyne.Size{}, false
+	}
+	border, content := r.mouseDisplayContainer.Objects[0], r.mouseDisplayContainer.Objects[2]
+	size := content.MinSize()
+	content.Resize(size)
+	content.Move(border.Position().AddXY(theme.Padding()/2, theme.Padding()/2))
+	border.Resize(size.AddWidthHeight(theme.Padding(), theme.Padding()))
+	return size.AddWidthHeight(theme.Padding(), 0), true
+}
@@ -0,0 +1,97 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Custom hover popups", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+		over   *desktop.MouseEvent
+	)
+
+	// popup returns the hover popup container and whether it is showing
+	popup := func() (*fyne.Container, bool) {
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if c, ok := o.(*fyne.Container); ok && len(c.Objects) >= 2 {
+				if _, ok := c.Objects[1].(*widget.Label); ok {
+					return c, c.Visible()
+				}
+			}
+		}
+		return nil, false
+	}
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(10*i), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+
+		top, bottom := (*points[5]).MarkerPosition()
+		over = &desktop.MouseEvent{}
+		over.Position = fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)
+	})
+
+	It("should show the builder's content for the hovered point", func() {
+		var built sknlinechart.ChartDatapoint
+		content := container.NewVBox(widget.NewLabel("cpu"), widget.NewButton("Details", func() {}))
+		lc.SetSeriesPopupBuilder("Testing", func(point sknlinechart.ChartDatapoint) fyne.CanvasObject {
+			built = point
+			return content
+		})
+		lc.(*sknlinechart.LineChartSkn).MouseMoved(over)
+
+		Expect(built.Value()).To(BeNumerically("==", 50))
+		display, shown := popup()
+		Expect(shown).To(BeTrue())
+		Expect(display.Objects).To(ContainElement(content))
+		Expect(display.Objects[1].Visible()).To(BeFalse())
+		Expect(content.Size()).To(Equal(content.MinSize()))
+		Expect(display.Objects[0].Size().Width).To(BeNumerically(">", content.MinSize().Width))
+
+		lc.(*sknlinechart.LineChartSkn).MouseOut()
+		_, shown = popup()
+		Expect(shown).To(BeFalse())
+	})
+	It("should fall back to the text popup", func() {
+		lc.SetSeriesPopupBuilder("Testing", func(point sknlinechart.ChartDatapoint) fyne.CanvasObject {
+			return nil
+		})
+		lc.(*sknlinechart.LineChartSkn).MouseMoved(over)
+		display, shown := popup()
+		Expect(shown).To(BeTrue())
+		Expect(display.Objects).To(HaveLen(2))
+		Expect(display.Objects[1].(*widget.Label).Text).To(ContainSubstring("Value: 50"))
+
+		content := widget.NewLabel("custom")
+		lc.SetSeriesPopupBuilder("Testing", func(point sknlinechart.ChartDatapoint) fyne.CanvasObject {
+			return content
+		})
+		lc.(*sknlinechart.LineChartSkn).MouseMoved(over)
+		display, _ = popup()
+		Expect(display.Objects).To(ContainElement(content))
+
+		lc.SetSeriesPopupBuilder("Testing", nil)
+		lc.(*sknlinechart.LineChartSkn).MouseMoved(over)
+		display, _ = popup()
+		Expect(display.Objects).NotTo(ContainElement(content))
+		Expect(display.Objects[1].Visible()).To(BeTrue())
+		Expect(display.Objects[1].(*widget.Label).Text).To(ContainSubstring("Testing"))
+	})
+})
@@ -0,0 +1,139 @@
+package sknlinechart
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+// chartPreferences user toggles stored under a preferences key, as a single json string
+type chartPreferences struct {
+	DataPointMarkers  bool           `json:"dataPointMarkers"`
+	HorizGridLines    bool           `json:"horizGridLines"`
+	VertGridLines     bool           `json:"vertGridLines"`
+	MousePointDisplay bool           `json:"mousePointDisplay"`
+	ColorLegend       bool           `json:"colorLegend"`
+	Viewport          *ChartViewport `json:"viewport,omitempty"` // the zoomed region, nil when not zoomed
+	HiddenSeries      []string       `json:"hiddenSeries,omitempty"`
+}
+
+// preferencesBinding where BindPreferences saves the chart's toggles, and what it saved last
+type preferencesBinding struct {
+	prefs fyne.Preferences
+	key   string
+	saved string
+}
+
+// SavePreferences stores the user's toggles, markers, grid lines, hover display, color legend,
+// zoom and hidden series, under key, so they can be restored by RestorePreferences after a restart
+func (w *LineChartSkn) SavePreferences(p fyne.Preferences, key string) {
+	w.debugLog("LineChartSkn::SavePreferences()")
+	p.SetString(key, w.encodePreferences())
+}
+
+// RestorePreferences applies the toggles stored under key by SavePreferences; the chart is left
+// unchanged when nothing was stored. Series hidden then are hidden as they are added again
+func (w *LineChartSkn) RestorePreferences(p fyne.Preferences, key string) error {
+	w.debugLog("LineChartSkn::RestorePreferences() ENTER")
+	raw := p.String(key)
+	if raw == "" {
+		w.debugLog("LineChartSkn::RestorePreferences() EXIT")
+		return nil
+	}
+	var prefs chartPreferences
+	err := json.Unmarshal([]byte(raw), &prefs)
+	if err != nil {
+		w.debugLog("LineChartSkn::RestorePreferences() ERROR EXIT")
+		return fmt.Errorf("RestorePreferences() decoding %s: %w", key, err)
+	}
+	if vp := prefs.Viewport; vp != nil && (vp.XMax <= vp.XMin || vp.YMax <= vp.YMin) {
+		w.debugLog("LineChartSkn::RestorePreferences() ERROR EXIT")
+		return fmt.Errorf("RestorePreferences() invalid viewport. x:%v-%v, y:%v-%v", vp.XMin, vp.XMax, vp.YMin, vp.YMax)
+	}
+
+	w.mapsLock.Lock()
+	w.enableDataPointMarkers = prefs.DataPointMarkers
+	w.enableHorizGridLines = prefs.HorizGridLines
+	w.enableVertGridLines = prefs.VertGridLines
+	w.enableMousePointDisplay = prefs.MousePointDisplay
+	w.enableColorLegend = prefs.ColorLegend
+	w.viewport = prefs.Viewport
+	w.hiddenSeries = map[string]bool{}
+	for _, key := range prefs.HiddenSeries {
+		w.hiddenSeries[key] = true
+	}
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.broadcastViewport(prefs.Viewport)
+	w.debugLog("LineChartSkn::RestorePreferences() EXIT")
+	return nil
+}
+
+// BindPreferences restores the toggles stored under key, then saves them again whenever the chart
+// redraws after one changes, so user customizations survive restarts without further calls.
+// Replaces any binding already made
+func (w *LineChartSkn) BindPreferences(p fyne.Preferences, key string) error {
+	w.debugLog("LineChartSkn::BindPreferences() ENTER")
+	w.UnbindPreferences()
+	err := w.RestorePreferences(p, key)
+	if err != nil {
+		w.debugLog("LineChartSkn::BindPreferences() ERROR EXIT")
+		return err
+	}
+	w.preferencesLock.Lock()
+	w.preferences = &preferencesBinding{prefs: p, key: key, saved: p.String(key)}
+	w.preferencesLock.Unlock()
+	w.syncPreferences()
+	w.debugLog("LineChartSkn::BindPreferences() EXIT")
+	return nil
+}
+
+// UnbindPreferences stops saving the chart's toggles, those already stored are kept
+func (w *LineChartSkn) UnbindPreferences() {
+	w.debugLog("LineChartSkn::UnbindPreferences()")
+	w.preferencesLock.Lock()
+	w.preferences = nil
+	w.preferencesLock.Unlock()
+}
+
+// syncPreferences saves the toggles to the bound preferences when they differ from those saved last
+func (w *LineChartSkn) syncPreferences() {
+	w.preferencesLock.Lock()
+	defer w.preferencesLock.Unlock()
+	if w.preferences == nil {
+		return
+	}
+	encoded := w.encodePreferences()
+	if encoded != w.preferences.saved {
+		w.preferences.prefs.SetString(w.preferences.key, encoded)
+		w.preferences.saved = encoded
+	}
+}
+
+// encodePreferences returns the chart's current toggles as stored under a preferences key
+func (w *LineChartSkn) encodePreferences() string {
+	w.mapsLock.RLock()
+	prefs := chartPreferences{
+		DataPointMarkers:  w.enableDataPointMarkers,
+		HorizGridLines:    w.enableHorizGridLines,
+		VertGridLines:     w.enableVertGridLines,
+		MousePointDisplay: w.enableMousePointDisplay,
+		ColorLegend:       w.enableColorLegend,
+	}
+	if w.viewport != nil {
+		vp := *w.viewport
+		prefs.Viewport = &vp
+	}
+	for key, hidden := range w.hiddenSeries {
+		if hidden {
+			prefs.HiddenSeries = append(prefs.HiddenSeries, key)
+		}
+	}
+	w.mapsLock.RUnlock()
+	sort.Strings(prefs.HiddenSeries)
+	encoded, _ := json.Marshal(prefs) // plain fields, never fails
+	return string(encoded)
+}
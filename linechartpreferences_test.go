@@ -0,0 +1,80 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Chart preferences", func() {
+	var (
+		lc    sknlinechart.LineChart
+		prefs fyne.Preferences
+	)
+
+	newChart := func() sknlinechart.LineChart {
+		chart, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithRefreshInterval(0)))
+		Expect(err).NotTo(HaveOccurred())
+		for _, series := range []string{"CPU", "Memory"} {
+			for i := 0; i < 10; i++ {
+				point := sknlinechart.NewChartDatapoint(float32(10+i), theme.ColorOrange, time.Now().Format(time.RFC1123))
+				chart.ApplyDataPoint(series, &point)
+			}
+		}
+		return chart
+	}
+
+	BeforeEach(func() {
+		prefs = test.NewApp().Preferences()
+		lc = newChart()
+	})
+
+	It("should restore saved toggles, zoom and hidden series onto a new chart", func() {
+		lc.SetDataPointMarkers(false)
+		lc.SetHorizGridLines(false)
+		lc.SetMousePointDisplay(false)
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 2, XMax: 8, YMin: 0, YMax: 50})).To(Succeed())
+		Expect(lc.HideSeries("Memory")).To(Succeed())
+		lc.SavePreferences(prefs, "chart")
+
+		restored := newChart()
+		Expect(restored.RestorePreferences(prefs, "chart")).To(Succeed())
+		Expect(restored.IsDataPointMarkersEnabled()).To(BeFalse())
+		Expect(restored.IsHorizGridLinesEnabled()).To(BeFalse())
+		Expect(restored.IsVertGridLinesEnabled()).To(BeTrue())
+		Expect(restored.IsMousePointDisplayEnabled()).To(BeFalse())
+		Expect(restored.GetViewport()).To(Equal(sknlinechart.ChartViewport{XMin: 2, XMax: 8, YMin: 0, YMax: 50}))
+		Expect(restored.IsSeriesVisible("Memory")).To(BeFalse())
+		Expect(restored.IsSeriesVisible("CPU")).To(BeTrue())
+	})
+	It("should leave the chart unchanged when nothing was saved", func() {
+		Expect(lc.RestorePreferences(prefs, "missing")).To(Succeed())
+		Expect(lc.IsDataPointMarkersEnabled()).To(BeTrue())
+		Expect(lc.IsSeriesVisible("Memory")).To(BeTrue())
+	})
+	It("should reject malformed preferences", func() {
+		prefs.SetString("chart", "{not json")
+		Expect(lc.RestorePreferences(prefs, "chart")).To(HaveOccurred())
+		prefs.SetString("chart", `{"viewport":{"xMin":5,"xMax":1,"yMin":0,"yMax":10}}`)
+		Expect(lc.RestorePreferences(prefs, "chart")).To(HaveOccurred())
+	})
+	It("should save toggles as they change once bound, until unbound", func() {
+		Expect(lc.BindPreferences(prefs, "chart")).To(Succeed())
+		Expect(lc.HideSeries("CPU")).To(Succeed())
+
+		restored := newChart()
+		Expect(restored.RestorePreferences(prefs, "chart")).To(Succeed())
+		Expect(restored.IsSeriesVisible("CPU")).To(BeFalse())
+
+		lc.UnbindPreferences()
+		Expect(lc.ShowSeries("CPU")).To(Succeed())
+		restored = newChart()
+		Expect(restored.RestorePreferences(prefs, "chart")).To(Succeed())
+		Expect(restored.IsSeriesVisible("CPU")).To(BeFalse())
+	})
+})
@@ -0,0 +1,110 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ExportPrivacy coarsens the data written by csv snapshots and SaveState so charts of sensitive
+// metrics can be shared outside the team without leaking exact figures. The zero value exports exactly
+type ExportPrivacy struct {
+	ValuePrecision     float32       // values and bounds rounded to the nearest multiple, 0 keeps exact values
+	TimestampPrecision time.Duration // timestamps truncated to a multiple, unreadable ones removed; 0 keeps them as recorded
+	StripTimestamps    bool          // timestamps removed entirely
+	StripMetadata      bool          // series metadata, annotations, and vertical markers removed
+}
+
+// validate checks the precisions are usable
+func (p ExportPrivacy) validate() error {
+	if p.ValuePrecision < 0 || !isFinite(p.ValuePrecision) {
+		return fmt.Errorf("value precision must be zero or positive: %v", p.ValuePrecision)
+	}
+	if p.TimestampPrecision < 0 {
+		return fmt.Errorf("timestamp precision must be zero or positive: %v", p.TimestampPrecision)
+	}
+	return nil
+}
+
+// SetExportPrivacy rounds values, coarsens or strips timestamps, and strips descriptive metadata in
+// csv snapshots and SaveState, for exports leaving the team. The chart's own data and on-screen image are unchanged
+func (w *LineChartSkn) SetExportPrivacy(privacy ExportPrivacy) error {
+	w.debugLog("LineChartSkn::SetExportPrivacy() ENTER")
+	if err := privacy.validate(); err != nil {
+		w.debugLog("LineChartSkn::SetExportPrivacy() ERROR EXIT")
+		return fmt.Errorf("SetExportPrivacy() %w", err)
+	}
+	w.mapsLock.Lock()
+	w.exportPrivacy = privacy
+	w.mapsLock.Unlock()
+	w.debugLog("LineChartSkn::SetExportPrivacy() EXIT")
+	return nil
+}
+
+// GetExportPrivacy returns how exported data is coarsened
+func (w *LineChartSkn) GetExportPrivacy() ExportPrivacy {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.exportPrivacy
+}
+
+// value rounds v to the nearest multiple of the value precision
+func (p ExportPrivacy) value(v float32) float32 {
+	if p.ValuePrecision == 0 || !isFinite(v) {
+		return v
+	}
+	return float32(math.Round(float64(v/p.ValuePrecision))) * p.ValuePrecision
+}
+
+// timestamp truncates ts to the timestamp precision, keeping its format, or strips it
+func (p ExportPrivacy) timestamp(ts string) string {
+	if p.StripTimestamps {
+		return ""
+	}
+	if p.TimestampPrecision == 0 {
+		return ts
+	}
+	for _, layout := range timestampLayouts {
+		t, err := time.Parse(layout, ts)
+		if err == nil {
+			return t.Truncate(p.TimestampPrecision).Format(layout)
+		}
+	}
+	return ""
+}
+
+// apply coarsens a state about to be exported
+func (p ExportPrivacy) apply(state *ChartState) {
+	if p == (ExportPrivacy{}) {
+		return
+	}
+	for _, series := range state.Series {
+		for i := range series {
+			sp := &series[i]
+			if !sp.Missing {
+				sp.Value = p.value(sp.Value)
+			}
+			if sp.Lower != nil && sp.Upper != nil {
+				lower, upper := p.value(*sp.Lower), p.value(*sp.Upper)
+				sp.Lower, sp.Upper = &lower, &upper
+			}
+			sp.Timestamp = p.timestamp(sp.Timestamp)
+		}
+	}
+	markers := state.VerticalMarkers[:0]
+	for _, marker := range state.VerticalMarkers {
+		if marker.Time != "" {
+			if p.StripTimestamps {
+				continue // no longer has a place on the axis
+			}
+			marker.Time = p.timestamp(marker.Time)
+		}
+		markers = append(markers, marker)
+	}
+	state.VerticalMarkers = markers
+	if p.StripMetadata {
+		state.SeriesMetadata = nil
+		state.Annotations = nil
+		state.VerticalMarkers = nil
+	}
+}
@@ -0,0 +1,82 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Export privacy", func() {
+	var (
+		lc    sknlinechart.LineChart
+		stamp = time.Date(2026, 3, 14, 9, 47, 31, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		for i, value := range []float32{12.34, 57.81, 93.06} {
+			point := sknlinechart.NewChartDatapoint(value, theme.ColorBlue, stamp.Add(time.Duration(i)*time.Minute).Format(time.RFC1123))
+			lc.ApplyDataPoint("Revenue", &point)
+		}
+		Expect(lc.AddAnnotation("Revenue", 1, "Q1 close")).To(Succeed())
+		lc.SetSeriesMetadata("Revenue", sknlinechart.SeriesMetadata{sknlinechart.MetadataSource: "finance-db"})
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+	})
+
+	It("should export exact data by default", func() {
+		Expect(lc.GetExportPrivacy()).To(BeZero())
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring("57.81"))
+		Expect(buf.String()).To(ContainSubstring("Q1 close"))
+	})
+	It("should round values, truncate timestamps, and strip metadata from saved state", func() {
+		Expect(lc.SetExportPrivacy(sknlinechart.ExportPrivacy{
+			ValuePrecision: 10, TimestampPrecision: time.Hour, StripMetadata: true})).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		Expect(buf.String()).NotTo(ContainSubstring("Q1 close"))
+		Expect(buf.String()).NotTo(ContainSubstring("finance-db"))
+
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		points := restored.GetDataSeries("Revenue")
+		Expect(points).To(HaveLen(3))
+		for i, want := range []float32{10, 60, 90} {
+			Expect(points[i].Value()).To(BeNumerically("==", want))
+			Expect(points[i].Timestamp()).To(Equal(stamp.Truncate(time.Hour).Format(time.RFC1123)))
+		}
+		Expect(lc.GetDataSeries("Revenue")[1].Value()).To(BeNumerically("~", 57.81, 0.001))
+	})
+	It("should coarsen csv snapshots", func() {
+		Expect(lc.SetExportPrivacy(sknlinechart.ExportPrivacy{
+			ValuePrecision: 0.5, StripTimestamps: true, StripMetadata: true})).To(Succeed())
+
+		dir := GinkgoT().TempDir()
+		Expect(lc.EnableAutoSnapshot(5*time.Millisecond, dir, sknlinechart.SnapshotCSV)).To(Succeed())
+		defer lc.DisableAutoSnapshot()
+		var files []string
+		Eventually(func() int {
+			files, _ = filepath.Glob(filepath.Join(dir, "*.csv"))
+			return len(files)
+		}).Should(BeNumerically(">", 0))
+		content, err := os.ReadFile(files[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.Split(strings.TrimSpace(string(content)), "\n")).To(Equal([]string{
+			"index,timestamp,Revenue", "0,,12.5", "1,,58", "2,,93"}))
+	})
+	It("should reject negative precisions", func() {
+		Expect(lc.SetExportPrivacy(sknlinechart.ExportPrivacy{ValuePrecision: -1})).To(HaveOccurred())
+		Expect(lc.SetExportPrivacy(sknlinechart.ExportPrivacy{TimestampPrecision: -time.Second})).To(HaveOccurred())
+	})
+})
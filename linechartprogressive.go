@@ -0,0 +1,92 @@
+package sknlinechart
+
+import "time"
+
+const (
+	progressiveMinPoints    = 2000                  // series loaded with at least this many points are refined progressively
+	progressiveCoarsePoints = 64                    // points drawn by the first, coarse trace
+	progressiveFrame        = 30 * time.Millisecond // time between refinements, each doubling the points drawn
+)
+
+// IsProgressiveLoadingEnabled returns true when large histories are drawn coarse first and refined over the following frames
+func (w *LineChartSkn) IsProgressiveLoadingEnabled() bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.enableProgressive
+}
+
+// SetProgressiveLoading draws series restored or backfilled with large histories as a coarse
+// downsampled trace at once, then refines their detail over the following frames, doubling the points
+// drawn each frame until the series is drawn in full, so opening a saved dashboard never freezes the UI.
+// On by default; disabling it finishes any refinement under way
+func (w *LineChartSkn) SetProgressiveLoading(enable bool) {
+	w.debugLog("LineChartSkn::SetProgressiveLoading()")
+	w.mapsLock.Lock()
+	w.enableProgressive = enable
+	if !enable {
+		w.stopRefining()
+	}
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// IsRefining returns true while series loaded progressively are still being drawn in more detail
+func (w *LineChartSkn) IsRefining() bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.refineTimer != nil
+}
+
+// startRefining draws the series coarse from the next layout on when it holds a large history,
+// scheduling its refinement
+// caller must hold the mapsLock
+func (w *LineChartSkn) startRefining(seriesName string) {
+	if !w.enableProgressive || len(w.dataPoints[seriesName]) < progressiveMinPoints {
+		return
+	}
+	if w.refining == nil {
+		w.refining = map[string]int{}
+	}
+	w.refining[seriesName] = progressiveCoarsePoints
+	w.markDirty(seriesName, 0)
+	if w.refineTimer == nil {
+		w.refineTimer = time.AfterFunc(progressiveFrame, w.refine)
+	}
+}
+
+// stopRefining ends any refinement under way, its series drawn in full detail from the next layout
+// caller must hold the mapsLock
+func (w *LineChartSkn) stopRefining() {
+	if w.refineTimer != nil {
+		w.refineTimer.Stop()
+		w.refineTimer = nil
+	}
+	if w.refining != nil {
+		w.refining = nil
+		w.relayoutRequired = true
+	}
+}
+
+// refine doubles the points drawn of each series being refined, ending once all its points or as many
+// as downsampling draws are shown, and schedules the next frame while any remain
+func (w *LineChartSkn) refine() {
+	w.mapsLock.Lock()
+	for seriesName, budget := range w.refining {
+		budget *= 2
+		if budget >= len(w.dataPoints[seriesName]) || (w.enableDownsampling && budget >= w.downsampleBudget()) {
+			delete(w.refining, seriesName)
+		} else {
+			w.refining[seriesName] = budget
+		}
+		w.markDirty(seriesName, 0)
+	}
+	w.mapsLock.Unlock()
+	w.Refresh()
+
+	w.mapsLock.Lock()
+	w.refineTimer = nil // scheduled until the refinement is drawn
+	if len(w.refining) > 0 {
+		w.refineTimer = time.AfterFunc(progressiveFrame, w.refine)
+	}
+	w.mapsLock.Unlock()
+}
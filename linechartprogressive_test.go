@@ -0,0 +1,69 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Progressive loading", func() {
+	var (
+		lc      sknlinechart.LineChart
+		win     fyne.Window
+		history []*sknlinechart.ChartDatapoint
+	)
+
+	drawn := func() int {
+		return len(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange)))
+	}
+	load := func(options ...sknlinechart.ChartOption) {
+		options = append(options, sknlinechart.WithXLimit(5000))
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(options...))
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+		Expect(lc.ApplyDataSeries("History", history)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		history = nil
+		for i := 0; i < 5000; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(20+i%50), theme.ColorOrange, time.Now().Format(time.RFC1123))
+			history = append(history, &point)
+		}
+	})
+	AfterEach(func() {
+		Eventually(lc.IsRefining).WithTimeout(2 * time.Second).Should(BeFalse())
+		win.Close()
+	})
+
+	It("should draw a coarse trace at once and refine it over the following frames", func() {
+		load()
+		Expect(lc.IsProgressiveLoadingEnabled()).To(BeTrue())
+		Expect(lc.IsRefining()).To(BeTrue())
+
+		Eventually(lc.IsRefining).WithTimeout(2 * time.Second).Should(BeFalse())
+		Expect(drawn()).To(BeNumerically(">", 200))
+	})
+	It("should end the refinement under way once disabled", func() {
+		load()
+		Expect(lc.IsRefining()).To(BeTrue())
+		lc.SetProgressiveLoading(false)
+		Expect(lc.IsRefining()).To(BeFalse())
+	})
+	It("should draw the full detail at once when disabled", func() {
+		load(sknlinechart.WithProgressiveLoading(false))
+		Eventually(lc.IsRefining).Should(BeFalse())
+		Expect(drawn()).To(BeNumerically(">", 200))
+	})
+	It("should leave short series alone", func() {
+		history = history[:500]
+		load(sknlinechart.WithDownsampling(false))
+		Eventually(lc.IsRefining).Should(BeFalse())
+		Expect(drawn()).To(Equal(500))
+	})
+})
@@ -0,0 +1,61 @@
+package sknlinechart
+
+// RenderQuality trades drawing detail against CPU usage
+type RenderQuality int
+
+const (
+	RenderQualityHigh   RenderQuality = iota // every point, markers, and grid line drawn at full stroke
+	RenderQualityMedium                      // markers are never drawn
+	RenderQualityLow                         // no markers, every other segment, every 5th index grid line, and 1px strokes
+)
+
+const (
+	lowQualityGridStride  = 5
+	lowQualityPointStride = 2
+	lowQualityStrokeSize  = 1.0
+)
+
+// GetRenderQuality returns the active render quality, default RenderQualityHigh
+func (w *LineChartSkn) GetRenderQuality() RenderQuality {
+	return w.renderQuality
+}
+
+// SetRenderQuality selects how much detail is drawn; lower tiers suit small devices like a Raspberry Pi.
+// Fyne always antialiases lines, so RenderQualityLow thins strokes to the cheapest one pixel width instead.
+func (w *LineChartSkn) SetRenderQuality(quality RenderQuality) {
+	w.debugLog("LineChartSkn::SetRenderQuality()")
+	w.mapsLock.Lock()
+	w.renderQuality = quality
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// markersAllowed true when the quality tier and marker setting both permit markers
+func (w *LineChartSkn) markersAllowed() bool {
+	return w.enableDataPointMarkers && w.renderQuality == RenderQualityHigh
+}
+
+// pointStride the interval between drawn datapoints
+func (w *LineChartSkn) pointStride() int {
+	if w.renderQuality == RenderQualityLow {
+		return lowQualityPointStride
+	}
+	return 1
+}
+
+// gridStride the interval between drawn grid lines
+func (w *LineChartSkn) gridStride() int {
+	if w.renderQuality == RenderQualityLow {
+		return lowQualityGridStride
+	}
+	return 1
+}
+
+// seriesStrokeSize the stroke width for series lines
+func (w *LineChartSkn) seriesStrokeSize() float32 {
+	if w.renderQuality == RenderQualityLow {
+		return lowQualityStrokeSize
+	}
+	return w.dataPointStrokeSize
+}
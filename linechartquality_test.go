@@ -0,0 +1,67 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Render quality tiers", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "Quality", 20)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	// visible counts of series lines, their widest stroke, and markers
+	visible := func() (lines int, stroke float32, markers int) {
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if !o.Visible() {
+				continue
+			}
+			switch t := o.(type) {
+			case *canvas.Line:
+				if t.StrokeWidth >= 1.0 {
+					lines++
+					if t.StrokeWidth > stroke {
+						stroke = t.StrokeWidth
+					}
+				}
+			case *canvas.Circle:
+				markers++
+			}
+		}
+		return
+	}
+
+	It("should default to high quality", func() {
+		Expect(lc.GetRenderQuality()).To(Equal(sknlinechart.RenderQualityHigh))
+		lines, stroke, markers := visible()
+		Expect(lines).To(Equal(20))
+		Expect(stroke).To(Equal(lc.GetLineStrokeSize()))
+		Expect(markers).To(Equal(20))
+	})
+	It("should drop markers at medium quality", func() {
+		lc.SetRenderQuality(sknlinechart.RenderQualityMedium)
+		lines, _, markers := visible()
+		Expect(lines).To(Equal(20))
+		Expect(markers).To(BeZero())
+	})
+	It("should thin and decimate lines at low quality", func() {
+		lc.SetRenderQuality(sknlinechart.RenderQualityLow)
+		lines, stroke, markers := visible()
+		Expect(lines).To(Equal(11))
+		Expect(stroke).To(BeNumerically("==", 1.0))
+		Expect(markers).To(BeZero())
+
+		By("restoring detail when raised again")
+		lc.SetRenderQuality(sknlinechart.RenderQualityHigh)
+		lines, _, markers = visible()
+		Expect(lines).To(Equal(20))
+		Expect(markers).To(Equal(20))
+	})
+})
@@ -0,0 +1,201 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// RenderBackend how the series lines and markers are drawn
+type RenderBackend int
+
+const (
+	RenderBackendVector RenderBackend = iota // one canvas line and circle per datapoint
+	RenderBackendRaster                      // every series painted into a single image each frame
+)
+
+func (b RenderBackend) String() string {
+	switch b {
+	case RenderBackendVector:
+		return "Vector"
+	case RenderBackendRaster:
+		return "Raster"
+	}
+	return fmt.Sprint("RenderBackend(", int(b), ")")
+}
+
+// rasterStroke a series line segment painted by the raster backend, in widget coordinates
+type rasterStroke struct {
+	from, to fyne.Position
+	width    float32
+	color    color.Color
+}
+
+// rasterDot a circle marker painted by the raster backend, in widget coordinates
+type rasterDot struct {
+	center fyne.Position
+	radius float32
+	color  color.Color
+}
+
+// rasterScene everything the raster backend paints, read by the raster while painting
+type rasterScene struct {
+	strokes []rasterStroke
+	dots    []rasterDot
+}
+
+// GetRenderBackend returns how series are drawn, default RenderBackendVector
+func (w *LineChartSkn) GetRenderBackend() RenderBackend {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.renderBackend
+}
+
+// SetRenderBackend selects how series are drawn. RenderBackendRaster paints every series' lines
+// and markers into one image each frame instead of handing Fyne a canvas object per datapoint,
+// so dense or many-series charts keep the scene graph small. Hover, pins, and annotations work
+// the same with either backend
+func (w *LineChartSkn) SetRenderBackend(backend RenderBackend) error {
+	w.debugLog("LineChartSkn::SetRenderBackend() ENTER")
+	if backend != RenderBackendVector && backend != RenderBackendRaster {
+		w.debugLog("LineChartSkn::SetRenderBackend() ERROR EXIT")
+		return fmt.Errorf("SetRenderBackend() unknown backend: %v", backend)
+	}
+	w.mapsLock.Lock()
+	w.renderBackend = backend
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetRenderBackend() EXIT")
+	return nil
+}
+
+// layoutSeriesRaster collects the series lines and markers laid out for the vector backend into
+// the raster's scene, leaving their canvas objects out of the scene graph
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutSeriesRaster(size fyne.Size) {
+	if r.widget.renderBackend != RenderBackendRaster {
+		r.seriesRaster.Hide()
+		return
+	}
+	var scene rasterScene
+	addLine := func(line *canvas.Line) {
+		if line.Visible() {
+			scene.strokes = append(scene.strokes, rasterStroke{from: line.Position1, to: line.Position2, width: line.StrokeWidth, color: line.StrokeColor})
+		}
+	}
+	for key, lines := range r.dataPoints {
+		if r.widget.hiddenSeries[key] {
+			continue
+		}
+		for idx, line := range lines {
+			addLine(line)
+			if marker := r.dataPointMarkers[key][idx]; marker.Visible() {
+				scene.dots = append(scene.dots, rasterDot{
+					center: fyne.NewPos((marker.Position1.X+marker.Position2.X)/2, (marker.Position1.Y+marker.Position2.Y)/2),
+					radius: (marker.Position2.X - marker.Position1.X) / 2,
+					color:  marker.FillColor,
+				})
+			}
+		}
+	}
+	for key, dashes := range r.seriesDashes {
+		if !r.widget.hiddenSeries[key] {
+			for _, dash := range dashes {
+				addLine(dash)
+			}
+		}
+	}
+	for key, lines := range r.shapeMarkers {
+		if !r.widget.hiddenSeries[key] {
+			for _, line := range lines {
+				addLine(line)
+			}
+		}
+	}
+	r.rasterScene.Store(scene)
+	r.seriesRaster.Move(fyne.NewPos(0, 0))
+	r.seriesRaster.Resize(size)
+	r.seriesRaster.Show()
+	r.seriesRaster.Refresh()
+}
+
+// drawSeries paints the raster scene's lines and markers, antialiased, scaled to the raster's pixels
+func (r *lineChartRenderer) drawSeries(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	scene, _ := r.rasterScene.Load().(rasterScene)
+	size := r.seriesRaster.Size()
+	if w == 0 || h == 0 || size.Width <= 0 || size.Height <= 0 {
+		return img
+	}
+	scale := float32(w) / size.Width
+	for _, s := range scene.strokes {
+		paintStroke(img, s.from.X*scale, s.from.Y*scale, s.to.X*scale, s.to.Y*scale, s.width*scale/2, s.color)
+	}
+	for _, d := range scene.dots {
+		paintStroke(img, d.center.X*scale, d.center.Y*scale, d.center.X*scale, d.center.Y*scale, d.radius*scale, d.color)
+	}
+	return img
+}
+
+// paintStroke blends a round capped line of half width hw from x1,y1 to x2,y2 into the image,
+// covering edge pixels in proportion to their distance from the line; a zero length line is a dot
+func paintStroke(img *image.RGBA, x1, y1, x2, y2, hw float32, c color.Color) {
+	if hw < 0.5 {
+		hw = 0.5
+	}
+	bounds := image.Rect(
+		int(math.Floor(float64(minFloat32(x1, x2)-hw-1))), int(math.Floor(float64(minFloat32(y1, y2)-hw-1))),
+		int(math.Ceil(float64(maxFloat32(x1, x2)+hw+1))), int(math.Ceil(float64(maxFloat32(y1, y2)+hw+1))),
+	).Intersect(img.Bounds())
+	dx, dy := x2-x1, y2-y1
+	length := dx*dx + dy*dy
+	for py := bounds.Min.Y; py < bounds.Max.Y; py++ {
+		for px := bounds.Min.X; px < bounds.Max.X; px++ {
+			cx, cy := float32(px)+0.5, float32(py)+0.5
+			t := float32(0)
+			if length > 0 {
+				t = ((cx-x1)*dx + (cy-y1)*dy) / length
+				t = maxFloat32(0, minFloat32(1, t))
+			}
+			ex, ey := cx-(x1+t*dx), cy-(y1+t*dy)
+			coverage := hw + 0.5 - float32(math.Sqrt(float64(ex*ex+ey*ey)))
+			if coverage > 0 {
+				blendPixel(img, px, py, c, minFloat32(1, coverage))
+			}
+		}
+	}
+}
+
+// blendPixel draws the color over the pixel at the coverage, 0-1
+func blendPixel(img *image.RGBA, x, y int, c color.Color, coverage float32) {
+	sr, sg, sb, sa := c.RGBA()
+	a := float32(sa) / 0xffff * coverage
+	if a <= 0 {
+		return
+	}
+	i := img.PixOffset(x, y)
+	pix := img.Pix[i : i+4 : i+4]
+	pix[0] = uint8(float32(sr>>8)*coverage + float32(pix[0])*(1-a))
+	pix[1] = uint8(float32(sg>>8)*coverage + float32(pix[1])*(1-a))
+	pix[2] = uint8(float32(sb>>8)*coverage + float32(pix[2])*(1-a))
+	pix[3] = uint8(float32(sa>>8)*coverage + float32(pix[3])*(1-a))
+}
+
+func minFloat32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxFloat32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,95 @@
+package sknlinechart_test
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Raster render backend", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	// seriesRaster returns the visible raster painting the series, nil when none is shown
+	seriesRaster := func() *canvas.Raster {
+		var found *canvas.Raster
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if raster, ok := o.(*canvas.Raster); ok && raster.Visible() && raster.Position().IsZero() {
+				found = raster
+			}
+		}
+		return found
+	}
+	// painted counts the pixels of the image painted in the color
+	painted := func(img image.Image, c color.Color) int {
+		want := color.NRGBAModel.Convert(c).(color.NRGBA)
+		count := 0
+		bounds := img.Bounds()
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				got := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+				if got == want {
+					count++
+				}
+			}
+		}
+		return count
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		var points []*sknlinechart.ChartDatapoint
+		for i := 0; i < 100; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(20+i%30), theme.ColorOrange, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		Expect(lc.ApplyDataSeries("Dense", points)).To(Succeed())
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should paint the series into one image instead of a canvas object per point", func() {
+		vectorObjects := len(test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects())
+		Expect(seriesRaster()).To(BeNil())
+
+		Expect(lc.SetRenderBackend(sknlinechart.RenderBackendRaster)).To(Succeed())
+		Expect(lc.GetRenderBackend()).To(Equal(sknlinechart.RenderBackendRaster))
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange))).To(BeEmpty())
+		Expect(len(test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects())).To(BeNumerically("<", vectorObjects-150))
+
+		raster := seriesRaster()
+		Expect(raster).NotTo(BeNil())
+		img := raster.Generator(int(raster.Size().Width), int(raster.Size().Height))
+		Expect(painted(img, theme.PrimaryColorNamed(theme.ColorOrange))).To(BeNumerically(">", 500))
+	})
+	It("should return to canvas objects on the vector backend", func() {
+		Expect(lc.SetRenderBackend(sknlinechart.RenderBackendRaster)).To(Succeed())
+		Expect(lc.SetRenderBackend(sknlinechart.RenderBackendVector)).To(Succeed())
+		Expect(seriesRaster()).To(BeNil())
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange))).To(HaveLen(100))
+	})
+	It("should leave hidden series out of the image", func() {
+		Expect(lc.SetRenderBackend(sknlinechart.RenderBackendRaster)).To(Succeed())
+		Expect(lc.HideSeries("Dense")).To(Succeed())
+		raster := seriesRaster()
+		img := raster.Generator(int(raster.Size().Width), int(raster.Size().Height))
+		Expect(painted(img, theme.PrimaryColorNamed(theme.ColorOrange))).To(BeZero())
+	})
+	It("should reject unknown backends", func() {
+		Expect(lc.SetRenderBackend(sknlinechart.RenderBackend(7))).To(HaveOccurred())
+		Expect(lc.GetRenderBackend()).To(Equal(sknlinechart.RenderBackendVector))
+	})
+})
@@ -0,0 +1,174 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ratioSeries a series derived as the ratio of two others
+type ratioSeries struct {
+	numerator   string
+	denominator string
+}
+
+// derivedSample one derived value before it is stored in its series
+type derivedSample struct {
+	value     float32
+	missing   bool
+	colorName string
+	timestamp string
+}
+
+// AddRatioSeries adds a series holding the ratio of two other series, such as errors over requests,
+// recomputed whenever either changes. Points are paired by timestamp: each numerator point with a
+// denominator point of the same time yields one ratio point, missing where the denominator is zero or
+// either point is missing. The inputs may be added later; ratio points take the numerator's color
+// name until the series is given its own with SetSeriesColor
+func (w *LineChartSkn) AddRatioSeries(name, numeratorSeries, denominatorSeries string) error {
+	w.debugLog("LineChartSkn::AddRatioSeries() ENTER")
+	w.mapsLock.Lock()
+	var err error
+	if name == "" || name == numeratorSeries || name == denominatorSeries {
+		err = fmt.Errorf("AddRatioSeries() ratio series name unavailable: %q", name)
+	} else if _, ok := w.ratios[numeratorSeries]; ok {
+		err = fmt.Errorf("AddRatioSeries() numerator is itself a ratio series: %s", numeratorSeries)
+	} else if _, ok := w.ratios[denominatorSeries]; ok {
+		err = fmt.Errorf("AddRatioSeries() denominator is itself a ratio series: %s", denominatorSeries)
+	} else if points, ok := w.dataPoints[name]; ok && len(points) > 0 && w.ratios[name] == (ratioSeries{}) {
+		err = fmt.Errorf("AddRatioSeries() series already holds data: %s", name)
+	}
+	if err != nil {
+		w.mapsLock.Unlock()
+		w.debugLog("LineChartSkn::AddRatioSeries() ERROR EXIT")
+		return err
+	}
+	if w.ratios == nil {
+		w.ratios = map[string]ratioSeries{}
+	}
+	w.ratios[name] = ratioSeries{numerator: numeratorSeries, denominator: denominatorSeries}
+	w.updateRatios()
+	w.dataSeriesAdded = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::AddRatioSeries() EXIT")
+	return nil
+}
+
+// RemoveRatioSeries stops deriving the ratio series and deletes it, false when it is not a ratio series
+func (w *LineChartSkn) RemoveRatioSeries(name string) bool {
+	w.debugLog("LineChartSkn::RemoveRatioSeries()")
+	w.mapsLock.Lock()
+	_, ok := w.ratios[name]
+	if ok {
+		w.forgetSeries(name)
+		w.relayoutRequired = true
+	}
+	w.mapsLock.Unlock()
+	if ok {
+		w.Refresh()
+	}
+	return ok
+}
+
+// GetRatioSeries returns the numerator and denominator series of a ratio series, false when it is not one
+func (w *LineChartSkn) GetRatioSeries(name string) (string, string, bool) {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	ratio, ok := w.ratios[name]
+	return ratio.numerator, ratio.denominator, ok
+}
+
+// updateRatios recomputes every ratio series from its inputs
+// caller must hold the mapsLock
+func (w *LineChartSkn) updateRatios() {
+	names := make([]string, 0, len(w.ratios))
+	for name := range w.ratios {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w.storeSamples(name, w.ratioSamples(w.ratios[name]))
+	}
+}
+
+// storeSamples writes a derived series' samples into its points, updating them in place and
+// marking the series dirty from the first which changed
+// caller must hold the mapsLock
+func (w *LineChartSkn) storeSamples(name string, samples []derivedSample) {
+	points := w.dataPoints[name]
+	first := len(samples) // the first point changed, none when left at the end
+	if points == nil || len(points) > len(samples) {
+		first = 0
+	}
+	for idx, sample := range samples {
+		if idx == len(points) {
+			point := NewChartDatapoint(sample.value, sample.colorName, sample.timestamp)
+			point.SetMissing(sample.missing)
+			points = append(points, &point)
+			if idx < first {
+				first = idx
+			}
+			continue
+		}
+		point := *points[idx]
+		if point.Value() == sample.value && point.IsMissing() == sample.missing &&
+			point.Timestamp() == sample.timestamp && point.ColorName() == sample.colorName {
+			continue
+		}
+		point.SetValue(sample.value)
+		point.SetMissing(sample.missing)
+		point.SetTimestamp(sample.timestamp)
+		point.SetColorName(sample.colorName)
+		if idx < first {
+			first = idx
+		}
+	}
+	if first == len(samples) && len(points) == len(samples) {
+		return
+	}
+	w.dataPoints[name] = points[:len(samples)]
+	w.touchSeries(name)
+	w.markDirty(name, first)
+}
+
+// ratioSamples pairs the numerator's points with the denominator's of the same time, the n-th
+// numerator point of a time with the n-th denominator point of it when several share a timestamp
+// caller must hold the mapsLock
+func (w *LineChartSkn) ratioSamples(ratio ratioSeries) []derivedSample {
+	denominators := map[ratioKey][]ChartDatapoint{}
+	for _, point := range w.dataPoints[ratio.denominator] {
+		key := newRatioKey(*point)
+		denominators[key] = append(denominators[key], *point)
+	}
+	samples := []derivedSample{}
+	for _, point := range w.dataPoints[ratio.numerator] {
+		key := newRatioKey(*point)
+		if len(denominators[key]) == 0 {
+			continue
+		}
+		denominator := denominators[key][0]
+		denominators[key] = denominators[key][1:]
+		sample := derivedSample{colorName: (*point).ColorName(), timestamp: (*point).Timestamp()}
+		if (*point).IsMissing() || denominator.IsMissing() || denominator.Value() == 0 {
+			sample.missing = true
+		} else {
+			sample.value = (*point).Value() / denominator.Value()
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+// ratioKey matches timestamps by the time they denote when readable, otherwise by their text
+type ratioKey struct {
+	nanos int64
+	text  string
+}
+
+// newRatioKey returns the point's key for pairing by timestamp
+func newRatioKey(point ChartDatapoint) ratioKey {
+	if ts, ok := point.Time(); ok {
+		return ratioKey{nanos: ts.UnixNano()}
+	}
+	return ratioKey{text: point.Timestamp()}
+}
@@ -0,0 +1,88 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Ratio series", func() {
+	var (
+		lc    sknlinechart.LineChart
+		start = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	at := func(minute int) string {
+		return start.Add(time.Duration(minute) * time.Minute).Format(time.RFC1123)
+	}
+	apply := func(series string, minute int, value float32) {
+		point := sknlinechart.NewChartDatapoint(value, theme.ColorBlue, at(minute))
+		lc.ApplyDataPoint(series, &point)
+	}
+	values := func(series string) []any {
+		var out []any
+		for _, point := range lc.GetDataSeries(series) {
+			if point.IsMissing() {
+				out = append(out, "missing")
+				continue
+			}
+			out = append(out, point.Value())
+		}
+		return out
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithRefreshInterval(0))) // redraw on every point
+		lc.Resize(fyne.NewSize(800, 400))
+		for minute, value := range []float32{100, 200, 0} {
+			apply("Requests", minute, value)
+		}
+		for minute, value := range []float32{5, 50, 1, 7} {
+			apply("Errors", minute, value)
+		}
+	})
+
+	It("should pair the inputs by timestamp and follow their updates", func() {
+		Expect(lc.AddRatioSeries("Error rate", "Errors", "Requests")).To(Succeed())
+		numerator, denominator, ok := lc.GetRatioSeries("Error rate")
+		Expect(ok).To(BeTrue())
+		Expect([]string{numerator, denominator}).To(Equal([]string{"Errors", "Requests"}))
+		Expect(values("Error rate")).To(Equal([]any{float32(0.05), float32(0.25), "missing"}))
+		Expect(lc.GetDataSeries("Error rate")[1].Timestamp()).To(Equal(at(1)))
+
+		apply("Requests", 3, 70)
+		Expect(values("Error rate")).To(Equal([]any{float32(0.05), float32(0.25), "missing", float32(0.1)}))
+		Expect(lc.UpdateDataPoint("Errors", 0, 20)).To(Succeed())
+		Expect(values("Error rate")[0]).To(Equal(float32(0.2)))
+	})
+	It("should follow renamed inputs and stop when removed", func() {
+		Expect(lc.AddRatioSeries("Error rate", "Errors", "Requests")).To(Succeed())
+		Expect(lc.RenameSeries("Requests", "Calls")).To(Succeed())
+		_, denominator, _ := lc.GetRatioSeries("Error rate")
+		Expect(denominator).To(Equal("Calls"))
+		apply("Calls", 3, 14)
+		Expect(values("Error rate")).To(HaveLen(4))
+
+		Expect(lc.RemoveRatioSeries("Error rate")).To(BeTrue())
+		Expect(lc.RemoveRatioSeries("Error rate")).To(BeFalse())
+		Expect(lc.GetSeriesNames()).NotTo(ContainElement("Error rate"))
+	})
+	It("should reject unusable names and keep ratios in saved state", func() {
+		Expect(lc.AddRatioSeries("Errors", "Errors", "Requests")).To(HaveOccurred())
+		Expect(lc.AddRatioSeries("Requests", "Errors", "Calls")).To(HaveOccurred())
+		Expect(lc.AddRatioSeries("Error rate", "Errors", "Requests")).To(Succeed())
+		Expect(lc.AddRatioSeries("Nested", "Error rate", "Requests")).To(HaveOccurred())
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, ok := restored.GetRatioSeries("Error rate")
+		Expect(ok).To(BeTrue())
+	})
+})
@@ -0,0 +1,402 @@
+package sknlinechart
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	recordingMagic     = "SKNREC1\n" // first bytes of every recording file
+	recordingExtension = "rec"
+	recordingMaxString = 1 << 16 // longest string read back, a longer length means the file is corrupt
+)
+
+// kinds of record in a recording file
+const (
+	recordString  byte = 1 // adds a string to the file's string table
+	recordPoint   byte = 2 // one ingested datapoint
+	recordReplace byte = 3 // the series' points were replaced, cleared before the points following
+)
+
+// flags of a point record, telling which optional fields follow
+const (
+	recordMissing byte = 1 << iota
+	recordColor
+	recordBounds
+	recordXValue
+)
+
+// RecordedPoint one entry read back from a recording. Replace entries carry no point: the series
+// was replaced at that time and the points following belong to its new data
+type RecordedPoint struct {
+	Series  string
+	At      time.Time // when the chart ingested the point
+	Point   ChartDatapoint
+	Replace bool
+}
+
+// chartRecorder appends every ingested point to rotating recording files in dir
+type chartRecorder struct {
+	dir      string
+	maxBytes int64
+	maxFiles int
+	file     *os.File
+	out      *bufio.Writer
+	written  int64
+	started  int // files started, keeping names unique when rotating quickly
+	strings  map[string]uint64
+	lastAt   int64
+}
+
+// EnableRecorder starts appending every datapoint the chart ingests to compact binary recording
+// files in dir, a flight recorder for telemetry sessions. A new file is started once the current
+// one reaches maxFileBytes, and only the newest maxFiles are kept. Replaces any recorder already running.
+// Recordings are read back with NewRecordingReader, or played into a chart by the demo's replay command
+func (w *LineChartSkn) EnableRecorder(dir string, maxFileBytes int64, maxFiles int) error {
+	w.debugLog("LineChartSkn::EnableRecorder() ENTER")
+	if maxFileBytes <= 0 || maxFiles < 1 {
+		w.debugLog("LineChartSkn::EnableRecorder() ERROR EXIT")
+		return fmt.Errorf("EnableRecorder() file size and count must be positive: %d bytes, %d files", maxFileBytes, maxFiles)
+	}
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		w.debugLog("LineChartSkn::EnableRecorder() ERROR EXIT")
+		return fmt.Errorf("EnableRecorder() directory unusable: %w", err)
+	}
+	_ = w.DisableRecorder()
+
+	w.recorderLock.Lock()
+	w.recorder = &chartRecorder{dir: dir, maxBytes: maxFileBytes, maxFiles: maxFiles}
+	w.recorderLock.Unlock()
+	w.debugLog("LineChartSkn::EnableRecorder() EXIT")
+	return nil
+}
+
+// DisableRecorder stops recording and closes the current file, files already written are kept
+func (w *LineChartSkn) DisableRecorder() error {
+	w.debugLog("LineChartSkn::DisableRecorder()")
+	w.recorderLock.Lock()
+	defer w.recorderLock.Unlock()
+	if w.recorder == nil {
+		return nil
+	}
+	err := w.recorder.close()
+	w.recorder = nil
+	return err
+}
+
+// IsRecorderEnabled returns true while ingested points are being recorded
+func (w *LineChartSkn) IsRecorderEnabled() bool {
+	w.recorderLock.Lock()
+	defer w.recorderLock.Unlock()
+	return w.recorder != nil
+}
+
+// record appends the ingested points to the recording, marking the series replaced first when asked;
+// a failure is logged and recording carries on with the next points
+func (w *LineChartSkn) record(seriesName string, replace bool, points []*ChartDatapoint) {
+	w.recorderLock.Lock()
+	defer w.recorderLock.Unlock()
+	if w.recorder == nil {
+		return
+	}
+	err := w.recorder.write(seriesName, replace, points)
+	if err != nil {
+		slog.Warn("chart recorder failed", "dir", w.recorder.dir, "error", err.Error())
+	}
+}
+
+// write appends one batch of records, flushed so a crash loses nothing already ingested
+func (r *chartRecorder) write(seriesName string, replace bool, points []*ChartDatapoint) error {
+	if r.file == nil || r.written >= r.maxBytes {
+		if err := r.rotate(); err != nil {
+			return err
+		}
+	}
+	series := r.intern(seriesName)
+	at := time.Now().UnixMilli()
+	if replace {
+		r.putByte(recordReplace)
+		r.putUvarint(series)
+		r.putVarint(at - r.lastAt)
+		r.lastAt = at
+	}
+	for _, point := range points {
+		p := *point
+		colorName := r.intern(p.ColorName())
+		var flags byte
+		if p.IsMissing() {
+			flags |= recordMissing
+		}
+		if p.Color() != nil {
+			flags |= recordColor
+		}
+		lower, upper, bounded := p.Bounds()
+		if bounded {
+			flags |= recordBounds
+		}
+		x, hasX := p.XValue()
+		if hasX {
+			flags |= recordXValue
+		}
+
+		r.putByte(recordPoint)
+		r.putUvarint(series)
+		r.putUvarint(colorName)
+		r.putVarint(at - r.lastAt)
+		r.lastAt = at
+		r.putFloat(p.Value())
+		r.putByte(flags)
+		if p.Color() != nil {
+			n := color.NRGBAModel.Convert(p.Color()).(color.NRGBA)
+			r.put([]byte{n.R, n.G, n.B, n.A})
+		}
+		if bounded {
+			r.putFloat(lower)
+			r.putFloat(upper)
+		}
+		if hasX {
+			r.putFloat(x)
+		}
+		r.putUvarint(uint64(len(p.Timestamp())))
+		r.put([]byte(p.Timestamp()))
+	}
+	return r.out.Flush()
+}
+
+// rotate closes the current file and starts the next, removing the oldest beyond maxFiles.
+// Each file carries its own string table so it can be replayed alone
+func (r *chartRecorder) rotate() error {
+	if err := r.close(); err != nil {
+		return err
+	}
+	name := filepath.Join(r.dir,
+		fmt.Sprintf("%s%s-%04d.%s", autoSnapshotPrefix, time.Now().Format("20060102-150405.000000"), r.started%10000, recordingExtension))
+	r.started++
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	r.file, r.out, r.written = file, bufio.NewWriter(file), 0
+	r.strings, r.lastAt = map[string]uint64{}, 0
+	r.put([]byte(recordingMagic))
+	return rotateSnapshots(r.dir, recordingExtension, r.maxFiles)
+}
+
+// close flushes and closes the current file
+func (r *chartRecorder) close() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.out.Flush()
+	if cerr := r.file.Close(); err == nil {
+		err = cerr
+	}
+	r.file, r.out = nil, nil
+	return err
+}
+
+// intern returns the id of the string in the file's string table, adding it when new
+func (r *chartRecorder) intern(s string) uint64 {
+	id, ok := r.strings[s]
+	if !ok {
+		id = uint64(len(r.strings))
+		r.strings[s] = id
+		r.putByte(recordString)
+		r.putUvarint(uint64(len(s)))
+		r.put([]byte(s))
+	}
+	return id
+}
+
+func (r *chartRecorder) put(b []byte) {
+	n, _ := r.out.Write(b) // errors surface from the batch's Flush
+	r.written += int64(n)
+}
+
+func (r *chartRecorder) putByte(b byte) {
+	r.put([]byte{b})
+}
+
+func (r *chartRecorder) putUvarint(v uint64) {
+	r.put(binary.AppendUvarint(nil, v))
+}
+
+func (r *chartRecorder) putVarint(v int64) {
+	r.put(binary.AppendVarint(nil, v))
+}
+
+func (r *chartRecorder) putFloat(v float32) {
+	r.put(binary.LittleEndian.AppendUint32(nil, math.Float32bits(v)))
+}
+
+// RecordingReader reads back the points of one recording file, as written by EnableRecorder
+type RecordingReader struct {
+	in      *bufio.Reader
+	strings []string
+	at      int64
+}
+
+// NewRecordingReader checks the recording's header and returns a reader of its points
+func NewRecordingReader(in io.Reader) (*RecordingReader, error) {
+	r := &RecordingReader{in: bufio.NewReader(in)}
+	magic := make([]byte, len(recordingMagic))
+	if _, err := io.ReadFull(r.in, magic); err != nil || string(magic) != recordingMagic {
+		return nil, errors.New("NewRecordingReader() not a chart recording")
+	}
+	return r, nil
+}
+
+// Next returns the next recorded point, io.EOF once the recording is exhausted. A recording cut
+// short by a crash ends with io.ErrUnexpectedEOF after its last complete point
+func (r *RecordingReader) Next() (RecordedPoint, error) {
+	for {
+		kind, err := r.in.ReadByte()
+		if err != nil {
+			return RecordedPoint{}, err
+		}
+		switch kind {
+		case recordString:
+			s, err := r.readString()
+			if err != nil {
+				return RecordedPoint{}, err
+			}
+			r.strings = append(r.strings, s)
+		case recordReplace:
+			series, err := r.readStringRef()
+			if err != nil {
+				return RecordedPoint{}, err
+			}
+			at, err := r.readAt()
+			if err != nil {
+				return RecordedPoint{}, err
+			}
+			return RecordedPoint{Series: series, At: at, Replace: true}, nil
+		case recordPoint:
+			return r.readPoint()
+		default:
+			return RecordedPoint{}, fmt.Errorf("Next() unknown record kind: %d", kind)
+		}
+	}
+}
+
+// readPoint reads the body of a point record
+func (r *RecordingReader) readPoint() (RecordedPoint, error) {
+	series, err := r.readStringRef()
+	if err != nil {
+		return RecordedPoint{}, err
+	}
+	colorName, err := r.readStringRef()
+	if err != nil {
+		return RecordedPoint{}, err
+	}
+	at, err := r.readAt()
+	if err != nil {
+		return RecordedPoint{}, err
+	}
+	value, err := r.readFloat()
+	if err != nil {
+		return RecordedPoint{}, err
+	}
+	flags, err := r.in.ReadByte()
+	if err != nil {
+		return RecordedPoint{}, unexpected(err)
+	}
+	point := NewChartDatapoint(value, colorName, "")
+	point.SetMissing(flags&recordMissing != 0)
+	if flags&recordColor != 0 {
+		rgba := make([]byte, 4)
+		if _, err := io.ReadFull(r.in, rgba); err != nil {
+			return RecordedPoint{}, unexpected(err)
+		}
+		point.SetColor(color.NRGBA{R: rgba[0], G: rgba[1], B: rgba[2], A: rgba[3]})
+	}
+	if flags&recordBounds != 0 {
+		lower, err := r.readFloat()
+		if err != nil {
+			return RecordedPoint{}, err
+		}
+		upper, err := r.readFloat()
+		if err != nil {
+			return RecordedPoint{}, err
+		}
+		point.SetBounds(lower, upper)
+	}
+	if flags&recordXValue != 0 {
+		x, err := r.readFloat()
+		if err != nil {
+			return RecordedPoint{}, err
+		}
+		point.SetXValue(x)
+	}
+	timestamp, err := r.readString()
+	if err != nil {
+		return RecordedPoint{}, err
+	}
+	point.SetTimestamp(timestamp)
+	return RecordedPoint{Series: series, At: at, Point: point}, nil
+}
+
+// readString reads a length prefixed string
+func (r *RecordingReader) readString() (string, error) {
+	length, err := binary.ReadUvarint(r.in)
+	if err != nil {
+		return "", unexpected(err)
+	}
+	if length > recordingMaxString {
+		return "", fmt.Errorf("Next() string length %d exceeds %d", length, recordingMaxString)
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r.in, b); err != nil {
+		return "", unexpected(err)
+	}
+	return string(b), nil
+}
+
+// readStringRef reads the id of a string already in the table
+func (r *RecordingReader) readStringRef() (string, error) {
+	id, err := binary.ReadUvarint(r.in)
+	if err != nil {
+		return "", unexpected(err)
+	}
+	if id >= uint64(len(r.strings)) {
+		return "", fmt.Errorf("Next() undefined string reference: %d", id)
+	}
+	return r.strings[id], nil
+}
+
+// readAt reads a time stored as milliseconds since the previous record's
+func (r *RecordingReader) readAt() (time.Time, error) {
+	delta, err := binary.ReadVarint(r.in)
+	if err != nil {
+		return time.Time{}, unexpected(err)
+	}
+	r.at += delta
+	return time.UnixMilli(r.at), nil
+}
+
+// readFloat reads a little endian float32
+func (r *RecordingReader) readFloat() (float32, error) {
+	b := make([]byte, 4)
+	if _, err := io.ReadFull(r.in, b); err != nil {
+		return 0, unexpected(err)
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+}
+
+// unexpected reports an end of file inside a record as truncation
+func unexpected(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
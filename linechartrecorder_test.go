@@ -0,0 +1,110 @@
+package sknlinechart_test
+
+import (
+	"errors"
+	"image/color"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Recording ingested points", func() {
+	var (
+		lc  sknlinechart.LineChart
+		dir string
+	)
+
+	// readAll returns every entry of the recording files, oldest file first
+	readAll := func() []sknlinechart.RecordedPoint {
+		files, _ := filepath.Glob(filepath.Join(dir, "*.rec"))
+		var entries []sknlinechart.RecordedPoint
+		for _, name := range files {
+			in, err := os.Open(name)
+			Expect(err).NotTo(HaveOccurred())
+			reader, err := sknlinechart.NewRecordingReader(in)
+			Expect(err).NotTo(HaveOccurred())
+			for {
+				entry, err := reader.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				Expect(err).NotTo(HaveOccurred())
+				entries = append(entries, entry)
+			}
+			in.Close()
+		}
+		return entries
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+	})
+	AfterEach(func() {
+		Expect(lc.DisableRecorder()).To(Succeed())
+		stopRedraws(lc)
+	})
+
+	It("should record every ingested point for replay", func() {
+		Expect(lc.IsRecorderEnabled()).To(BeFalse())
+		Expect(lc.EnableRecorder(dir, 1<<20, 4)).To(Succeed())
+		Expect(lc.IsRecorderEnabled()).To(BeTrue())
+
+		stamp := time.Now().Format(time.RFC1123)
+		first := sknlinechart.NewChartDatapoint(21.5, theme.ColorRed, stamp)
+		lc.ApplyDataPoint("Temperature", &first)
+		bounded := sknlinechart.NewChartDatapointWithBounds(22, 20, 24, theme.ColorRed, stamp)
+		bounded.SetColor(color.NRGBA{R: 1, G: 2, B: 3, A: 4})
+		lc.ApplyDataPoints("Temperature", []sknlinechart.ChartDatapoint{bounded})
+		Expect(lc.ApplyDataSeries("Humidity", []*sknlinechart.ChartDatapoint{&first})).To(Succeed())
+		Expect(lc.DisableRecorder()).To(Succeed())
+
+		entries := readAll()
+		Expect(entries).To(HaveLen(4))
+		Expect(entries[0].Series).To(Equal("Temperature"))
+		Expect(entries[0].Point.Value()).To(BeNumerically("==", 21.5))
+		Expect(entries[0].Point.ColorName()).To(Equal(theme.ColorRed))
+		Expect(entries[0].Point.Timestamp()).To(Equal(stamp))
+		Expect(time.Since(entries[0].At)).To(BeNumerically("<", time.Minute))
+
+		lower, upper, ok := entries[1].Point.Bounds()
+		Expect([]interface{}{lower, upper, ok}).To(Equal([]interface{}{float32(20), float32(24), true}))
+		Expect(entries[1].Point.Color()).To(Equal(color.NRGBA{R: 1, G: 2, B: 3, A: 4}))
+
+		Expect(entries[2].Replace).To(BeTrue())
+		Expect(entries[2].Series).To(Equal("Humidity"))
+		Expect(entries[3].Point.Value()).To(BeNumerically("==", 21.5))
+	})
+	It("should rotate files at the size limit keeping the newest", func() {
+		Expect(lc.EnableRecorder(dir, 64, 3)).To(Succeed())
+		for i := 0; i < 40; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(i), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("Load", &point)
+		}
+		Expect(lc.DisableRecorder()).To(Succeed())
+
+		files, _ := filepath.Glob(filepath.Join(dir, "*.rec"))
+		Expect(files).To(HaveLen(3))
+		entries := readAll()
+		Expect(entries[len(entries)-1].Point.Value()).To(BeNumerically("==", 39))
+		Expect(entries[0].Point.Value()).To(BeNumerically(">", 0))
+	})
+	It("should reject bad limits and files that are not recordings", func() {
+		Expect(lc.EnableRecorder(dir, 0, 1)).To(HaveOccurred())
+		Expect(lc.EnableRecorder(dir, 1024, 0)).To(HaveOccurred())
+		_, err := sknlinechart.NewRecordingReader(strings.NewReader("index,timestamp,Load\n"))
+		Expect(err).To(HaveOccurred())
+
+		reader, err := sknlinechart.NewRecordingReader(strings.NewReader("SKNREC1\n\x01\xff\xff\xff\xff\x0f"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = reader.Next()
+		Expect(err).To(MatchError(ContainSubstring("string length")))
+	})
+})
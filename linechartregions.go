@@ -0,0 +1,159 @@
+package sknlinechart
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// RegionAxis the axis a reference region's range lies on
+type RegionAxis int
+
+const (
+	RegionY RegionAxis = iota // a band of values across the plot's width, like a comfort zone
+	RegionX                   // a span of the x axis over the plot's height, like a maintenance window
+)
+
+// String returns the axis' name
+func (a RegionAxis) String() string {
+	switch a {
+	case RegionY:
+		return "y"
+	case RegionX:
+		return "x"
+	}
+	return fmt.Sprintf("RegionAxis(%d)", int(a))
+}
+
+// ReferenceRegion shaded band behind the data. X ranges are datapoint indexes, or values when the
+// chart has a numeric x axis
+type ReferenceRegion struct {
+	Name  string
+	Axis  RegionAxis
+	From  float32
+	To    float32
+	Color color.Color // nil for a faint shade of the foreground color
+}
+
+// validate checks the region has a name, a known axis and a range
+func (g ReferenceRegion) validate() error {
+	if g.Name == "" {
+		return errors.New("reference region needs a name")
+	}
+	if g.Axis != RegionY && g.Axis != RegionX {
+		return fmt.Errorf("reference region [%s] unknown axis: %v", g.Name, g.Axis)
+	}
+	if !isFinite(g.From) || !isFinite(g.To) || g.To <= g.From {
+		return fmt.Errorf("reference region [%s] to must be greater than from: %v-%v", g.Name, g.From, g.To)
+	}
+	return nil
+}
+
+// AddReferenceRegion shades the range from-to of an axis behind the data series, such as a comfort
+// zone of 18-24 °C on the y axis or a maintenance window on the x axis. fillColor is drawn as given so its
+// alpha sets the shade's strength; nil draws a faint shade of the foreground. Adding a name already in
+// use updates that region
+func (w *LineChartSkn) AddReferenceRegion(name string, axis RegionAxis, from, to float32, fillColor color.Color) error {
+	w.debugLog("LineChartSkn::AddReferenceRegion() ENTER")
+	region := ReferenceRegion{Name: name, Axis: axis, From: from, To: to, Color: fillColor}
+	if err := region.validate(); err != nil {
+		w.debugLog("LineChartSkn::AddReferenceRegion() ERROR EXIT")
+		return fmt.Errorf("AddReferenceRegion() %w", err)
+	}
+	w.mapsLock.Lock()
+	w.setReferenceRegion(region)
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::AddReferenceRegion() EXIT")
+	return nil
+}
+
+// RemoveReferenceRegion removes the named region, false when there is none
+func (w *LineChartSkn) RemoveReferenceRegion(name string) bool {
+	w.debugLog("LineChartSkn::RemoveReferenceRegion()")
+	w.mapsLock.Lock()
+	removed := false
+	regions := w.regions[:0]
+	for _, region := range w.regions {
+		if region.Name == name {
+			removed = true
+			continue
+		}
+		regions = append(regions, region)
+	}
+	w.regions = regions
+	w.mapsLock.Unlock()
+	if removed {
+		w.Refresh()
+	}
+	return removed
+}
+
+// GetReferenceRegions returns the reference regions in the order added
+func (w *LineChartSkn) GetReferenceRegions() []ReferenceRegion {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return append([]ReferenceRegion(nil), w.regions...)
+}
+
+// setReferenceRegion replaces the region of the same name or appends it
+// caller must hold the mapsLock
+func (w *LineChartSkn) setReferenceRegion(region ReferenceRegion) {
+	for i := range w.regions {
+		if w.regions[i].Name == region.Name {
+			w.regions[i] = region
+			return
+		}
+	}
+	w.regions = append(w.regions, region)
+}
+
+// layoutRegions shades each reference region's part of the plot area, hiding those outside the viewport
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutRegions() {
+	vp := r.widget.currentViewport()
+	used := 0
+	for _, region := range r.widget.regions {
+		left, top := r.widget.plotMin.X, r.widget.plotMin.Y
+		right, bottom := r.widget.plotMax.X, r.widget.plotMax.Y
+		if region.Axis == RegionY {
+			if region.To < vp.YMin || region.From > vp.YMax {
+				continue
+			}
+			from, to := r.widget.dataToPosition(vp.XMin, region.From).Y, r.widget.dataToPosition(vp.XMin, region.To).Y
+			top, bottom = fyne.Min(from, to), fyne.Max(from, to)
+		} else {
+			from, to := region.From, region.To
+			if r.widget.xAxis != nil {
+				from, to = r.widget.xAxisIndex(from), r.widget.xAxisIndex(to)
+			}
+			left = fyne.Max(left, r.widget.dataToPosition(from, 0).X)
+			right = fyne.Min(right, r.widget.dataToPosition(to, 0).X)
+		}
+		if right <= left || bottom <= top {
+			continue
+		}
+		c := region.Color
+		if c == nil {
+			c = fadeColor(theme.ForegroundColor(), timeBandOpacity)
+		}
+		c = r.widget.cvdColor(c)
+		if used == len(r.regionRects) {
+			r.regionRects = append(r.regionRects, canvas.NewRectangle(c))
+		}
+		rect := r.regionRects[used]
+		rect.FillColor = c
+		rect.Move(fyne.NewPos(left, top))
+		rect.Resize(fyne.NewSize(right-left, bottom-top))
+		rect.Show()
+		rect.Refresh()
+		used++
+	}
+	for idx := used; idx < len(r.regionRects); idx++ {
+		r.regionRects[idx].Hide()
+	}
+}
@@ -0,0 +1,113 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Reference regions", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+		green  = color.NRGBA{G: 0xc0, A: 0x30}
+	)
+
+	// regionRects returns the visible rectangles filled with the color
+	regionRects := func(c color.Color) []*canvas.Rectangle {
+		var found []*canvas.Rectangle
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if rect, ok := o.(*canvas.Rectangle); ok && rect.Visible() && rect.FillColor == c {
+				found = append(found, rect)
+			}
+		}
+		return found
+	}
+	// markerY returns the vertical center of the point's marker
+	markerY := func(point *sknlinechart.ChartDatapoint) float32 {
+		top, bottom := (*point).MarkerPosition()
+		return (top.Y + bottom.Y) / 2
+	}
+
+	BeforeEach(func() {
+		points = nil
+		for _, value := range []float32{10, 18, 21, 24, 30, 22, 20, 19, 25, 16} {
+			point := sknlinechart.NewChartDatapoint(value, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Temperature": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should shade a value band between its values across the plot area", func() {
+		Expect(lc.AddReferenceRegion("comfort", sknlinechart.RegionY, 18, 24, green)).To(Succeed())
+		rects := regionRects(green)
+		Expect(rects).To(HaveLen(1))
+		Expect(rects[0].Position().Y).To(BeNumerically("~", markerY(points[3]), 1))
+		Expect(rects[0].Position().Y + rects[0].Size().Height).To(BeNumerically("~", markerY(points[1]), 1))
+		Expect(rects[0].Size().Width).To(BeNumerically(">", 600))
+
+		Expect(lc.RemoveReferenceRegion("comfort")).To(BeTrue())
+		Expect(lc.RemoveReferenceRegion("comfort")).To(BeFalse())
+		Expect(regionRects(green)).To(BeEmpty())
+	})
+	It("should shade an x range over the plot's height and update it by name", func() {
+		Expect(lc.AddReferenceRegion("maintenance", sknlinechart.RegionX, 2, 4, green)).To(Succeed())
+		rects := regionRects(green)
+		Expect(rects).To(HaveLen(1))
+		left, _ := (*points[2]).MarkerPosition()
+		right, _ := (*points[4]).MarkerPosition()
+		Expect(rects[0].Position().X).To(BeNumerically("~", left.X, 6))
+		Expect(rects[0].Position().X + rects[0].Size().Width).To(BeNumerically("~", right.X, 6))
+
+		Expect(lc.AddReferenceRegion("maintenance", sknlinechart.RegionX, 5, 8, green)).To(Succeed())
+		Expect(lc.GetReferenceRegions()).To(HaveLen(1))
+		Expect(lc.GetReferenceRegions()[0].From).To(BeNumerically("==", 5))
+		moved := regionRects(green)
+		Expect(moved).To(HaveLen(1))
+		Expect(moved[0].Position().X).To(BeNumerically(">", right.X))
+	})
+	It("should be drawn behind the data series", func() {
+		Expect(lc.AddReferenceRegion("comfort", sknlinechart.RegionY, 18, 24, green)).To(Succeed())
+		objs := test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects()
+		region, line := -1, -1
+		for idx, o := range objs {
+			if rect, ok := o.(*canvas.Rectangle); ok && rect.FillColor == green && region < 0 {
+				region = idx
+			}
+			if l, ok := o.(*canvas.Line); ok && l.Visible() && l.StrokeColor == theme.PrimaryColorNamed(theme.ColorBlue) && line < 0 {
+				line = idx
+			}
+		}
+		Expect(region).To(BeNumerically(">=", 0))
+		Expect(line).To(BeNumerically(">", region))
+	})
+	It("should hide regions outside the viewport and reject bad ranges", func() {
+		Expect(lc.AddReferenceRegion("cold", sknlinechart.RegionY, 0, 5, green)).To(Succeed())
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 0, XMax: 9, YMin: 10, YMax: 40})).To(Succeed())
+		Expect(regionRects(green)).To(BeEmpty())
+
+		Expect(lc.AddReferenceRegion("", sknlinechart.RegionY, 0, 5, nil)).To(HaveOccurred())
+		Expect(lc.AddReferenceRegion("flat", sknlinechart.RegionY, 5, 5, nil)).To(HaveOccurred())
+		Expect(lc.AddReferenceRegion("axis", sknlinechart.RegionAxis(7), 0, 5, nil)).To(HaveOccurred())
+	})
+	It("should keep regions in saved state", func() {
+		Expect(lc.AddReferenceRegion("comfort", sknlinechart.RegionY, 18, 24, green)).To(Succeed())
+		Expect(lc.AddReferenceRegion("maintenance", sknlinechart.RegionX, 2, 4, nil)).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.GetReferenceRegions()).To(Equal(lc.GetReferenceRegions()))
+	})
+})
@@ -1,14 +1,20 @@
 package sknlinechart
 
 import (
+	"image"
+	"image/color"
+	"image/draw"
 	"math"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 )
@@ -16,29 +22,80 @@ import (
 const (
 	XPointLimit = 150
 	YPointLimit = 13
+	MaxXLimit   = 50000 // largest point limit, the x axis keeps XPointLimit grid columns and longer series are downsampled when drawn
 )
 
+// confidenceBandOpacity opacity of the band drawn between datapoint bounds
+const confidenceBandOpacity float32 = 0.25
+
+// bandSegment confidence interval between two neighbouring datapoints, relative to the plot area
+type bandSegment struct {
+	x1, lower1, upper1 float32
+	x2, lower2, upper2 float32
+}
+
+// confidenceBand one series' confidence interval segments
+type confidenceBand struct {
+	color    color.Color
+	segments []bandSegment
+}
+
 // Widget Renderer code starts here
 type lineChartRenderer struct {
-	widget                *LineChartSkn // Reference to the widget holding the current state
-	xInc                  float32
-	yInc                  float32
-	dataPoints            map[string][]*canvas.Line
-	dataPointMarkers      map[string][]*canvas.Circle
-	mouseDisplayContainer *fyne.Container
-	xLines                []*canvas.Line
-	yLines                []*canvas.Line
-	xLabels               []*canvas.Text
-	yLabels               []*canvas.Text
-	topLeftDesc           *canvas.Text
-	topCenteredDesc       *canvas.Text
-	topRightDesc          *canvas.Text
-	bottomLeftDesc        *canvas.Text
-	bottomCenteredDesc    *canvas.Text
-	bottomRightDesc       *canvas.Text
-	leftMiddleBox         *fyne.Container
-	rightMiddleBox        *fyne.Container
-	colorLegend           *fyne.Container
+	widget                 *LineChartSkn     // Reference to the widget holding the current state
+	renderLock             sync.Mutex        // one Refresh or Layout at a time, whichever goroutine applied the data; taken before the mapsLock
+	paper                  *canvas.Rectangle // white background drawn behind everything in monochrome mode
+	xInc                   float32
+	yInc                   float32
+	dataPoints             map[string][]*canvas.Line
+	dataPointMarkers       map[string][]*canvas.Circle
+	mouseDisplayContainer  *fyne.Container
+	xLines                 []*canvas.Line
+	yLines                 []*canvas.Line
+	xLabels                []*canvas.Text
+	yLabels                []*canvas.Text
+	topLeftDesc            *canvas.Text
+	topCenteredDesc        *canvas.Text
+	topRightDesc           *canvas.Text
+	bottomLeftDesc         *canvas.Text
+	bottomCenteredDesc     *canvas.Text
+	bottomRightDesc        *canvas.Text
+	leftMiddleBox          *fyne.Container
+	rightMiddleBox         *fyne.Container
+	colorLegend            *fyne.Container
+	legendValues           *fyne.Container
+	selectionBox           *canvas.Rectangle
+	crosshairLines         []*canvas.Line
+	crosshairXReadout      *canvas.Text
+	crosshairYReadout      *canvas.Text
+	compareDisplay         *fyne.Container
+	staleSeries            map[string]bool
+	pinnedDisplays         []*fyne.Container
+	annotationDisplays     []*fyne.Container
+	verticalMarkerDisplays []*fyne.Container
+	bandRaster             *canvas.Raster
+	bands                  atomic.Value // []confidenceBand, read by the raster while painting
+	seriesRaster           *canvas.Raster
+	rasterScene            atomic.Value // rasterScene, read by the series raster while painting
+	forecastRegion         *canvas.Rectangle
+	forecastDashes         []*canvas.Line
+	seriesDashes           map[string][]*canvas.Line
+	shapeMarkers           map[string][]*canvas.Line
+	timeBandRects          []*canvas.Rectangle
+	regionRects            []*canvas.Rectangle
+	xLabelTimes            []axisTimeLabel
+	wallClock              []time.Time // grid line times when snapped to the wall clock
+	laidOut                fyne.Size   // size of the last layout
+	pool                   pointObjectPool
+	downsampled            map[string]bool  // series last drawn through a downsampled selection
+	virtualBase            map[string]int   // index of the point each series' first line and marker draw, see virtualize
+	virtualIndexes         map[string][]int // indexes of the points each series' lines and markers draw, nil when one per point from virtualBase
+	gapMarkers             []*canvas.Line
+	thresholdLines         []*canvas.Line
+	thresholdLabels        []*canvas.Text
+	statsLines             []*canvas.Line
+	statsLabels            []*canvas.Text
+	pixels                 pixelGrid
 }
 
 var _ fyne.WidgetRenderer = (*lineChartRenderer)(nil)
@@ -63,7 +120,7 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 
 	// hover frame
 	border := canvas.NewRectangle(theme.OverlayBackgroundColor())
-	border.StrokeColor = theme.PrimaryColorNamed(lineChart.mouseDisplayFrameColor)
+	border.StrokeColor = theme.ForegroundColor()
 	border.StrokeWidth = 2.0
 
 	// hover content
@@ -80,8 +137,36 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 	)
 	mouseDisplay.Hide()
 
+	// zoom selection rubber-band
+	selectionBox := canvas.NewRectangle(theme.SelectionColor())
+	selectionBox.StrokeColor = theme.PrimaryColor()
+	selectionBox.StrokeWidth = 1.0
+	selectionBox.Hide()
+
+	// crosshair following the mouse, vertical then horizontal
+	var crosshairLines []*canvas.Line
+	for i := 0; i < 2; i++ {
+		ch := canvas.NewLine(theme.ForegroundColor())
+		ch.StrokeWidth = 0.5
+		ch.Hide()
+		crosshairLines = append(crosshairLines, ch)
+	}
+	crosshairXReadout := canvas.NewText("", theme.PrimaryColor())
+	crosshairXReadout.TextStyle = fyne.TextStyle{Bold: true}
+	crosshairXReadout.Hide()
+	crosshairYReadout := canvas.NewText("", theme.PrimaryColor())
+	crosshairYReadout.TextStyle = fyne.TextStyle{Bold: true}
+	crosshairYReadout.Hide()
+
+	// compare tooltip, one colored row per series
+	compareFrame := canvas.NewRectangle(theme.OverlayBackgroundColor())
+	compareFrame.StrokeColor = theme.ForegroundColor()
+	compareFrame.StrokeWidth = 1.0
+	compareDisplay := container.NewPadded(compareFrame, container.NewVBox())
+	compareDisplay.Hide()
+
 	// x & y frame lines
-	for i := 0; i < lineChart.dataPointXLimit; i++ { // vertical
+	for i := 0; i < lineChart.gridColumns(); i++ { // vertical
 		x := canvas.NewLine(theme.PrimaryColorNamed(theme.ColorGreen))
 		x.StrokeWidth = 0.25
 		xlines = append(xlines, x)
@@ -103,8 +188,8 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 		objs = append(objs, yl)
 	}
 	// X scale labels
-	for i := 0; i < lineChart.dataPointXLimit; i++ {
-		xt := strconv.Itoa(i * lineChart.chartXScaleMultiplier)
+	for i := 0; i < lineChart.gridColumns(); i++ {
+		xt := strconv.Itoa(lineChart.gridIndex(i) * lineChart.chartXScaleMultiplier)
 		xl := canvas.NewText(xt, theme.ForegroundColor())
 		xl.Alignment = fyne.TextAlignTrailing
 		xLabels = append(xLabels, xl)
@@ -125,12 +210,14 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 			z.Resize(fyne.NewSize(markerSize, markerSize))
 			dpMaker[key] = append(dpMaker[key], z)
 		}
-		z := canvas.NewText(key, theme.PrimaryColorNamed((*points[0]).ColorName()))
-		colorLegend.Add(z)
+		if len(points) > 0 {
+			z := canvas.NewText(key, lineChart.legendColor(key))
+			colorLegend.Add(z)
+		}
 	}
 
 	topCenteredDesc := canvas.NewText(lineChart.topCenteredLabel, theme.ForegroundColor())
-	topCenteredDesc.TextSize = 24
+	topCenteredDesc.TextSize = titleTextSize
 	topCenteredDesc.TextStyle = fyne.TextStyle{
 		Bold:   true,
 		Italic: false,
@@ -138,7 +225,7 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 	objs = append(objs, topCenteredDesc)
 
 	bottomCenteredDesc := canvas.NewText(lineChart.bottomCenteredLabel, theme.ForegroundColor())
-	bottomCenteredDesc.TextSize = 16
+	bottomCenteredDesc.TextSize = footerTextSize
 	bottomCenteredDesc.TextStyle = fyne.TextStyle{
 		Bold:   false,
 		Italic: true,
@@ -150,7 +237,7 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 	for _, c := range lineChart.leftMiddleLabel {
 		z := canvas.NewText(strings.ToUpper(string(c)), theme.PrimaryColorNamed(string(theme.ColorNameForeground)))
 		z.TextStyle = fyne.TextStyle{Monospace: true}
-		z.TextSize = 14
+		z.TextSize = sideTextSize
 		z.Alignment = fyne.TextAlignCenter
 		lBox.Add(z)
 	}
@@ -160,7 +247,7 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 	for _, c := range lineChart.rightMiddleLabel {
 		z := canvas.NewText(strings.ToUpper(string(c)), theme.PrimaryColorNamed(string(theme.ColorNameForeground)))
 		z.TextStyle = fyne.TextStyle{Monospace: true}
-		z.TextSize = 14
+		z.TextSize = sideTextSize
 		z.Alignment = fyne.TextAlignCenter
 		rBox.Add(z)
 	}
@@ -177,7 +264,7 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 
 	lineChart.debugLog("::newLineChartRenderer() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 
-	return &lineChartRenderer{
+	r := &lineChartRenderer{
 		widget:                lineChart,
 		xLines:                xlines,
 		yLines:                ylines,
@@ -195,7 +282,31 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 		dataPointMarkers:      dpMaker,
 		mouseDisplayContainer: mouseDisplay,
 		colorLegend:           colorLegend,
+		legendValues:          container.NewWithoutLayout(),
+		selectionBox:          selectionBox,
+		crosshairLines:        crosshairLines,
+		crosshairXReadout:     crosshairXReadout,
+		crosshairYReadout:     crosshairYReadout,
+		compareDisplay:        compareDisplay,
+		staleSeries:           map[string]bool{},
+		seriesDashes:          map[string][]*canvas.Line{},
+		shapeMarkers:          map[string][]*canvas.Line{},
+		downsampled:           map[string]bool{},
+		virtualBase:           map[string]int{},
+		virtualIndexes:        map[string][]int{},
 	}
+	r.paper = canvas.NewRectangle(monochromePaper)
+	r.paper.Hide()
+	// confidence bands behind the series lines
+	r.bandRaster = canvas.NewRaster(r.drawBands)
+	r.bandRaster.Hide()
+	// every series painted as one image by the raster backend
+	r.seriesRaster = canvas.NewRaster(r.drawSeries)
+	r.seriesRaster.Hide()
+	// shading beyond the "now" boundary of forecast series
+	r.forecastRegion = canvas.NewRectangle(fadeColor(theme.ForegroundColor(), forecastRegionOpacity))
+	r.forecastRegion.Hide()
+	return r
 }
 
 // manageLabelVisibility called by refresh to show/hide as needed
@@ -267,9 +378,15 @@ func (r *lineChartRenderer) manageLabelVisibility() {
 			r.colorLegend.Hide()
 		}
 	}
+	if r.widget.enableColorLegend && len(r.widget.legendValues) > 0 {
+		r.legendValues.Show()
+	} else {
+		r.legendValues.Hide()
+	}
 
-	for _, line := range r.xLines {
-		if r.widget.enableHorizGridLines {
+	r.manageGridLineVisibility()
+	for _, line := range r.yLines {
+		if r.widget.enableVertGridLines {
 			if !line.Visible() {
 				line.Show()
 			}
@@ -277,8 +394,15 @@ func (r *lineChartRenderer) manageLabelVisibility() {
 			line.Hide()
 		}
 	}
-	for _, line := range r.yLines {
-		if r.widget.enableVertGridLines {
+	r.widget.debugLog("lineChartRenderer::manageLabelVisibility() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
+}
+
+// manageGridLineVisibility shows the vertical grid lines in use at the current quality,
+// those on wall-clock boundaries when snapped
+func (r *lineChartRenderer) manageGridLineVisibility() {
+	stride := r.widget.gridStride()
+	for idx, line := range r.xLines {
+		if r.widget.enableHorizGridLines && idx%stride == 0 && (r.wallClock == nil || idx < len(r.wallClock)) {
 			if !line.Visible() {
 				line.Show()
 			}
@@ -286,7 +410,6 @@ func (r *lineChartRenderer) manageLabelVisibility() {
 			line.Hide()
 		}
 	}
-	r.widget.debugLog("lineChartRenderer::manageLabelVisibility() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 }
 
 // Refresh method is called if the state of the widget changes or the
@@ -294,15 +417,49 @@ func (r *lineChartRenderer) manageLabelVisibility() {
 func (r *lineChartRenderer) Refresh() {
 	r.widget.debugLog("lineChartRenderer::Refresh() ENTER")
 	startTime := time.Now()
+	r.renderLock.Lock()
+	defer r.renderLock.Unlock()
 
 	r.verifyDataPoints(true)
+	r.widget.idleLock.Lock()
+	remeasure := r.widget.remeasurePixels
+	r.widget.remeasurePixels = false
+	r.widget.idleLock.Unlock()
+	if remeasure {
+		r.pixels = r.widget.pixelGrid()
+	}
+
+	r.widget.mapsLock.Lock()
+	r.widget.assignMonochromeStyles()
+	if r.relayoutsAll() {
+		for key := range r.widget.dataPoints {
+			r.layoutSeries(key)
+		}
+		r.widget.relayoutRequired = false
+		r.widget.updatedPoints = nil
+	} else {
+		r.layoutUpdatedPoints()
+	}
+	r.layoutGrid()
+	r.layoutMonochrome()
+	r.applyStaleness()
+	r.layoutRegions()
+	r.layoutTimeBands()
+	r.layoutBands()
+	r.layoutForecasts()
+	r.layoutGapMarkers()
+	r.layoutThresholds()
+	r.layoutStatsOverlay()
+	r.layoutSeriesRaster(r.widget.Size())
+	r.refreshLegendColors()
+	r.layoutLegend(r.widget.Size())
+	ink := r.widget.inkColor()
+	r.widget.mapsLock.Unlock()
 
 	r.leftMiddleBox.RemoveAll()
 	for _, c := range r.widget.leftMiddleLabel {
-		z := canvas.NewText(
-			strings.ToUpper(string(c)),
-			theme.PrimaryColorNamed(string(theme.ColorNameForeground)))
-		z.TextSize = 14
+		z := canvas.NewText(strings.ToUpper(string(c)), ink)
+		z.TextSize = sideTextSize
 		z.TextStyle = fyne.TextStyle{Monospace: true}
 		z.Alignment = fyne.TextAlignCenter
 		r.leftMiddleBox.Add(z)
@@ -311,10 +468,8 @@ func (r *lineChartRenderer) Refresh() {
 
 	r.rightMiddleBox.RemoveAll()
 	for _, c := range r.widget.rightMiddleLabel {
-		z := canvas.NewText(
-			strings.ToUpper(string(c)),
-			theme.PrimaryColorNamed(string(theme.ColorNameForeground)))
-		z.TextSize = 14
+		z := canvas.NewText(strings.ToUpper(string(c)), ink)
+		z.TextSize = sideTextSize
 		z.TextStyle = fyne.TextStyle{Monospace: true}
 		z.Alignment = fyne.TextAlignCenter
 
@@ -329,6 +484,7 @@ func (r *lineChartRenderer) Refresh() {
 	r.bottomLeftDesc.Text = r.widget.bottomLeftLabel
 	r.bottomCenteredDesc.Text = r.widget.bottomCenteredLabel
 	r.bottomRightDesc.Text = r.widget.bottomRightLabel
+	r.updateScaleLabels()
 	for _, v := range r.widget.objectsCache {
 		v.Refresh()
 	}
@@ -340,8 +496,15 @@ func (r *lineChartRenderer) Refresh() {
 	r.widget.mapsLock.Lock()
 
 	r.mouseDisplayContainer.Hide()
-	r.mouseDisplayContainer.Objects[0].(*canvas.Rectangle).StrokeColor = theme.PrimaryColorNamed(r.widget.mouseDisplayFrameColor)
+	frameColor := r.widget.mouseDisplayFrameColor
+	if frameColor == nil {
+		frameColor = theme.ForegroundColor()
+	}
+	r.mouseDisplayContainer.Objects[0].(*canvas.Rectangle).StrokeColor = frameColor
 	r.mouseDisplayContainer.Objects[1].(*widget.Label).SetText(r.widget.mouseDisplayStr)
+	r.setMouseDisplayContent(r.widget.mouseDisplayContent)
+	r.layoutMouseDisplay(r.widget.Size())
+	r.layoutInsets()
 
 	r.widget.mapsLock.Unlock()
 
@@ -357,6 +520,18 @@ func (r *lineChartRenderer) Refresh() {
 		r.mouseDisplayContainer.Hide()
 	}
 
+	r.refreshSelectionBox()
+	r.refreshCrosshair()
+	r.refreshCompareDisplay()
+
+	r.widget.mapsLock.RLock()
+	r.layoutAnnotations()
+	r.layoutVerticalMarkers()
+	r.layoutPinnedTooltips()
+	r.widget.mapsLock.RUnlock()
+	r.refreshInsets()
+
+	r.widget.metrics.refreshed(time.Since(startTime))
 	r.widget.debugLog("lineChartRenderer::Refresh() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 }
 
@@ -366,52 +541,100 @@ func (r *lineChartRenderer) layoutSeries(series string) {
 
 	r.widget.debugLog("lineChartRenderer::layoutSeries() ENTER. Series: ", series)
 	// data points
-	xp := r.xInc
-	yp := r.yInc * float32(YPointLimit+1)
-	yScale := (r.yInc * 10) / (10.0 * float32(r.widget.chartYScaleMultiplier)) // 100
-	xScale := (r.xInc * float32(r.widget.dataPointXLimit*r.widget.chartXScaleMultiplier)) / float32(r.widget.dataPointXLimit*r.widget.chartXScaleMultiplier)
-	var dp float32
+	keep := r.widget.downsampleKeep(series)
+	r.downsampled[series] = keep != nil
+	r.virtualize(series, keep)
 	data := r.widget.dataPoints[series] // datasource
-	lastPoint := fyne.NewPos(xp, yp)
+	var lastPoint fyne.Position
+	firstVisible := true
+	broken := false
+	strokeSize := r.seriesStroke(series)
+	hidden := r.widget.hiddenSeries[series]
+	dashed := r.widget.seriesDashPattern(series) != nil
+	curved := r.widget.lineInterpolation != LineLinear
+	var dashesUsed int
+	var dashPhase float32
+	var run []curvePoint
+	shape, markerSize := r.widget.seriesMarker(series)
+	half := markerSize / 2
+	var shapesUsed int
 
 	for idx, point := range data { // one set of lines
-		if (*point).Value() > r.widget.dataPointYLimit { // max y chart scale
-			dp = r.widget.dataPointYLimit
-		} else if (*point).Value() < 0.0 {
-			dp = 0.0
-		} else {
-			dp = (*point).Value()
+		o, held := r.objectIndex(series, idx) // none outside the viewport, nor for points skipped by the render quality or downsampling
+		var dpv *canvas.Line
+		var dpm *canvas.Circle
+		var c color.Color
+		if held {
+			dpv, dpm = r.dataPoints[series][o], r.dataPointMarkers[series][o]
+			c = r.seriesColor(series, point)
+			dpv.StrokeColor = c
+			dpm.FillColor = c
+		}
+		x := r.widget.pointX(idx, *point)
+		drawable := isFinite((*point).Value()) && !(*point).IsMissing()
+		if hidden || !drawable || !r.widget.isXVisible(x) { // hidden from the legend, a missing sample, or outside the zoomed viewport
+			broken = broken || !drawable // no line across a dropout
+			if held {
+				dpv.Hide()
+				dpm.Hide()
+			}
+			(*point).SetMarkerPosition(&fyne.Position{}, &fyne.Position{})
+			continue
 		}
-		yy := yp - (dp * yScale) // using same datasource value
-		xx := xp + (float32(idx) * xScale)
 
-		xx = float32(math.Trunc(float64(xx)))
-		yy = float32(math.Trunc(float64(yy)))
+		thisPoint := r.widget.dataToPosition(x, r.widget.plotValue(series, idx, *point))
+		thisPoint.X = float32(math.Trunc(float64(thisPoint.X)))
+		thisPoint.Y = float32(math.Trunc(float64(thisPoint.Y)))
+		if r.widget.isGapBefore(series, idx) {
+			broken = true
+		}
+		if firstVisible {
+			lastPoint = thisPoint
+			firstVisible = false
+		}
 
-		thisPoint := fyne.NewPos(xx, yy)
-		if idx == 0 {
-			lastPoint.Y = yy
+		zt := fyne.NewPos(thisPoint.X-half, thisPoint.Y-half)
+		zb := fyne.NewPos(thisPoint.X+half, thisPoint.Y+half)
+		(*point).SetMarkerPosition(&zt, &zb)
+		if !held { // skipped by the render quality or downsampling, hovering still finds it
+			continue
 		}
+		dpm.Position1 = zt
+		dpm.Position2 = zb
 
-		dpv := r.dataPoints[series][idx]
+		dpv.StrokeWidth = strokeSize
 		dpv.Position1 = thisPoint
 		dpv.Position2 = lastPoint
 		lastPoint = thisPoint
+		if broken { // no line across a data source outage
+			dpv.Hide()
+			broken = false
+			dashesUsed, dashPhase = r.curveRun(series, run, dashesUsed, strokeSize, dashPhase)
+			run = run[:0]
+		} else if curved { // drawn as a curve through the run of points instead
+			dpv.Hide()
+		} else if dashed { // drawn by the series' pooled dashes instead
+			dpv.Hide()
+			dashesUsed, dashPhase = r.dashSegment(series, dashesUsed, dpv.Position2, dpv.Position1, c, strokeSize, dashPhase)
+		} else if !dpv.Visible() {
+			dpv.Show()
+		}
+		if curved {
+			run = append(run, curvePoint{pos: thisPoint, color: c})
+		}
 
-		zt := fyne.NewPos(thisPoint.X-2, thisPoint.Y-2)
-		dpm := r.dataPointMarkers[series][idx]
-		dpm.Position1 = zt
-		zb := fyne.NewPos(thisPoint.X+2, thisPoint.Y+2)
-		dpm.Position2 = zb
-		(*point).SetMarkerPosition(&zt, &zb)
-		if r.widget.enableDataPointMarkers {
-			if !dpm.Visible() {
-				dpm.Show()
-			}
-		} else {
+		if !r.widget.markersAllowed() {
+			dpm.Hide()
+		} else if shape != MarkerCircle { // drawn by the series' pooled marker lines instead
 			dpm.Hide()
+			shapesUsed = r.shapeMarker(series, shapesUsed, thisPoint, c)
+		} else if !dpm.Visible() {
+			dpm.Show()
 		}
 	}
+	dashesUsed, _ = r.curveRun(series, run, dashesUsed, strokeSize, dashPhase)
+	r.hideDashes(series, dashesUsed)
+	r.hideShapeMarkers(series, shapesUsed)
 	var found bool
 correct:
 	for _, o := range r.colorLegend.Objects {
@@ -420,66 +643,91 @@ correct:
 			break correct
 		}
 	}
-	if !found {
-		z := canvas.NewText(series, theme.PrimaryColorNamed((*data[0]).ColorName()))
+	if !found && len(data) > 0 {
+		z := canvas.NewText(series, r.widget.legendColor(series))
 		r.colorLegend.Add(z)
 	}
 
 	r.widget.debugLog("lineChartRenderer::layoutSeries() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 }
 
+// layoutGrid positions the grid lines on the device pixels nearest their place in the plot area
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutGrid() {
+	// grid Vert lines
+	yp := float32(YPointLimit+1) * r.yInc
+	for idx, line := range r.xLines {
+		xp := r.pixels.x(float32(idx)*r.xInc + r.xInc)
+		line.Position1 = fyne.NewPos(xp, r.yInc) //top
+		line.Position2 = fyne.NewPos(xp, yp+8)
+	}
+	for idx, label := range r.xLabels {
+		xxp := float32(idx+1) * r.xInc // starting at left
+		label.Move(fyne.NewPos(xxp+8, yp+10))
+	}
+
+	// grid Horiz lines
+	xp := r.xInc
+	for idx, line := range r.yLines {
+		yp := r.pixels.y(float32(idx)*r.yInc + r.yInc)
+		line.Position1 = fyne.NewPos(xp-8, yp) // left
+		line.Position2 = fyne.NewPos(xp*float32(r.widget.gridColumns()), yp)
+	}
+	r.layoutWallClockGrid()
+}
+
 // Layout Given the size required by the fyne application
 // move and re-size all custom widget canvas objects here
 func (r *lineChartRenderer) Layout(s fyne.Size) {
 	r.widget.debugLog("lineChartRenderer::Layout() ENTER: ", s)
 	startTime := time.Now()
+	r.renderLock.Lock()
+	defer r.renderLock.Unlock()
+	r.pixels = r.widget.pixelGrid()
 
 	r.widget.mapsLock.Lock()
 	defer r.widget.mapsLock.Unlock()
 
-	r.xInc = (s.Width - (theme.Padding() * 4)) / float32(r.widget.dataPointXLimit)
+	r.widget.assignMonochromeStyles()
+	r.paper.Resize(s)
+	r.xInc = (s.Width - (theme.Padding() * 4)) / float32(r.widget.gridColumns())
 	r.yInc = (s.Height - (theme.Padding() * 3)) / 16.0
 
 	r.xInc = float32(math.Trunc(float64(r.xInc)))
 	r.yInc = float32(math.Trunc(float64(r.yInc)))
 
-	// grid Vert lines
-	yp := float32(YPointLimit+1) * r.yInc
-	for idx, line := range r.xLines {
-		xp := float32(idx) * r.xInc
-		line.Position1 = fyne.NewPos(xp+r.xInc, r.yInc) //top
-		line.Position2 = fyne.NewPos(xp+r.xInc, yp+8)
-	}
-
-	// grid Horiz lines
-	xp := r.xInc
-	for idx, line := range r.yLines {
-		yp := float32(idx) * r.yInc
-		line.Position1 = fyne.NewPos(xp-8, yp+r.yInc) // left
-		line.Position2 = fyne.NewPos(xp*float32(r.widget.dataPointXLimit), yp+r.yInc)
-	}
+	// plot area used to map datapoints to positions
+	r.widget.plotMin = fyne.NewPos(r.xInc, r.yInc)
+	r.widget.plotMax = fyne.NewPos(r.xInc*float32(r.widget.gridColumns()), r.yInc*float32(YPointLimit+1))
 
 	// grid scale labels
-	xp = r.xInc
-	yp = float32(YPointLimit+1) * r.yInc
-	for idx, label := range r.xLabels {
-		xxp := float32(idx+1) * r.xInc // starting at left
-		label.Move(fyne.NewPos(xxp+8, yp+10))
-	}
+	xp := r.xInc
 	for idx, label := range r.yLabels {
 		yyp := float32(idx+1) * r.yInc // starting at top
 		label.Move(fyne.NewPos(xp*0.80, yyp-8))
 	}
 
 	// handle new data points or series
+	resized := s != r.laidOut
+	r.laidOut = s
 	r.verifyDataPoints(false)
+	r.layoutGrid() // after the time span is measured, the grid may follow the wall clock
 
-	// handle new data series
-	if !r.widget.datapointAdded && !r.widget.dataSeriesAdded {
+	// position every series for the new size, hover matching depends on current marker positions;
+	// at the same size only the series changed since were repositioned above
+	if resized || r.relayoutsAll() {
 		for key := range r.widget.dataPoints { // datasource
 			r.layoutSeries(key)
 		}
 	}
+	r.layoutRegions()
+	r.layoutTimeBands()
+	r.layoutBands()
+	r.layoutForecasts()
+	r.layoutGapMarkers()
+	r.layoutThresholds()
+	r.layoutStatsOverlay()
+	r.layoutSeriesRaster(s)
 	r.widget.dataSeriesAdded = false
 	r.widget.datapointAdded = false
 
@@ -496,23 +744,8 @@ func (r *lineChartRenderer) Layout(s fyne.Size) {
 	r.topRightDesc.Move(fyne.Position{X: (s.Width - ts.Width) - theme.Padding(), Y: ts.Height / 4})
 	r.topLeftDesc.Move(fyne.NewPos(theme.Padding(), ts.Height/4))
 
-	msg := strings.Split(r.mouseDisplayContainer.Objects[1].(*widget.Label).Text, "[")
-	ts = fyne.MeasureText(msg[0], 14, r.mouseDisplayContainer.Objects[1].(*widget.Label).TextStyle)
-	r.mouseDisplayContainer.Objects[1].(*widget.Label).Resize(fyne.NewSize(ts.Width-theme.Padding(), (2*ts.Height)+(theme.Padding()/2))) // allow room for wrap
-	r.mouseDisplayContainer.Objects[0].(*canvas.Rectangle).Resize(fyne.NewSize(ts.Width+theme.Padding(), (2*ts.Height)+theme.Padding()))
-	// top edge
-	if r.widget.mouseDisplayPosition.Y < theme.Padding()/6 {
-		r.widget.mouseDisplayPosition.Y = theme.Padding() / 6
-	}
-	// left edge
-	if r.widget.mouseDisplayPosition.X < theme.Padding()/8 {
-		r.widget.mouseDisplayPosition.X = theme.Padding() / 8
-	}
-	// right edge
-	if (r.widget.mouseDisplayPosition.X + ts.Width) > s.Width-theme.Padding() {
-		r.widget.mouseDisplayPosition.X = s.Width - ts.Width - theme.Padding()
-	}
-	r.mouseDisplayContainer.Move(*r.widget.mouseDisplayPosition)
+	r.layoutMouseDisplay(s)
+	r.layoutInsets()
 
 	ts = fyne.MeasureText("A", 14, fyne.TextStyle{Bold: true, Monospace: true})
 	r.leftMiddleBox.Resize(fyne.NewSize(ts.Width+2, s.Height*0.70))
@@ -534,18 +767,47 @@ func (r *lineChartRenderer) Layout(s fyne.Size) {
 	r.bottomRightDesc.Move(fyne.NewPos((s.Width-ts.Width)-theme.Padding(), s.Height-ts.Height-theme.Padding()))
 	r.bottomLeftDesc.Move(fyne.NewPos(theme.Padding()+2.0, s.Height-ts.Height-theme.Padding()))
 
-	z := r.colorLegend.MinSize()
-	r.colorLegend.Move(fyne.NewPos(s.Width-(z.Width+theme.Padding()), (r.yInc*15)+theme.Padding()))
+	r.layoutLegend(s)
+
+	r.layoutAnnotations()
+	r.layoutVerticalMarkers()
+	r.layoutPinnedTooltips()
 
+	r.widget.metrics.laidOut(time.Since(startTime))
 	r.widget.debugLog("lineChartRenderer::Layout() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 }
 
+// layoutMouseDisplay sizes the hover popup around its text or custom content and keeps it inside the chart
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutMouseDisplay(s fyne.Size) {
+	ts, ok := r.layoutMouseDisplayContent()
+	if !ok {
+		msg := strings.Split(r.mouseDisplayContainer.Objects[1].(*widget.Label).Text, "[")
+		ts = fyne.MeasureText(msg[0], 14, r.mouseDisplayContainer.Objects[1].(*widget.Label).TextStyle)
+		r.mouseDisplayContainer.Objects[1].(*widget.Label).Resize(fyne.NewSize(ts.Width-theme.Padding(), (2*ts.Height)+(theme.Padding()/2))) // allow room for wrap
+		r.mouseDisplayContainer.Objects[0].(*canvas.Rectangle).Resize(fyne.NewSize(ts.Width+theme.Padding(), (2*ts.Height)+theme.Padding()))
+	}
+	// top edge
+	if r.widget.mouseDisplayPosition.Y < theme.Padding()/6 {
+		r.widget.mouseDisplayPosition.Y = theme.Padding() / 6
+	}
+	// left edge
+	if r.widget.mouseDisplayPosition.X < theme.Padding()/8 {
+		r.widget.mouseDisplayPosition.X = theme.Padding() / 8
+	}
+	// right edge
+	if (r.widget.mouseDisplayPosition.X + ts.Width) > s.Width-theme.Padding() {
+		r.widget.mouseDisplayPosition.X = s.Width - ts.Width - theme.Padding()
+	}
+	r.mouseDisplayContainer.Move(*r.widget.mouseDisplayPosition)
+}
+
 // MinSize Create a minimum size for the widget.
-// The smallest size is can be overridden by user
+// The smallest size is can be overridden by user, the widget's MinSize decides
 func (r *lineChartRenderer) MinSize() fyne.Size {
 	startTime := time.Now()
 	r.widget.debugLog("lineChartRenderer::MinSize() ENTER")
-	rVal := fyne.NewSize(r.widget.minSize.Width, r.widget.minSize.Height)
+	rVal := r.widget.MinSize()
 	r.widget.debugLog("lineChartRenderer::MinSize() EXIT: renderer: ", rVal, ", Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 	return rVal
 }
@@ -559,17 +821,76 @@ func (r *lineChartRenderer) Objects() []fyne.CanvasObject {
 	r.widget.mapsLock.RLock()
 	defer r.widget.mapsLock.RUnlock()
 
-	var objs []fyne.CanvasObject
+	objs := []fyne.CanvasObject{r.paper}
 	objs = append(objs, r.widget.objectsCache...)
+	for _, rect := range r.regionRects {
+		objs = append(objs, rect)
+	}
+	for _, rect := range r.timeBandRects {
+		objs = append(objs, rect)
+	}
+	objs = append(objs, r.bandRaster, r.forecastRegion)
 
+	raster := r.widget.renderBackend == RenderBackendRaster // series painted by the series raster instead
+	if raster {
+		objs = append(objs, r.seriesRaster)
+	}
 	for key, lines := range r.dataPoints {
+		if raster || r.widget.hiddenSeries[key] {
+			continue
+		}
 		for idx, line := range lines {
 			marker := r.dataPointMarkers[key][idx]
 			objs = append(objs, marker, line)
 		}
 	}
+	for key, dashes := range r.seriesDashes {
+		if raster || r.widget.hiddenSeries[key] {
+			continue
+		}
+		for _, dash := range dashes {
+			objs = append(objs, dash)
+		}
+	}
+	for key, lines := range r.shapeMarkers {
+		if raster || r.widget.hiddenSeries[key] {
+			continue
+		}
+		for _, line := range lines {
+			objs = append(objs, line)
+		}
+	}
+	for _, dash := range r.forecastDashes {
+		objs = append(objs, dash)
+	}
+	for _, marker := range r.gapMarkers {
+		objs = append(objs, marker)
+	}
+	for idx, line := range r.thresholdLines {
+		objs = append(objs, line, r.thresholdLabels[idx])
+	}
+	for idx, line := range r.statsLines {
+		objs = append(objs, line, r.statsLabels[idx])
+	}
 
-	objs = append(objs, r.colorLegend, r.mouseDisplayContainer)
+	for _, inset := range r.widget.insets {
+		objs = append(objs, inset.chart)
+	}
+	objs = append(objs, r.colorLegend, r.legendValues, r.selectionBox)
+	for _, line := range r.crosshairLines {
+		objs = append(objs, line)
+	}
+	objs = append(objs, r.crosshairXReadout, r.crosshairYReadout)
+	for _, flag := range r.annotationDisplays {
+		objs = append(objs, flag)
+	}
+	for _, marker := range r.verticalMarkerDisplays {
+		objs = append(objs, marker)
+	}
+	for _, box := range r.pinnedDisplays {
+		objs = append(objs, box)
+	}
+	objs = append(objs, r.mouseDisplayContainer, r.compareDisplay)
 
 	r.widget.debugLog("lineChartRenderer::Objects() EXIT cnt: ", len(objs), ", Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 	return objs
@@ -578,12 +899,22 @@ func (r *lineChartRenderer) Objects() []fyne.CanvasObject {
 // Destroy Cleanup if resources have been allocated
 func (r *lineChartRenderer) Destroy() {
 	r.widget.debugLog("lineChartRenderer::Destroy() ENTER cnt: ", len(r.widget.objectsCache))
+	r.widget.mapsLock.Lock()
 	r.widget.objectsCache = r.widget.objectsCache[:0]
 	for key := range r.widget.dataPoints {
 		r.widget.dataPoints[key] = r.widget.dataPoints[key][:0]
 		r.dataPoints[key] = r.dataPoints[key][:0]
 		r.dataPointMarkers[key] = r.dataPointMarkers[key][:0]
 	}
+	r.virtualBase = map[string]int{}
+	r.virtualIndexes = map[string][]int{}
+	r.widget.stopStaleWatch() // nothing left to dim
+	r.widget.stopRefining()   // nor to refine
+	r.widget.stopSink()       // nor to apply
+	r.widget.mapsLock.Unlock()
+	r.widget.idleLock.Lock()
+	r.widget.cancelCoalesced() // nor to redraw
+	r.widget.idleLock.Unlock()
 	r.widget.debugLog("lineChartRenderer::Destroy() EXIT cnt: ", len(r.widget.objectsCache))
 }
 
@@ -598,39 +929,632 @@ func (r *lineChartRenderer) verifyDataPoints(protect bool) {
 		r.widget.mapsLock.Lock()
 		defer r.widget.mapsLock.Unlock()
 	}
+	r.widget.updateRatios()
+	r.widget.updateDerived()
+	r.widget.updateTimeSpan()
+	r.widget.updateStack()
 
-	var changedKeys []string
+	changedKeys := map[string]int{} // series to lay out, from the first point to reposition
 	var changed bool
-	strokeSize := r.widget.dataPointStrokeSize
-	markerSize := strokeSize * 5
-	for key, points := range r.widget.dataPoints {
+	dirty := r.widget.dirtySeries
+	r.widget.dirtySeries = nil
+	for key := range r.widget.dataPoints {
 		changed = false
+		from, isDirty := dirty[key]
+		if !isDirty && r.dataPoints[key] != nil && !r.widget.dataSeriesAdded && !r.relayoutsAll() {
+			continue // unchanged since its last layout, its objects still match
+		}
 		if nil == r.dataPoints[key] {
 			r.dataPoints[key] = []*canvas.Line{}
 			r.dataPointMarkers[key] = []*canvas.Circle{}
 			changed = true
+			from = 0
 		}
-		for idx, point := range points {
-			if idx > (len(r.dataPoints[key]) - 1) { // add added points
-				changed = true
-				x := canvas.NewLine(theme.PrimaryColorNamed((*point).ColorName()))
-				x.StrokeWidth = strokeSize
-				r.dataPoints[key] = append(r.dataPoints[key], x)
-				z := canvas.NewCircle(theme.PrimaryColorNamed((*point).ColorName()))
-				z.StrokeWidth = strokeSize * 2
-				z.Resize(fyne.NewSize(markerSize, markerSize))
-				r.dataPointMarkers[key] = append(r.dataPointMarkers[key], z)
-			}
+		grew, moved := r.virtualize(key, r.widget.downsampleKeep(key)) // objects for the points drawn
+		if grew || moved {
+			changed = true
+		}
+		if moved { // replaced by a shorter series, or the viewport moved
+			from = 0
+		}
+		if r.widget.dataSeriesAdded || (changed && !isDirty) {
+			from, isDirty = 0, true
 		}
-		if changed {
-			changedKeys = append(changedKeys, key)
+		if isDirty {
+			changedKeys[key] = from
 		}
 	}
-	if len(changedKeys) > 0 {
-		for _, series := range changedKeys {
-			r.layoutSeries(series)
+	for key := range r.dataPoints { // series no longer in the chart
+		if _, ok := r.widget.dataPoints[key]; !ok {
+			r.releasePointObjects(key, 0)
+			delete(r.dataPoints, key)
+			delete(r.dataPointMarkers, key)
+			delete(r.staleSeries, key)
+			delete(r.seriesDashes, key)
+			delete(r.shapeMarkers, key)
+			delete(r.downsampled, key)
+			delete(r.virtualBase, key)
+			delete(r.virtualIndexes, key)
+			r.removeLegend(key)
 		}
-		r.widget.dataSeriesAdded = false
 	}
+	if !r.relayoutsAll() { // otherwise every series is laid out next
+		for series, from := range changedKeys {
+			if !r.layoutAppended(series, from) {
+				r.layoutSeries(series)
+			}
+		}
+	}
+	r.widget.dataSeriesAdded = false
 	r.widget.debugLog("lineChartRenderer::VerifyDataPoints() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 }
+
+// updateScaleLabels applies the current viewport to the x & y scale labels
+func (r *lineChartRenderer) updateScaleLabels() {
+	defer r.updateXAxisLabels()
+	if !r.widget.IsZoomed() {
+		for idx, label := range r.yLabels {
+			label.Text = strconv.Itoa(r.yLabelStep(idx) * r.widget.chartYScaleMultiplier)
+		}
+		for idx, label := range r.xLabels {
+			label.Text = strconv.Itoa(r.widget.gridIndex(idx) * r.widget.chartXScaleMultiplier)
+		}
+		return
+	}
+
+	vp := r.widget.currentViewport()
+	yStep := (vp.YMax - vp.YMin) / float32(YPointLimit)
+	for idx, label := range r.yLabels {
+		label.Text = strconv.FormatFloat(float64(vp.YMin+float32(r.yLabelStep(idx))*yStep), 'f', 1, 32)
+	}
+	xStep := (vp.XMax - vp.XMin) / float32(len(r.xLabels)-1)
+	for idx, label := range r.xLabels {
+		label.Text = strconv.Itoa(int(math.Round(float64(vp.XMin+float32(idx)*xStep))) * r.widget.chartXScaleMultiplier)
+	}
+}
+
+// updateXAxisLabels shows the numeric x axis values, or the times when spaced by time, across the viewport in place of indexes;
+// times follow the grid lines onto wall-clock boundaries when snapped
+func (r *lineChartRenderer) updateXAxisLabels() {
+	if r.widget.xAxis == nil && r.widget.timeSpan == nil {
+		return
+	}
+	if r.wallClock != nil {
+		for idx, label := range r.xLabels {
+			label.Text = ""
+			if idx < len(r.wallClock) {
+				label.Text = r.axisTimeText(idx, r.wallClock[idx])
+			}
+		}
+		return
+	}
+	vp := r.widget.currentViewport()
+	xStep := (vp.XMax - vp.XMin) / float32(len(r.xLabels)-1)
+	for idx, label := range r.xLabels {
+		x := vp.XMin + float32(idx)*xStep
+		if r.widget.xAxis != nil {
+			label.Text = formatXValue(r.widget.xAxisValue(x))
+		} else {
+			label.Text = r.axisTimeText(idx, r.widget.xTime(x))
+		}
+	}
+}
+
+// refreshSelectionBox positions the zoom rubber-band over the current mouse selection
+func (r *lineChartRenderer) refreshSelectionBox() {
+	if !r.widget.selectionActive {
+		r.selectionBox.Hide()
+		return
+	}
+	start := r.widget.clampToPlotArea(r.widget.selectionStart)
+	end := r.widget.selectionEnd
+	r.selectionBox.Move(fyne.NewPos(
+		float32(math.Min(float64(start.X), float64(end.X))),
+		float32(math.Min(float64(start.Y), float64(end.Y)))))
+	r.selectionBox.Resize(fyne.NewSize(
+		float32(math.Abs(float64(end.X-start.X))),
+		float32(math.Abs(float64(end.Y-start.Y)))))
+	r.selectionBox.Show()
+	r.selectionBox.Refresh()
+}
+
+// refreshCrosshair positions the crosshair lines and readouts at the mouse position, or at the datapoint
+// under the keyboard cursor
+func (r *lineChartRenderer) refreshCrosshair() {
+	r.widget.mapsLock.RLock()
+	pos, xText, yText, keyed := r.widget.keyboardCursorReadout()
+	r.widget.mapsLock.RUnlock()
+	linked := r.widget.crosshairLinked && !keyed
+	if (!r.widget.enableCrosshair || !r.widget.crosshairActive) && !linked && !keyed {
+		for _, line := range r.crosshairLines {
+			line.Hide()
+		}
+		r.crosshairXReadout.Hide()
+		r.crosshairYReadout.Hide()
+		return
+	}
+
+	if !keyed {
+		r.widget.mapsLock.RLock()
+		xText, yText = r.widget.crosshairReadout()
+		r.widget.mapsLock.RUnlock()
+		pos = r.widget.crosshairPosition
+	}
+	vert, horiz := r.crosshairLines[0], r.crosshairLines[1]
+	vert.Position1 = fyne.NewPos(r.pixels.x(pos.X), r.widget.plotMin.Y)
+	vert.Position2 = fyne.NewPos(r.pixels.x(pos.X), r.widget.plotMax.Y)
+	horiz.Position1 = fyne.NewPos(r.widget.plotMin.X, r.pixels.y(pos.Y))
+	horiz.Position2 = fyne.NewPos(r.widget.plotMax.X, r.pixels.y(pos.Y))
+
+	r.crosshairXReadout.Text = xText
+	ts := fyne.MeasureText(xText, r.crosshairXReadout.TextSize, r.crosshairXReadout.TextStyle)
+	xp := pos.X + theme.Padding()
+	if xp+ts.Width > r.widget.plotMax.X {
+		xp = pos.X - ts.Width - theme.Padding()
+	}
+	r.crosshairXReadout.Move(fyne.NewPos(xp, r.widget.plotMax.Y-ts.Height))
+
+	r.crosshairYReadout.Text = yText
+	ts = fyne.MeasureText(yText, r.crosshairYReadout.TextSize, r.crosshairYReadout.TextStyle)
+	r.crosshairYReadout.Move(fyne.NewPos(r.widget.plotMin.X+theme.Padding(), pos.Y-ts.Height))
+
+	for _, line := range r.crosshairLines {
+		line.Show()
+		line.Refresh()
+	}
+	r.crosshairXReadout.Show()
+	r.crosshairXReadout.Refresh()
+	r.crosshairYReadout.Show()
+	r.crosshairYReadout.Refresh()
+	if linked { // only the index is shared with linked charts
+		horiz.Hide()
+		r.crosshairYReadout.Hide()
+	}
+}
+
+// refreshCompareDisplay rebuilds the compare tooltip rows and places it beside the mouse
+func (r *lineChartRenderer) refreshCompareDisplay() {
+	r.widget.mapsLock.RLock()
+	rows := r.widget.compareRows
+	pos := r.widget.comparePosition
+	r.widget.mapsLock.RUnlock()
+
+	if !r.widget.enableMousePointDisplay || r.widget.hoverMode != HoverCompareSeries || len(rows) == 0 {
+		r.compareDisplay.Hide()
+		return
+	}
+
+	content := r.compareDisplay.Objects[1].(*fyne.Container)
+	content.RemoveAll()
+	for _, row := range rows {
+		color := theme.ForegroundColor()
+		if row.color != nil {
+			color = row.color
+		}
+		z := canvas.NewText(row.text, color)
+		z.TextStyle = fyne.TextStyle{Bold: true}
+		content.Add(z)
+	}
+
+	size := r.compareDisplay.MinSize()
+	r.compareDisplay.Resize(size)
+	xp := pos.X + theme.Padding()*3
+	if xp+size.Width > r.widget.Size().Width {
+		xp = pos.X - size.Width - theme.Padding()*3
+	}
+	yp := pos.Y - size.Height/2
+	if yp < 0 {
+		yp = 0
+	}
+	r.compareDisplay.Move(fyne.NewPos(xp, yp))
+	r.compareDisplay.Show()
+	r.compareDisplay.Refresh()
+}
+
+// applyStaleness dims the lines, markers, and legend of series older than the stale threshold
+// and restores them once fresh data arrives; caller must hold the mapsLock
+func (r *lineChartRenderer) applyStaleness() {
+	now := time.Now()
+	for key, points := range r.widget.dataPoints {
+		stale := r.widget.isSeriesStale(key, now)
+		if stale == r.staleSeries[key] {
+			continue
+		}
+		r.staleSeries[key] = stale
+		if r.widget.drawsPooledLines(key) { // pooled lines are colored as they are laid out
+			r.layoutSeries(key)
+			continue
+		}
+		for o, line := range r.dataPoints[key] {
+			idx := r.pointIndex(key, o)
+			if idx >= len(points) {
+				break
+			}
+			c := r.seriesColor(key, points[idx])
+			line.StrokeColor = c
+			r.dataPointMarkers[key][o].FillColor = c
+		}
+	}
+}
+
+// refreshLegendColors colors each legend entry as its series, dimmed when stale and faded when hidden
+// caller must hold the mapsLock
+func (r *lineChartRenderer) refreshLegendColors() {
+	for _, o := range r.colorLegend.Objects {
+		t := o.(*canvas.Text)
+		points := r.widget.dataPoints[t.Text]
+		if len(points) == 0 {
+			continue
+		}
+		c := r.widget.legendColor(t.Text)
+		if r.staleSeries[t.Text] {
+			c = dimColor(c)
+		}
+		if r.widget.hiddenSeries[t.Text] {
+			c = fadeColor(c, hiddenLegendOpacity)
+		}
+		t.Color = c
+	}
+}
+
+// layoutLegend arranges the legend for its position and records each entry's bounds for clicks
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutLegend(s fyne.Size) {
+	position := r.widget.legendPosition
+	table := len(r.widget.legendValues) > 0
+	if table || position == LegendRight || position == LegendFloating {
+		r.colorLegend.Layout = layout.NewVBoxLayout()
+	} else {
+		r.colorLegend.Layout = layout.NewHBoxLayout()
+	}
+	z := r.colorLegend.MinSize()
+	r.colorLegend.Resize(z)
+	r.colorLegend.Layout.Layout(r.colorLegend.Objects, z) // Resize skips the layout when the size is unchanged
+
+	size := z // the whole legend, with the values column when shown
+	if table {
+		vz := r.layoutLegendValues()
+		size = fyne.NewSize(z.Width+theme.Padding()+vz.Width, fyne.Max(z.Height, vz.Height))
+	}
+
+	var pos fyne.Position
+	switch position {
+	case LegendTop:
+		pos = fyne.NewPos(r.widget.plotMin.X, r.widget.plotMin.Y-size.Height)
+	case LegendRight:
+		pos = fyne.NewPos(s.Width-(size.Width+theme.Padding()), r.widget.plotMin.Y)
+	case LegendFloating:
+		pos = r.widget.plotMin.AddXY(theme.Padding(), theme.Padding())
+	default:
+		pos = fyne.NewPos(s.Width-(size.Width+theme.Padding()), (r.yInc*15)+theme.Padding())
+	}
+	r.colorLegend.Move(pos)
+	r.legendValues.Move(pos.AddXY(z.Width+theme.Padding(), 0))
+
+	r.widget.legendBounds = r.widget.legendBounds[:0]
+	for _, o := range r.colorLegend.Objects {
+		topLeft := pos.Add(o.Position())
+		r.widget.legendBounds = append(r.widget.legendBounds, legendBound{
+			series: o.(*canvas.Text).Text,
+			min:    topLeft,
+			max:    topLeft.Add(o.Size()),
+		})
+	}
+}
+
+// layoutPinnedTooltips places a readout box above each pinned datapoint, creating boxes as needed
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutPinnedTooltips() {
+	pins := r.widget.pinnedTooltips
+	for len(r.pinnedDisplays) < len(pins) {
+		frame := canvas.NewRectangle(theme.OverlayBackgroundColor())
+		frame.StrokeWidth = 2.0
+		lines := container.NewVBox(
+			canvas.NewText("", theme.ForegroundColor()),
+			canvas.NewText("", theme.ForegroundColor()),
+		)
+		box := container.NewPadded(frame, lines)
+		box.Hide()
+		r.pinnedDisplays = append(r.pinnedDisplays, box)
+	}
+
+	width := r.widget.Size().Width
+	for idx, box := range r.pinnedDisplays {
+		if idx >= len(pins) || !r.widget.isIndexVisible(pins[idx].index) {
+			box.Hide()
+			continue
+		}
+		value, timestamp, point := r.widget.pinnedTooltipText(pins[idx])
+		if point == nil {
+			box.Hide()
+			continue
+		}
+		box.Objects[0].(*canvas.Rectangle).StrokeColor = r.widget.pointColor(pins[idx].series, point)
+		lines := box.Objects[1].(*fyne.Container).Objects
+		lines[0].(*canvas.Text).Text = value
+		lines[1].(*canvas.Text).Text = timestamp
+
+		size := box.MinSize()
+		box.Resize(size)
+		top, _ := (*point).MarkerPosition()
+		pos := fyne.NewPos(top.X-size.Width/2, top.Y-size.Height-theme.Padding())
+		if pos.X+size.Width > width {
+			pos.X = width - size.Width
+		}
+		if pos.X < 0 {
+			pos.X = 0
+		}
+		if pos.Y < 0 {
+			pos.Y = 0
+		}
+		box.Move(pos)
+		box.Show()
+		box.Refresh()
+	}
+}
+
+// layoutAnnotations draws each annotation as a label joined to its datapoint by a short flag line,
+// creating displays as needed
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutAnnotations() {
+	notes := r.widget.annotations
+	for len(r.annotationDisplays) < len(notes) {
+		flag := canvas.NewLine(theme.ForegroundColor())
+		flag.StrokeWidth = 1.0
+		frame := canvas.NewRectangle(theme.OverlayBackgroundColor())
+		frame.StrokeWidth = 1.0
+		text := canvas.NewText("", theme.ForegroundColor())
+		text.TextSize = theme.CaptionTextSize()
+		display := container.NewWithoutLayout(flag, frame, text)
+		display.Hide()
+		r.annotationDisplays = append(r.annotationDisplays, display)
+	}
+
+	for idx, display := range r.annotationDisplays {
+		if idx >= len(notes) {
+			display.Hide()
+			continue
+		}
+		pos, size, ok := r.widget.annotationLabel(notes[idx])
+		if !ok {
+			display.Hide()
+			continue
+		}
+		point := r.widget.dataPoints[notes[idx].Series][notes[idx].Index]
+		c := r.widget.pointColor(notes[idx].Series, point)
+		top, bottom := (*point).MarkerPosition()
+
+		flag := display.Objects[0].(*canvas.Line)
+		flag.StrokeColor = c
+		flag.Position1 = fyne.NewPos((top.X+bottom.X)/2, top.Y)
+		flag.Position2 = fyne.NewPos(pos.X+size.Width/2, pos.Y+size.Height)
+		frame := display.Objects[1].(*canvas.Rectangle)
+		frame.StrokeColor = c
+		frame.Move(pos)
+		frame.Resize(size)
+		text := display.Objects[2].(*canvas.Text)
+		text.Text = notes[idx].Text
+		text.Move(fyne.NewPos(pos.X+theme.Padding(), pos.Y+theme.Padding()/2))
+
+		display.Show()
+		display.Refresh()
+	}
+}
+
+// layoutBands collects the area fills and the confidence interval of each shown series for the band raster
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutBands() {
+	bands := r.layoutFills()
+	for series, data := range r.widget.dataPoints {
+		if r.widget.hiddenSeries[series] {
+			continue
+		}
+		var band confidenceBand
+		for idx := 1; idx < len(data); idx++ {
+			lower1, upper1, ok1 := (*data[idx-1]).Bounds()
+			lower2, upper2, ok2 := (*data[idx]).Bounds()
+			x1, x2 := r.widget.pointX(idx-1, *data[idx-1]), r.widget.pointX(idx, *data[idx])
+			if !ok1 || !ok2 || !r.widget.isXVisible(x1) || !r.widget.isXVisible(x2) {
+				continue
+			}
+			low1 := r.widget.dataToPosition(x1, lower1).Subtract(r.widget.plotMin)
+			high1 := r.widget.dataToPosition(x1, upper1).Subtract(r.widget.plotMin)
+			low2 := r.widget.dataToPosition(x2, lower2).Subtract(r.widget.plotMin)
+			high2 := r.widget.dataToPosition(x2, upper2).Subtract(r.widget.plotMin)
+			band.segments = append(band.segments, bandSegment{
+				x1: low1.X, lower1: low1.Y, upper1: high1.Y,
+				x2: low2.X, lower2: low2.Y, upper2: high2.Y,
+			})
+			band.color = fadeColor(r.seriesColor(series, data[idx]), confidenceBandOpacity)
+		}
+		if len(band.segments) > 0 {
+			bands = append(bands, band)
+		}
+	}
+	r.bands.Store(bands)
+
+	if len(bands) == 0 {
+		r.bandRaster.Hide()
+		return
+	}
+	r.bandRaster.Move(r.widget.plotMin)
+	r.bandRaster.Resize(fyne.NewSize(r.widget.plotMax.X-r.widget.plotMin.X, r.widget.plotMax.Y-r.widget.plotMin.Y))
+	r.bandRaster.Show()
+	r.bandRaster.Refresh()
+}
+
+// drawBands paints each confidence band column by column, interpolating bounds between datapoints
+func (r *lineChartRenderer) drawBands(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	bands, _ := r.bands.Load().([]confidenceBand)
+	size := r.bandRaster.Size()
+	if w == 0 || h == 0 || size.Width <= 0 || size.Height <= 0 {
+		return img
+	}
+	scaleX := size.Width / float32(w)
+	scaleY := size.Height / float32(h)
+	for _, band := range bands {
+		fill := image.NewUniform(band.color)
+		for _, seg := range band.segments {
+			if seg.x2 <= seg.x1 {
+				continue
+			}
+			for px := int(seg.x1 / scaleX); px < int(seg.x2/scaleX); px++ {
+				t := ((float32(px)+0.5)*scaleX - seg.x1) / (seg.x2 - seg.x1)
+				top := seg.upper1 + (seg.upper2-seg.upper1)*t
+				bottom := seg.lower1 + (seg.lower2-seg.lower1)*t
+				rect := image.Rect(px, int(top/scaleY), px+1, int(math.Ceil(float64(bottom/scaleY))))
+				draw.Draw(img, rect, fill, image.Point{}, draw.Over)
+			}
+		}
+	}
+	return img
+}
+
+// layoutTimeBands shades each run of datapoint indexes whose timestamps fall inside the same time band
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutTimeBands() {
+	used := 0
+	if len(r.widget.timeBands) > 0 {
+		count := 0
+		for _, points := range r.widget.dataPoints {
+			if len(points) > count {
+				count = len(points)
+			}
+		}
+		runStart := -1
+		var runBand TimeBand
+		for idx := 0; idx <= count; idx++ {
+			band, inside := TimeBand{}, false
+			if idx < count {
+				band, inside = r.widget.timeBandAt(idx)
+			}
+			if runStart >= 0 && (!inside || band.Name != runBand.Name) {
+				used = r.shadeIndexes(used, runStart, idx-1, runBand)
+				runStart = -1
+			}
+			if inside && runStart < 0 {
+				runStart, runBand = idx, band
+			}
+		}
+	}
+	for idx := used; idx < len(r.timeBandRects); idx++ {
+		r.timeBandRects[idx].Hide()
+	}
+}
+
+// shadeIndexes covers indexes first through last with the pooled rectangle at used, returns the next unused index
+func (r *lineChartRenderer) shadeIndexes(used, first, last int, band TimeBand) int {
+	left := r.widget.dataToPosition(float32(first)-0.5, 0).X
+	right := r.widget.dataToPosition(float32(last)+0.5, 0).X
+	if left < r.widget.plotMin.X {
+		left = r.widget.plotMin.X
+	}
+	if right > r.widget.plotMax.X {
+		right = r.widget.plotMax.X
+	}
+	if right <= left {
+		return used
+	}
+	c := theme.ForegroundColor()
+	if band.ColorName != "" {
+		c = theme.PrimaryColorNamed(band.ColorName)
+	}
+	if used == len(r.timeBandRects) {
+		r.timeBandRects = append(r.timeBandRects, canvas.NewRectangle(c))
+	}
+	rect := r.timeBandRects[used]
+	rect.FillColor = fadeColor(r.widget.cvdColor(c), timeBandOpacity)
+	rect.Move(fyne.NewPos(left, r.widget.plotMin.Y))
+	rect.Resize(fyne.NewSize(right-left, r.widget.plotMax.Y-r.widget.plotMin.Y))
+	rect.Show()
+	rect.Refresh()
+	return used + 1
+}
+
+// layoutGapMarkers draws a vertical event marker midway across each visible gap when enabled
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutGapMarkers() {
+	used := 0
+	if r.widget.enableGapMarkers {
+		for _, idx := range r.widget.gapIndexes() {
+			if !r.widget.isIndexVisible(idx-1) || !r.widget.isIndexVisible(idx) {
+				continue
+			}
+			if used == len(r.gapMarkers) {
+				marker := canvas.NewLine(theme.ErrorColor())
+				marker.StrokeWidth = 1.0
+				r.gapMarkers = append(r.gapMarkers, marker)
+			}
+			marker := r.gapMarkers[used]
+			x := r.pixels.x(r.widget.dataToPosition(float32(idx)-0.5, 0).X)
+			marker.StrokeColor = theme.ErrorColor()
+			marker.Position1 = fyne.NewPos(x, r.widget.plotMin.Y)
+			marker.Position2 = fyne.NewPos(x, r.widget.plotMax.Y)
+			marker.Show()
+			marker.Refresh()
+			used++
+		}
+	}
+	for idx := used; idx < len(r.gapMarkers); idx++ {
+		r.gapMarkers[idx].Hide()
+	}
+}
+
+// layoutForecasts draws each forecast as dashes continuing from its series' latest datapoint,
+// and shades the plot area beyond the earliest "now" boundary
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutForecasts() {
+	used := 0
+	nowX := r.widget.plotMax.X
+	for series, forecast := range r.widget.forecasts {
+		actual := r.widget.dataPoints[series]
+		if len(actual) == 0 || r.widget.hiddenSeries[series] {
+			continue
+		}
+		now := len(actual) - 1
+		lastX := r.widget.pointX(now, *actual[now])
+		last := r.widget.dataToPosition(lastX, (*actual[now]).Value())
+		if last.X < nowX {
+			nowX = last.X
+		}
+		c := r.seriesColor(series, actual[now])
+		stroke := r.seriesStroke(series)
+		joined := !(*actual[now]).IsMissing() // no dash from a missing latest sample
+		for idx, point := range forecast {
+			nextX := r.widget.pointX(now+1+idx, point) // placed as the series' own points, by x value, timestamp, or index
+			next := r.widget.dataToPosition(nextX, point.Value())
+			if joined && r.widget.isXVisible(lastX) && r.widget.isXVisible(nextX) {
+				used, _ = dashLine(&r.forecastDashes, used, last, next, c, stroke, forecastDashPattern, 0)
+			}
+			last, lastX = next, nextX
+			joined = true
+		}
+	}
+	for idx := used; idx < len(r.forecastDashes); idx++ {
+		r.forecastDashes[idx].Hide()
+	}
+
+	if len(r.widget.forecasts) == 0 || nowX >= r.widget.plotMax.X {
+		r.forecastRegion.Hide()
+		return
+	}
+	if nowX < r.widget.plotMin.X {
+		nowX = r.widget.plotMin.X
+	}
+	r.forecastRegion.FillColor = fadeColor(theme.ForegroundColor(), forecastRegionOpacity)
+	r.forecastRegion.Move(fyne.NewPos(nowX, r.widget.plotMin.Y))
+	r.forecastRegion.Resize(fyne.NewSize(r.widget.plotMax.X-nowX, r.widget.plotMax.Y-r.widget.plotMin.Y))
+	r.forecastRegion.Show()
+	r.forecastRegion.Refresh()
+}
+
+// removeLegend drops the series name from the color legend
+func (r *lineChartRenderer) removeLegend(series string) {
+	for _, o := range r.colorLegend.Objects {
+		if o.(*canvas.Text).Text == series {
+			r.colorLegend.Remove(o)
+			return
+		}
+	}
+}
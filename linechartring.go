@@ -0,0 +1,43 @@
+package sknlinechart
+
+// Each series keeps its datapoints in a ring buffer of twice its point limit. Once the series is
+// full, appending slides the series' window one slot along the ring instead of shifting every
+// point, and the window is unrolled back to the start of the ring when it reaches the end, one
+// copy per point limit appends. Readers still see the series as one contiguous slice.
+
+// ringAppend appends the datapoint to the series, rolling off its oldest point first when asked
+// caller must hold the mapsLock
+func (w *LineChartSkn) ringAppend(seriesName string, newDataPoint *ChartDatapoint, dropOldest bool) {
+	points := w.dataPoints[seriesName]
+	ring := w.pointRings[seriesName]
+	if dropOldest && len(points) > 0 {
+		if sharesRing(points, ring) {
+			points[0] = nil // its slot is left behind on the ring
+		}
+		points = points[1:]
+	}
+	size := 2 * (w.seriesPointLimit(seriesName) + 1)
+	if cap(ring) != size || len(points) >= size {
+		ring = make([]*ChartDatapoint, size)
+		if w.pointRings == nil {
+			w.pointRings = map[string][]*ChartDatapoint{}
+		}
+		w.pointRings[seriesName] = ring
+	}
+	if !sharesRing(points, ring) || len(points) == cap(points) { // replaced since, or the window reached the ring's end
+		n := copy(ring, points)
+		for i := n; i < len(ring); i++ {
+			ring[i] = nil // no longer referenced by the series
+		}
+		points = ring[:n]
+	}
+	w.dataPoints[seriesName] = append(points, newDataPoint)
+}
+
+// sharesRing returns true when the points are a window onto the ring
+func sharesRing(points, ring []*ChartDatapoint) bool {
+	if cap(points) == 0 || cap(ring) == 0 {
+		return false
+	}
+	return &points[:cap(points)][cap(points)-1] == &ring[:cap(ring)][cap(ring)-1]
+}
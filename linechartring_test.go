@@ -0,0 +1,83 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Ring buffered series", func() {
+	var lc sknlinechart.LineChart
+
+	feed := func(series string, from, count int) {
+		ts := time.Now().Format(time.RFC1123)
+		for i := from; i < from+count; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(i), theme.ColorBlue, ts)
+			lc.ApplyDataPoint(series, &point)
+		}
+	}
+	values := func(series string) []float32 {
+		var got []float32
+		for _, point := range lc.GetDataSeries(series) {
+			got = append(got, point.Value())
+		}
+		return got
+	}
+	latest := func(last, count int) []float32 {
+		var want []float32
+		for i := last - count + 1; i <= last; i++ {
+			want = append(want, float32(i))
+		}
+		return want
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithXLimit(20)))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+	})
+
+	It("should keep the latest points in order as the feed wraps the ring many times", func() {
+		feed("Fast", 0, 1000)
+		Expect(values("Fast")).To(Equal(latest(999, 21)))
+	})
+	It("should follow the series' limit as it changes", func() {
+		feed("Fast", 0, 100)
+		Expect(lc.SetSeriesPointLimit("Fast", 5)).To(Succeed())
+		feed("Fast", 100, 37)
+		Expect(values("Fast")).To(Equal(latest(136, 6)))
+
+		Expect(lc.SetSeriesPointLimit("Fast", 0)).To(Succeed())
+		feed("Fast", 137, 50)
+		Expect(values("Fast")).To(Equal(latest(186, 21)))
+	})
+	It("should start afresh once the series is cleared, replaced, or renamed", func() {
+		feed("Fast", 0, 45)
+		Expect(lc.ClearSeries("Fast")).To(Succeed())
+		feed("Fast", 45, 3)
+		Expect(values("Fast")).To(Equal(latest(47, 3)))
+
+		var replacement []*sknlinechart.ChartDatapoint
+		for i := 0; i < 20; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(500+i), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			replacement = append(replacement, &point)
+		}
+		Expect(lc.ApplyDataSeries("Fast", replacement)).To(Succeed())
+		feed("Fast", 520, 30)
+		Expect(values("Fast")).To(Equal(latest(549, 21)))
+
+		Expect(lc.RenameSeries("Fast", "Renamed")).To(Succeed())
+		feed("Renamed", 550, 30)
+		Expect(values("Renamed")).To(Equal(latest(579, 21)))
+	})
+	It("should keep annotations on their points as they roll along the ring", func() {
+		feed("Fast", 0, 21)
+		Expect(lc.AddAnnotation("Fast", 20, "spike")).To(Succeed())
+		feed("Fast", 21, 15)
+		Expect(lc.GetAnnotations()).To(Equal([]sknlinechart.Annotation{{Series: "Fast", Index: 5, Text: "spike"}}))
+	})
+})
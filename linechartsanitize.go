@@ -0,0 +1,117 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"math"
+)
+
+// NonFiniteValuePolicy decides what happens to datapoints whose value is NaN or infinite
+type NonFiniteValuePolicy int
+
+const (
+	// NonFiniteDrop rejects the datapoint, counted as dropped by the chart's metrics
+	NonFiniteDrop NonFiniteValuePolicy = iota
+	// NonFiniteClamp keeps infinite values at the edge of the chart's value range, NaN is still dropped
+	NonFiniteClamp
+	// NonFiniteGap keeps NaN values as missing samples that break the series' line, infinite values are dropped
+	NonFiniteGap
+)
+
+// defaultMaxTextLength characters of a popup or readout kept before it is clipped
+const defaultMaxTextLength = 256
+
+// GetNonFiniteValuePolicy returns how NaN and infinite datapoint values are handled on ingest
+func (w *LineChartSkn) GetNonFiniteValuePolicy() NonFiniteValuePolicy {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.nonFinitePolicy
+}
+
+// SetNonFiniteValuePolicy sets how NaN and infinite datapoint values are handled on ingest,
+// NonFiniteGap by default. Points whose value becomes non-finite after ingest are never drawn
+func (w *LineChartSkn) SetNonFiniteValuePolicy(policy NonFiniteValuePolicy) {
+	w.mapsLock.Lock()
+	w.nonFinitePolicy = policy
+	w.mapsLock.Unlock()
+}
+
+// GetMaxTextLength returns the characters of popup and readout text shown before it is clipped
+func (w *LineChartSkn) GetMaxTextLength() int {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.maxTextLength
+}
+
+// SetMaxTextLength clips popup and readout text, which carries series names and timestamps
+// from the data feed, to length characters; 0 shows text in full
+func (w *LineChartSkn) SetMaxTextLength(length int) error {
+	if length < 0 {
+		return fmt.Errorf("SetMaxTextLength() length cannot be negative: %d", length)
+	}
+	w.mapsLock.Lock()
+	w.maxTextLength = length
+	w.mapsLock.Unlock()
+	w.Refresh()
+	return nil
+}
+
+// acceptDatapoint applies the ingest policies to a datapoint, false when it must be dropped
+// caller must hold the mapsLock
+func (w *LineChartSkn) acceptDatapoint(point *ChartDatapoint) bool {
+	if point == nil || *point == nil {
+		return false
+	}
+	value := (*point).Value()
+	if isFinite(value) {
+		return true
+	}
+	nan := math.IsNaN(float64(value))
+	switch {
+	case nan && w.nonFinitePolicy == NonFiniteGap: // kept as a missing sample
+		return true
+	case !nan && w.nonFinitePolicy == NonFiniteClamp && value > 0:
+		(*point).SetValue(w.dataPointYLimit)
+		return true
+	case !nan && w.nonFinitePolicy == NonFiniteClamp:
+		(*point).SetValue(0)
+		return true
+	}
+	return false
+}
+
+// acceptDataSeries returns the series without the datapoints the ingest policies drop, and
+// the count dropped; the series itself is returned when every point is accepted
+// caller must hold the mapsLock
+func (w *LineChartSkn) acceptDataSeries(series []*ChartDatapoint) ([]*ChartDatapoint, int) {
+	for idx, point := range series {
+		if w.acceptDatapoint(point) {
+			continue
+		}
+		accepted := append([]*ChartDatapoint(nil), series[:idx]...)
+		for _, rest := range series[idx+1:] {
+			if w.acceptDatapoint(rest) {
+				accepted = append(accepted, rest)
+			}
+		}
+		return accepted, len(series) - len(accepted)
+	}
+	return series, 0
+}
+
+// clipText shortens text beyond the max text length, marking the cut with an ellipsis
+func (w *LineChartSkn) clipText(text string) string {
+	if w.maxTextLength <= 0 || len(text) <= w.maxTextLength {
+		return text
+	}
+	runes := []rune(text)
+	if len(runes) <= w.maxTextLength {
+		return text
+	}
+	return string(runes[:w.maxTextLength]) + "…"
+}
+
+// isFinite returns true when the value is neither NaN nor infinite
+func isFinite(v float32) bool {
+	f := float64(v)
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
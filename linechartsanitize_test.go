@@ -0,0 +1,115 @@
+package sknlinechart_test
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Hostile data feeds", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	point := func(value float32) sknlinechart.ChartDatapoint {
+		return sknlinechart.NewChartDatapoint(value, theme.ColorBlue, time.Now().Format(time.RFC1123))
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+		win.Close()
+	})
+
+	It("should drop nil and non-finite datapoints when asked", func() {
+		Expect(lc.GetNonFiniteValuePolicy()).To(Equal(sknlinechart.NonFiniteGap))
+		lc.SetNonFiniteValuePolicy(sknlinechart.NonFiniteDrop)
+		var missing sknlinechart.ChartDatapoint
+		nan, inf, good := point(float32(math.NaN())), point(float32(math.Inf(1))), point(42)
+
+		lc.ApplyDataPoint("Feed", nil)
+		lc.ApplyDataPoint("Feed", &missing)
+		lc.ApplyDataPoint("Feed", &nan)
+		lc.ApplyDataPoints("Feed", []sknlinechart.ChartDatapoint{inf, nil, good})
+		Expect(lc.GetDataSeries("Feed")).To(HaveLen(1))
+
+		Expect(lc.ApplyDataSeries("Batch", []*sknlinechart.ChartDatapoint{nil, &nan, &good, &missing})).To(Succeed())
+		Expect(lc.GetDataSeries("Batch")).To(HaveLen(1))
+		Expect(lc.ReplaceAllDataSeries(map[string][]*sknlinechart.ChartDatapoint{"Feed": {&inf, &good, nil}})).To(Succeed())
+		Expect(lc.GetDataSeries("Feed")).To(HaveLen(1))
+		Expect(lc.GetDataSeries("Feed")[0].Value()).To(BeNumerically("==", 42))
+		Expect(lc.Metrics().PointsDropped).To(BeNumerically(">=", 9))
+	})
+	It("should clamp infinite values to the value range when asked", func() {
+		lc.SetNonFiniteValuePolicy(sknlinechart.NonFiniteClamp)
+		high, low, nan := point(float32(math.Inf(1))), point(float32(math.Inf(-1))), point(float32(math.NaN()))
+		lc.ApplyDataPoints("Feed", []sknlinechart.ChartDatapoint{high, low, nan})
+
+		series := lc.GetDataSeries("Feed")
+		Expect(series).To(HaveLen(2))
+		Expect(series[0].Value()).To(BeNumerically("==", lc.GetViewport().YMax))
+		Expect(series[1].Value()).To(BeNumerically("==", 0))
+	})
+	It("should not draw a point whose value stops being a number after ingest", func() {
+		points := []sknlinechart.ChartDatapoint{point(10), point(20), point(30)}
+		lc.ApplyDataPoints("Feed", points)
+		points[1].SetValue(float32(math.NaN()))
+		lc.SetViewport(lc.GetViewport()) // relayout the series
+
+		top, _ := points[1].MarkerPosition()
+		Expect(top.IsZero()).To(BeTrue())
+		top, _ = points[2].MarkerPosition()
+		Expect(top.IsZero()).To(BeFalse())
+	})
+	It("should clip enormous names and timestamps in readouts", func() {
+		Expect(lc.GetMaxTextLength()).To(Equal(256))
+		Expect(lc.SetMaxTextLength(-1)).To(HaveOccurred())
+		Expect(lc.SetMaxTextLength(40)).To(Succeed())
+		huge := sknlinechart.NewChartDatapoint(50, theme.ColorBlue, strings.Repeat("☃", 10000))
+		lc.ApplyDataPoint(strings.Repeat("S", 10000), &huge)
+
+		lc.(fyne.Focusable).TypedKey(&fyne.KeyEvent{Name: fyne.KeyEnd})
+		Expect(visibleText(lc, strings.Repeat("S", 40)+"…")).NotTo(BeNil())
+	})
+	It("should survive producers mutating the chart while it refreshes", func() {
+		var wg sync.WaitGroup
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					p := point(float32(i % 100))
+					switch g {
+					case 0:
+						lc.ApplyDataPoint("A", &p)
+					case 1:
+						_ = lc.ApplyDataSeries("B", []*sknlinechart.ChartDatapoint{&p, &p})
+					case 2:
+						_ = lc.SetViewport(sknlinechart.ChartViewport{XMin: 0, XMax: float32(50 + i%100), YMin: 0, YMax: 130})
+					default:
+						if i%40 == 0 {
+							lc.ClearAllData()
+						}
+						lc.Refresh()
+					}
+				}
+			}(g)
+		}
+		wg.Wait()
+		Expect(len(lc.GetDataSeries("A"))).To(BeNumerically("<=", 151))
+	})
+})
@@ -0,0 +1,171 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// settingsColorNames theme colors offered for a series by the settings dialog, after the
+// choice keeping the colors of the datapoints themselves
+var settingsColorNames = []string{
+	theme.ColorRed,
+	theme.ColorOrange,
+	theme.ColorYellow,
+	theme.ColorGreen,
+	theme.ColorBlue,
+	theme.ColorPurple,
+	theme.ColorBrown,
+	theme.ColorGray,
+}
+
+// ShowChartSettingsDialog shows NewChartSettingsDialog over the window
+func ShowChartSettingsDialog(chart LineChart, parent fyne.Window) {
+	NewChartSettingsDialog(chart, parent).Show()
+}
+
+// NewChartSettingsDialog returns a dialog editing the chart's labels, value range, limits, display
+// toggles, and series colors. Changes apply to the chart as they are made; invalid values are
+// flagged on their field and leave the chart unchanged. Its text follows the chart's translator
+func NewChartSettingsDialog(chart LineChart, parent fyne.Window) dialog.Dialog {
+	msg := chart.Message
+	labels := widget.NewForm(
+		widget.NewFormItem(msg(MessageSettingsTitle), settingsEntry(chart.GetTitle(), applyText(chart.SetTitle))),
+		widget.NewFormItem(msg(MessageSettingsFooter), settingsEntry(chart.GetBottomCenteredLabel(), applyText(chart.SetBottomCenteredLabel))),
+		widget.NewFormItem(msg(MessageSettingsTopLeft), settingsEntry(chart.GetTopLeftLabel(), applyText(chart.SetTopLeftLabel))),
+		widget.NewFormItem(msg(MessageSettingsTopRight), settingsEntry(chart.GetTopRightLabel(), applyText(chart.SetTopRightLabel))),
+		widget.NewFormItem(msg(MessageSettingsBottomLeft), settingsEntry(chart.GetBottomLeftLabel(), applyText(chart.SetBottomLeftLabel))),
+		widget.NewFormItem(msg(MessageSettingsBottomRight), settingsEntry(chart.GetBottomRightLabel(), applyText(chart.SetBottomRightLabel))),
+		widget.NewFormItem(msg(MessageSettingsLeftScale), settingsEntry(chart.GetMiddleLeftLabel(), applyText(chart.SetMiddleLeftLabel))),
+		widget.NewFormItem(msg(MessageSettingsRightScale), settingsEntry(chart.GetMiddleRightLabel(), applyText(chart.SetMiddleRightLabel))),
+	)
+
+	vp := chart.GetViewport()
+	ranges := widget.NewForm(
+		widget.NewFormItem(msg(MessageSettingsValueMin), settingsEntry(fmt.Sprint(vp.YMin), func(s string) error {
+			return applyValueRange(chart, s, func(vp *ChartViewport, v float32) { vp.YMin = v })
+		})),
+		widget.NewFormItem(msg(MessageSettingsValueMax), settingsEntry(fmt.Sprint(vp.YMax), func(s string) error {
+			return applyValueRange(chart, s, func(vp *ChartViewport, v float32) { vp.YMax = v })
+		})),
+		widget.NewFormItem(msg(MessageSettingsTimeWindow), settingsEntry(formatTimeWindow(chart.GetTimeWindow()), func(s string) error {
+			d := time.Duration(0)
+			if s != "" {
+				var err error
+				if d, err = time.ParseDuration(s); err != nil {
+					return err
+				}
+			}
+			return chart.SetTimeWindow(d)
+		})),
+		widget.NewFormItem(msg(MessageSettingsLineStroke), settingsEntry(fmt.Sprint(chart.GetLineStrokeSize()), func(s string) error {
+			size, err := strconv.ParseFloat(s, 32)
+			if err != nil || size <= 0 {
+				return fmt.Errorf("stroke must be a positive number: %q", s)
+			}
+			chart.SetLineStrokeSize(float32(size))
+			return nil
+		})),
+	)
+
+	display := widget.NewForm(
+		widget.NewFormItem(msg(MessageSettingsMarkers), settingsCheck(chart.IsDataPointMarkersEnabled(), chart.SetDataPointMarkers)),
+		widget.NewFormItem(msg(MessageSettingsHorizGrid), settingsCheck(chart.IsHorizGridLinesEnabled(), chart.SetHorizGridLines)),
+		widget.NewFormItem(msg(MessageSettingsVertGrid), settingsCheck(chart.IsVertGridLinesEnabled(), chart.SetVertGridLines)),
+		widget.NewFormItem(msg(MessageSettingsLegend), settingsCheck(chart.IsLegendVisible(), chart.SetLegendVisible)),
+		widget.NewFormItem(msg(MessageSettingsHoverPopup), settingsCheck(chart.IsMousePointDisplayEnabled(), chart.SetMousePointDisplay)),
+		widget.NewFormItem(msg(MessageSettingsCrosshair), settingsCheck(chart.IsCrosshairEnabled(), chart.SetCrosshairEnabled)),
+		widget.NewFormItem(msg(MessageSettingsDownsampling), settingsCheck(chart.IsDownsamplingEnabled(), chart.SetDownsampling)),
+		widget.NewFormItem(msg(MessageSettingsPixelSnapping), settingsCheck(chart.IsPixelSnappingEnabled(), chart.SetPixelSnapping)),
+	)
+
+	series := widget.NewForm()
+	pointColors := msg(MessageSettingsPointColors)
+	for _, name := range chart.GetSeriesNames() {
+		name := name
+		color := widget.NewSelect(append([]string{pointColors}, settingsColorNames...), nil)
+		color.SetSelected(pointColors) // before OnChanged, an app's own color rule stays until a color is picked
+		color.OnChanged = func(selected string) {
+			if selected == pointColors {
+				chart.SetSeriesColorRule(name, nil)
+				return
+			}
+			chart.SetSeriesColorRule(name, func(float64) string { return selected })
+		}
+		limit := settingsEntry(strconv.Itoa(chart.GetSeriesPointLimit(name)), func(s string) error {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				return fmt.Errorf("point limit must be a whole number: %q", s)
+			}
+			return chart.SetSeriesPointLimit(name, n)
+		})
+		series.Append(name, container.NewGridWithColumns(2, color, limit))
+	}
+
+	content := container.NewVBox(
+		widget.NewCard(msg(MessageSettingsLabels), "", labels),
+		widget.NewCard(msg(MessageSettingsRanges), "", ranges),
+		widget.NewCard(msg(MessageSettingsDisplay), "", display),
+		widget.NewCard(msg(MessageSettingsSeries), msg(MessageSettingsSeriesHint), series),
+	)
+	dlg := dialog.NewCustom(msg(MessageSettings), msg(MessageClose), container.NewVScroll(content), parent)
+	dlg.Resize(fyne.NewSize(440, 600))
+	return dlg
+}
+
+// settingsEntry returns an entry applying its text to the chart as it is edited,
+// text rejected by apply is flagged on the entry
+func settingsEntry(text string, apply func(string) error) *widget.Entry {
+	entry := widget.NewEntry()
+	entry.SetText(text)
+	entry.Validator = func(string) error { return nil } // errors come from apply, once the text is applied
+	entry.OnChanged = func(s string) {
+		entry.SetValidationError(apply(s))
+	}
+	return entry
+}
+
+// settingsCheck returns a check applying its state to the chart as it is toggled
+func settingsCheck(checked bool, apply func(bool)) *widget.Check {
+	check := widget.NewCheck("", nil)
+	check.SetChecked(checked)
+	check.OnChanged = apply
+	return check
+}
+
+// applyText adapts a label setter to a settings entry
+func applyText(set func(string)) func(string) error {
+	return func(s string) error {
+		set(s)
+		return nil
+	}
+}
+
+// applyValueRange zooms the chart to the edited value range, keeping the index range
+func applyValueRange(chart LineChart, s string, set func(vp *ChartViewport, v float32)) error {
+	v, err := strconv.ParseFloat(s, 32)
+	if err != nil {
+		return fmt.Errorf("value must be a number: %q", s)
+	}
+	current := chart.GetViewport()
+	vp := current
+	set(&vp, float32(v))
+	if vp == current {
+		return nil
+	}
+	return chart.SetViewport(vp)
+}
+
+// formatTimeWindow shows a disabled time window as empty
+func formatTimeWindow(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return d.String()
+}
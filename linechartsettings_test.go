@@ -0,0 +1,104 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Settings dialog", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	// formItem finds the settings field labelled text in the dialog shown over the window
+	formItem := func(text string) fyne.CanvasObject {
+		var found fyne.CanvasObject
+		var walk func(objs []fyne.CanvasObject)
+		walk = func(objs []fyne.CanvasObject) {
+			for _, o := range objs {
+				if found != nil {
+					return
+				}
+				if form, ok := o.(*widget.Form); ok {
+					for _, item := range form.Items {
+						if item.Text == text {
+							found = item.Widget
+							return
+						}
+					}
+				}
+				switch v := o.(type) {
+				case *fyne.Container:
+					walk(v.Objects)
+				case fyne.Widget:
+					walk(test.WidgetRenderer(v).Objects())
+				}
+			}
+		}
+		walk(win.Canvas().Overlays().List())
+		return found
+	}
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "Settings", 20)
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 600))
+		sknlinechart.ShowChartSettingsDialog(lc, win)
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should apply labels and toggles as they are edited", func() {
+		title := formItem("Title").(*widget.Entry)
+		Expect(title.Text).To(Equal("Testing"))
+		title.SetText("Edited")
+		Expect(lc.GetTitle()).To(Equal("Edited"))
+
+		formItem("Markers").(*widget.Check).SetChecked(false)
+		Expect(lc.IsDataPointMarkersEnabled()).To(BeFalse())
+		formItem("Crosshair").(*widget.Check).SetChecked(true)
+		Expect(lc.IsCrosshairEnabled()).To(BeTrue())
+	})
+	It("should flag invalid values and leave the chart unchanged", func() {
+		window := formItem("Time window").(*widget.Entry)
+		window.SetText("soon")
+		Expect(window.Validate()).To(Succeed()) // the validator only clears, errors come from applying
+		Expect(lc.GetTimeWindow()).To(BeZero())
+		window.SetText("10m")
+		Expect(lc.GetTimeWindow()).To(Equal(10 * time.Minute))
+
+		formItem("Value max").(*widget.Entry).SetText("80")
+		Expect(lc.GetViewport().YMax).To(BeNumerically("==", 80))
+		formItem("Value min").(*widget.Entry).SetText("90")
+		Expect(lc.GetViewport().YMin).To(BeNumerically("==", 0))
+	})
+	It("should color a series and limit its points", func() {
+		row := formItem("Testing").(*fyne.Container)
+		row.Objects[0].(*widget.Select).SetSelected(theme.ColorPurple)
+		Expect(seriesLines(lc, theme.PrimaryColorNamed(theme.ColorPurple))).NotTo(BeEmpty())
+
+		row.Objects[1].(*widget.Entry).SetText("10")
+		Expect(lc.GetDataSeries("Testing")).To(HaveLen(10))
+	})
+	It("should label its fields through the chart's translator", func() {
+		win.Canvas().Overlays().Remove(win.Canvas().Overlays().Top())
+		lc.SetTranslator(func(key string) string {
+			return map[string]string{sknlinechart.MessageSettingsMarkers: "Markierungen"}[key]
+		})
+		sknlinechart.ShowChartSettingsDialog(lc, win)
+
+		Expect(formItem("Markers")).To(BeNil())
+		formItem("Markierungen").(*widget.Check).SetChecked(false)
+		Expect(lc.IsDataPointMarkersEnabled()).To(BeFalse())
+		Expect(formItem("Crosshair")).NotTo(BeNil(), "untranslated keys stay English")
+	})
+})
@@ -0,0 +1,95 @@
+package sknlinechart
+
+// dataSinkCapacity points buffered by the DataSink before producers wait on the chart
+const dataSinkCapacity = 1024
+
+// SeriesPoint a datapoint sent through the DataSink, with the series it is applied to
+type SeriesPoint struct {
+	Series string
+	Point  ChartDatapoint
+}
+
+// DataSink returns the channel producers send datapoints into, so they never call into the chart.
+// The chart's own goroutine drains it, applying whatever has arrived as one batch per series with
+// ApplyDataPoints, so a fast producer costs one redraw per batch rather than one per point. The
+// channel buffers dataSinkCapacity points; once full, senders wait until the chart catches up.
+// Every call returns the same channel until the chart sees it closed, which stops the goroutine;
+// the next call then starts another. Destroying the chart's renderer also stops it, points sent
+// afterwards are no longer applied
+func (w *LineChartSkn) DataSink() chan<- SeriesPoint {
+	w.debugLog("LineChartSkn::DataSink()")
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	if w.sink == nil {
+		w.sink = make(chan SeriesPoint, dataSinkCapacity)
+		w.sinkStop = make(chan struct{})
+		go w.drainSink(w.sink, w.sinkStop)
+	}
+	return w.sink
+}
+
+// drainSink applies the points sent to the sink until it is closed or stop is
+func (w *LineChartSkn) drainSink(sink chan SeriesPoint, stop chan struct{}) {
+	for {
+		var point SeriesPoint
+		open := true
+		select {
+		case <-stop:
+			return
+		case point, open = <-sink:
+		}
+		if !open {
+			w.releaseSink(sink)
+			return
+		}
+		order := []string{point.Series}
+		batch := map[string][]ChartDatapoint{point.Series: {point.Point}}
+	gather: // everything already waiting joins the batch
+		for count := 1; count < dataSinkCapacity; count++ {
+			select {
+			case point, open = <-sink:
+				if !open {
+					w.releaseSink(sink) // before the last batch, so producers asking again get a new sink
+					break gather
+				}
+				if _, ok := batch[point.Series]; !ok {
+					order = append(order, point.Series)
+				}
+				batch[point.Series] = append(batch[point.Series], point.Point)
+			default:
+				break gather
+			}
+		}
+		select {
+		case <-stop: // destroyed while gathering
+			return
+		default:
+		}
+		for _, series := range order {
+			w.ApplyDataPoints(series, batch[series])
+		}
+		if !open {
+			return
+		}
+	}
+}
+
+// releaseSink forgets the closed sink, the next DataSink call starting another
+func (w *LineChartSkn) releaseSink(sink chan SeriesPoint) {
+	w.mapsLock.Lock()
+	if w.sink == sink {
+		w.sink = nil
+		w.sinkStop = nil
+	}
+	w.mapsLock.Unlock()
+}
+
+// stopSink stops draining the sink, the next DataSink call starting another
+// caller must hold the mapsLock
+func (w *LineChartSkn) stopSink() {
+	if w.sinkStop != nil {
+		close(w.sinkStop)
+		w.sink = nil
+		w.sinkStop = nil
+	}
+}
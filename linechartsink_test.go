@@ -0,0 +1,86 @@
+package sknlinechart_test
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Data sink", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	send := func(sink chan<- sknlinechart.SeriesPoint, series string, value float32) {
+		sink <- sknlinechart.SeriesPoint{
+			Series: series,
+			Point:  sknlinechart.NewChartDatapoint(value, theme.ColorOrange, time.Now().Format(time.RFC1123)),
+		}
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should apply points sent from many producers in the order sent", func() {
+		sink := lc.DataSink()
+		var producers sync.WaitGroup
+		for p := 0; p < 3; p++ {
+			producers.Add(1)
+			go func(series string) {
+				defer producers.Done()
+				for i := 0; i < 100; i++ {
+					send(sink, series, float32(i))
+				}
+			}(fmt.Sprint("Sensor ", p))
+		}
+		producers.Wait()
+		for p := 0; p < 3; p++ {
+			series := fmt.Sprint("Sensor ", p)
+			Eventually(func() int { return len(lc.GetDataSeries(series)) }).WithTimeout(time.Second).Should(Equal(100))
+			points := lc.GetDataSeries(series)
+			Expect(points[0].Value()).To(BeNumerically("==", 0))
+			Expect(points[99].Value()).To(BeNumerically("==", 99))
+		}
+	})
+	It("should redraw a burst once per batch rather than per point", func() {
+		before := lc.Metrics().Refreshes
+		sink := lc.DataSink()
+		for i := 0; i < 500; i++ {
+			send(sink, "CPU", float32(i%100))
+		}
+		Eventually(func() int { return len(lc.GetDataSeries("CPU")) }).WithTimeout(time.Second).Should(Equal(151))
+		Eventually(func() uint64 { return lc.Metrics().Refreshes }).WithTimeout(time.Second).Should(BeNumerically(">", before))
+		Expect(lc.Metrics().Refreshes - before).To(BeNumerically("<", 50))
+	})
+	It("should return the same sink until it is closed", func() {
+		sink := lc.DataSink()
+		Expect(lc.DataSink()).To(Equal(sink))
+		send(sink, "CPU", 10)
+		close(sink)
+		Eventually(lc.DataSink).WithTimeout(time.Second).ShouldNot(Equal(sink))
+		send(lc.DataSink(), "CPU", 20)
+		Eventually(func() int { return len(lc.GetDataSeries("CPU")) }).WithTimeout(time.Second).Should(Equal(2))
+	})
+	It("should stop draining the sink once the chart is destroyed", func() {
+		sink := lc.DataSink()
+		send(sink, "CPU", 10)
+		Eventually(func() int { return len(lc.GetDataSeries("CPU")) }).WithTimeout(time.Second).Should(Equal(1))
+		stopRedraws(lc)
+		send(sink, "CPU", 20)
+		Consistently(func() int { return len(lc.GetDataSeries("CPU")) }).WithTimeout(100 * time.Millisecond).Should(BeZero())
+	})
+})
@@ -0,0 +1,156 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	autoSnapshotPrefix           = "sknlinechart-"
+	defaultAutoSnapshotRetention = 24
+)
+
+// autoSnapshot background recorder state
+type autoSnapshot struct {
+	interval time.Duration
+	dir      string
+	format   SnapshotFormat
+	stop     chan struct{}
+	done     chan struct{} // closed once the recorder goroutine returns
+}
+
+// EnableAutoSnapshot periodically writes the chart into dir using the given format,
+// keeping only the newest files as set by SetAutoSnapshotRetention.
+// Replaces any recorder already running.
+func (w *LineChartSkn) EnableAutoSnapshot(interval time.Duration, dir string, format SnapshotFormat) error {
+	w.debugLog("LineChartSkn::EnableAutoSnapshot() ENTER")
+	if interval <= 0 {
+		w.debugLog("LineChartSkn::EnableAutoSnapshot() ERROR EXIT")
+		return fmt.Errorf("EnableAutoSnapshot() interval must be positive: %v", interval)
+	}
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		w.debugLog("LineChartSkn::EnableAutoSnapshot() ERROR EXIT")
+		return fmt.Errorf("EnableAutoSnapshot() directory unusable: %w", err)
+	}
+
+	w.DisableAutoSnapshot()
+
+	snap := &autoSnapshot{
+		interval: interval,
+		dir:      dir,
+		format:   format,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	w.snapshotLock.Lock()
+	w.snapshot = snap
+	w.snapshotLock.Unlock()
+
+	go w.runAutoSnapshot(snap)
+
+	w.debugLog("LineChartSkn::EnableAutoSnapshot() EXIT")
+	return nil
+}
+
+// DisableAutoSnapshot stops the background recorder, returning once any snapshot being written
+// is done; files already written are kept
+func (w *LineChartSkn) DisableAutoSnapshot() {
+	w.debugLog("LineChartSkn::DisableAutoSnapshot()")
+	w.snapshotLock.Lock()
+	snap := w.snapshot
+	w.snapshot = nil
+	w.snapshotLock.Unlock()
+	if snap != nil {
+		close(snap.stop)
+		<-snap.done
+	}
+}
+
+// IsAutoSnapshotEnabled returns true while the background recorder is running
+func (w *LineChartSkn) IsAutoSnapshotEnabled() bool {
+	w.snapshotLock.Lock()
+	defer w.snapshotLock.Unlock()
+	return w.snapshot != nil
+}
+
+// SetAutoSnapshotRetention sets how many snapshot files are kept in the directory, default 24
+func (w *LineChartSkn) SetAutoSnapshotRetention(count int) {
+	if count < 1 {
+		count = 1
+	}
+	w.snapshotLock.Lock()
+	w.snapshotRetention = count
+	w.snapshotLock.Unlock()
+}
+
+// runAutoSnapshot writes a snapshot every interval until stopped
+func (w *LineChartSkn) runAutoSnapshot(snap *autoSnapshot) {
+	defer close(snap.done)
+	ticker := time.NewTicker(snap.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-snap.stop:
+			return
+		case <-ticker.C:
+			err := w.writeSnapshotFile(snap)
+			if err != nil {
+				slog.Warn("auto snapshot failed", "dir", snap.dir, "error", err.Error())
+			}
+		}
+	}
+}
+
+// writeSnapshotFile writes one timestamped snapshot file then removes the oldest beyond retention
+func (w *LineChartSkn) writeSnapshotFile(snap *autoSnapshot) error {
+	ext := snap.format.Extension()
+	name := filepath.Join(snap.dir,
+		fmt.Sprintf("%s%s.%s", autoSnapshotPrefix, time.Now().Format("20060102-150405.000000"), ext))
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	err = w.writeSnapshot(file, snap.format)
+	closeErr := file.Close()
+	if err != nil {
+		_ = os.Remove(name)
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	w.snapshotLock.Lock()
+	retention := w.snapshotRetention
+	w.snapshotLock.Unlock()
+	return rotateSnapshots(snap.dir, ext, retention)
+}
+
+// rotateSnapshots removes the oldest snapshot files of the given extension beyond retention
+func rotateSnapshots(dir, ext string, retention int) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), autoSnapshotPrefix) && strings.HasSuffix(entry.Name(), "."+ext) {
+			files = append(files, entry.Name())
+		}
+	}
+	sort.Strings(files) // timestamped names sort oldest first
+	for len(files) > retention {
+		err = os.Remove(filepath.Join(dir, files[0]))
+		if err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package sknlinechart_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Automatic chart snapshots", func() {
+	var (
+		lc  sknlinechart.LineChart
+		dir string
+	)
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "Snapshots", 20)
+		dir = GinkgoT().TempDir()
+	})
+	AfterEach(func() {
+		lc.DisableAutoSnapshot()
+	})
+
+	It("should reject a non-positive interval", func() {
+		Expect(lc.EnableAutoSnapshot(0, dir, sknlinechart.SnapshotCSV)).To(HaveOccurred())
+		Expect(lc.IsAutoSnapshotEnabled()).To(BeFalse())
+	})
+	It("should write csv snapshots and rotate old files", func() {
+		lc.SetAutoSnapshotRetention(2)
+		Expect(lc.EnableAutoSnapshot(5*time.Millisecond, dir, sknlinechart.SnapshotCSV)).To(Succeed())
+		Expect(lc.IsAutoSnapshotEnabled()).To(BeTrue())
+
+		Eventually(func() int {
+			files, _ := filepath.Glob(filepath.Join(dir, "*.csv"))
+			return len(files)
+		}).Should(Equal(2))
+		time.Sleep(30 * time.Millisecond)
+		files, _ := filepath.Glob(filepath.Join(dir, "*.csv"))
+		Expect(len(files)).To(BeNumerically("<=", 2))
+
+		content, err := os.ReadFile(files[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.HasPrefix(string(content), "index,timestamp,Testing")).To(BeTrue())
+
+		lc.DisableAutoSnapshot()
+		Expect(lc.IsAutoSnapshotEnabled()).To(BeFalse())
+	})
+	It("should write png snapshots of a displayed chart", func() {
+		w := test.NewWindow(lc)
+		defer w.Close()
+		w.Resize(fyne.NewSize(400, 300))
+
+		Expect(lc.EnableAutoSnapshot(5*time.Millisecond, dir, sknlinechart.SnapshotPNG)).To(Succeed())
+		Eventually(func() int {
+			files, _ := filepath.Glob(filepath.Join(dir, "*.png"))
+			return len(files)
+		}).Should(BeNumerically(">", 0))
+	})
+})
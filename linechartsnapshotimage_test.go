@@ -0,0 +1,34 @@
+package sknlinechart_test
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Chart snapshot image", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Snapshot", "Image", 20)
+	})
+
+	It("should return the chart as drawn, starting at the origin", func() {
+		win := test.NewWindow(lc)
+		defer win.Close()
+		win.Resize(fyne.NewSize(800, 400))
+		lc.SetMonochromeMode(true)
+
+		img, err := lc.Snapshot()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(img.Bounds().Min.X).To(BeZero())
+		Expect(img.Bounds().Min.Y).To(BeZero())
+		Expect(float32(img.Bounds().Dx())).To(Equal(lc.Size().Width))
+		Expect(float32(img.Bounds().Dy())).To(Equal(lc.Size().Height))
+		Expect(color.NRGBAModel.Convert(img.At(1, 1))).To(Equal(color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}))
+	})
+})
@@ -0,0 +1,116 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"sort"
+)
+
+// StackingMode how series are combined on the y axis
+type StackingMode int
+
+const (
+	StackingNone    StackingMode = iota // each series plotted at its own values, the default
+	StackingStacked                     // values summed across series at each index, drawn as stacked bands
+	StackingPercent                     // stacked values as a percentage of the total at each index
+)
+
+// String returns the mode's name
+func (m StackingMode) String() string {
+	switch m {
+	case StackingNone:
+		return "none"
+	case StackingStacked:
+		return "stacked"
+	case StackingPercent:
+		return "percent"
+	}
+	return fmt.Sprintf("StackingMode(%d)", int(m))
+}
+
+// SetStackingMode stacks the shown series, in name order, summing their values at each index and
+// shading each as a band over the series below it, to show the composition of a total such as disk
+// usage by mount. StackingPercent scales each index's total to 100. Series are matched by index, so
+// stacked series should be sampled together; hovering still reports each point's own value
+func (w *LineChartSkn) SetStackingMode(mode StackingMode) error {
+	w.debugLog("LineChartSkn::SetStackingMode() ENTER")
+	if mode < StackingNone || mode > StackingPercent {
+		w.debugLog("LineChartSkn::SetStackingMode() ERROR EXIT")
+		return fmt.Errorf("SetStackingMode() unknown mode: %v", mode)
+	}
+	w.mapsLock.Lock()
+	w.stackingMode = mode
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetStackingMode() EXIT")
+	return nil
+}
+
+// GetStackingMode returns how series are combined on the y axis
+func (w *LineChartSkn) GetStackingMode() StackingMode {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.stackingMode
+}
+
+// stackLayer where one series' point sits in the stack, in plotted units
+type stackLayer struct {
+	base []float32
+	top  []float32
+}
+
+// updateStack sums the shown series in name order at each index, none when not stacking
+// caller must hold the mapsLock
+func (w *LineChartSkn) updateStack() {
+	w.stack = nil
+	if w.stackingMode == StackingNone {
+		return
+	}
+	names := make([]string, 0, len(w.dataPoints))
+	for name := range w.dataPoints {
+		if !w.hiddenSeries[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var totals []float32
+	for _, name := range names {
+		for idx, point := range w.dataPoints[name] {
+			if idx == len(totals) {
+				totals = append(totals, 0)
+			}
+			if isDrawable(*point) {
+				totals[idx] += (*point).Value()
+			}
+		}
+	}
+	running := make([]float32, len(totals))
+	w.stack = map[string]stackLayer{}
+	for _, name := range names {
+		points := w.dataPoints[name]
+		layer := stackLayer{base: make([]float32, len(points)), top: make([]float32, len(points))}
+		for idx, point := range points {
+			layer.base[idx] = running[idx]
+			if isDrawable(*point) {
+				running[idx] += (*point).Value()
+			}
+			layer.top[idx] = running[idx]
+			if w.stackingMode == StackingPercent && totals[idx] != 0 {
+				layer.base[idx] = layer.base[idx] / totals[idx] * 100
+				layer.top[idx] = layer.top[idx] / totals[idx] * 100
+			}
+		}
+		w.stack[name] = layer
+	}
+}
+
+// plotBase returns the y value the series' area is shaded down to: the top of the layer below when stacking,
+// otherwise the zero baseline
+// caller must hold the mapsLock
+func (w *LineChartSkn) plotBase(seriesName string, index int) float32 {
+	if layer, ok := w.stack[seriesName]; ok && index < len(layer.base) {
+		return layer.base[index]
+	}
+	return 0
+}
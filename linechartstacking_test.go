@@ -0,0 +1,81 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Stacking series", func() {
+	var (
+		lc         sknlinechart.LineChart
+		root, home []*sknlinechart.ChartDatapoint
+	)
+
+	markerY := func(point *sknlinechart.ChartDatapoint) float32 {
+		top, bottom := (*point).MarkerPosition()
+		return (top.Y + bottom.Y) / 2
+	}
+	series := func(value float32, colorName string) []*sknlinechart.ChartDatapoint {
+		var points []*sknlinechart.ChartDatapoint
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(value, colorName, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		return points
+	}
+
+	BeforeEach(func() {
+		root, home = series(20, theme.ColorBlue), series(30, theme.ColorOrange)
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"/": root, "/home": home})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should plot each series on top of the ones before it", func() {
+		Expect(lc.GetStackingMode()).To(Equal(sknlinechart.StackingNone))
+		rootY, homeY := markerY(root[5]), markerY(home[5])
+		unit := (rootY - homeY) / 10 // pixels per unit of value
+
+		Expect(lc.SetStackingMode(sknlinechart.StackingStacked)).To(Succeed())
+		Expect(markerY(root[5])).To(BeNumerically("~", rootY, 1))
+		Expect(markerY(home[5])).To(BeNumerically("~", rootY-30*unit, 1))
+
+		var raster *canvas.Raster
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if r, ok := o.(*canvas.Raster); ok {
+				raster = r
+			}
+		}
+		Expect(raster.Visible()).To(BeTrue())
+
+		Expect(lc.SetStackingMode(sknlinechart.StackingNone)).To(Succeed())
+		Expect(markerY(home[5])).To(BeNumerically("~", homeY, 1))
+		Expect(raster.Visible()).To(BeFalse())
+	})
+	It("should scale the total to 100 in percent mode", func() {
+		rootY, homeY := markerY(root[5]), markerY(home[5])
+		unit := (rootY - homeY) / 10
+
+		Expect(lc.SetStackingMode(sknlinechart.StackingPercent)).To(Succeed())
+		Expect(markerY(root[5])).To(BeNumerically("~", rootY+(20-40)*unit, 1))
+		Expect(markerY(home[5])).To(BeNumerically("~", rootY-80*unit, 1))
+	})
+	It("should reject unknown modes and keep the mode in saved state", func() {
+		Expect(lc.SetStackingMode(sknlinechart.StackingMode(7))).To(HaveOccurred())
+		Expect(lc.SetStackingMode(sknlinechart.StackingPercent)).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.GetStackingMode()).To(Equal(sknlinechart.StackingPercent))
+	})
+})
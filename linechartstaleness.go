@@ -0,0 +1,112 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image/color"
+	"time"
+)
+
+// staleAlphaDivisor how much a stale series' colors are faded
+const staleAlphaDivisor = 3
+
+// LastUpdated returns when the series last received data, zero time when the series is unknown
+func (w *LineChartSkn) LastUpdated(seriesName string) time.Time {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.lastUpdated[seriesName]
+}
+
+// GetStaleThreshold returns the age after which a series is dimmed, zero when disabled
+func (w *LineChartSkn) GetStaleThreshold() time.Duration {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.staleThreshold
+}
+
+// SetStaleThreshold dims any series not updated within threshold; zero disables dimming
+func (w *LineChartSkn) SetStaleThreshold(threshold time.Duration) error {
+	w.debugLog("LineChartSkn::SetStaleThreshold() ENTER")
+	if threshold < 0 {
+		w.debugLog("LineChartSkn::SetStaleThreshold() ERROR EXIT")
+		return fmt.Errorf("SetStaleThreshold() threshold cannot be negative: %v", threshold)
+	}
+	w.mapsLock.Lock()
+	w.staleThreshold = threshold
+	w.mapsLock.Unlock()
+
+	w.startStaleWatch()
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetStaleThreshold() EXIT")
+	return nil
+}
+
+// IsSeriesStale returns true when dimming is enabled and the series is older than the threshold
+func (w *LineChartSkn) IsSeriesStale(seriesName string) bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.isSeriesStale(seriesName, time.Now())
+}
+
+// isSeriesStale caller must hold the mapsLock
+func (w *LineChartSkn) isSeriesStale(seriesName string, now time.Time) bool {
+	if w.staleThreshold <= 0 {
+		return false
+	}
+	last, ok := w.lastUpdated[seriesName]
+	return ok && now.Sub(last) > w.staleThreshold
+}
+
+// touchSeries records the series as just updated
+// caller must hold the mapsLock
+func (w *LineChartSkn) touchSeries(seriesName string) {
+	if w.lastUpdated == nil {
+		w.lastUpdated = map[string]time.Time{}
+	}
+	w.lastUpdated[seriesName] = time.Now()
+}
+
+// startStaleWatch replaces the background ticker which refreshes the chart
+// so series going stale are dimmed without waiting for new data
+func (w *LineChartSkn) startStaleWatch() {
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	w.stopStaleWatch()
+	if w.staleThreshold <= 0 {
+		return
+	}
+	period := w.staleThreshold / 2
+	if period < 10*time.Millisecond {
+		period = 10 * time.Millisecond
+	}
+	stop := make(chan struct{})
+	w.staleWatch = stop
+
+	go func() {
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				w.Refresh()
+			}
+		}
+	}()
+}
+
+// stopStaleWatch stops the background ticker, if running
+// caller must hold the mapsLock
+func (w *LineChartSkn) stopStaleWatch() {
+	if w.staleWatch != nil {
+		close(w.staleWatch)
+		w.staleWatch = nil
+	}
+}
+
+// dimColor fades the color used for stale series
+func dimColor(c color.Color) color.Color {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	n.A = n.A / staleAlphaDivisor
+	return n
+}
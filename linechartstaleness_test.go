@@ -0,0 +1,48 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Series staleness", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "Staleness", 10)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should track when each series was last updated", func() {
+		Expect(lc.LastUpdated("Testing")).NotTo(BeZero())
+		Expect(lc.LastUpdated("Unknown")).To(BeZero())
+
+		before := lc.LastUpdated("Testing")
+		time.Sleep(2 * time.Millisecond)
+		point := sknlinechart.NewChartDatapoint(42, theme.ColorBlue, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Testing", &point)
+		Expect(lc.LastUpdated("Testing")).To(BeTemporally(">", before))
+	})
+	It("should reject a negative threshold", func() {
+		Expect(lc.SetStaleThreshold(-time.Second)).To(HaveOccurred())
+		Expect(lc.GetStaleThreshold()).To(BeZero())
+	})
+	It("should dim a series once it goes stale and restore it on new data", func() {
+		Expect(lc.SetStaleThreshold(20 * time.Millisecond)).To(Succeed())
+		defer lc.SetStaleThreshold(0)
+		Expect(lc.IsSeriesStale("Testing")).To(BeFalse())
+
+		Eventually(func() bool { return lc.IsSeriesStale("Testing") }).Should(BeTrue())
+
+		point := sknlinechart.NewChartDatapoint(42, theme.ColorBlue, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Testing", &point)
+		Expect(lc.IsSeriesStale("Testing")).To(BeFalse())
+		Expect(lc.SetStaleThreshold(0)).To(Succeed())
+		Expect(lc.IsSeriesStale("Testing")).To(BeFalse(), "dimming is disabled")
+	})
+})
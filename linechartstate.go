@@ -0,0 +1,694 @@
+package sknlinechart
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ChartStateSchemaVersion version written by SaveState; older states are migrated on load
+const ChartStateSchemaVersion = 1
+
+// ChartState persisted chart labels, settings, and series data
+type ChartState struct {
+	SchemaVersion     int                           `json:"schemaVersion"`
+	Title             string                        `json:"title"`
+	Footer            string                        `json:"footer"`
+	TopLeftLabel      string                        `json:"topLeftLabel"`
+	TopRightLabel     string                        `json:"topRightLabel"`
+	BottomLeftLabel   string                        `json:"bottomLeftLabel"`
+	BottomRightLabel  string                        `json:"bottomRightLabel"`
+	LeftScaleLabel    string                        `json:"leftScaleLabel"`
+	RightScaleLabel   string                        `json:"rightScaleLabel"`
+	XScaleFactor      int                           `json:"xScaleFactor"`
+	YScaleFactor      int                           `json:"yScaleFactor"`
+	LineStrokeSize    float32                       `json:"lineStrokeSize"`
+	DataPointMarkers  bool                          `json:"dataPointMarkers"`
+	HorizGridLines    bool                          `json:"horizGridLines"`
+	VertGridLines     bool                          `json:"vertGridLines"`
+	ColorLegend       bool                          `json:"colorLegend"`
+	MousePointDisplay bool                          `json:"mousePointDisplay"`
+	Series            map[string][]ChartStatePoint  `json:"series"`
+	Annotations       []Annotation                  `json:"annotations,omitempty"`
+	SeriesMetadata    map[string]SeriesMetadata     `json:"seriesMetadata,omitempty"`
+	Gaps              map[string][]int              `json:"gaps,omitempty"`
+	TimeBands         []TimeBand                    `json:"timeBands,omitempty"`
+	Thresholds        []ChartStateThreshold         `json:"thresholds,omitempty"`
+	Regions           []ChartStateRegion            `json:"regions,omitempty"`
+	VerticalMarkers   []ChartStateMarker            `json:"verticalMarkers,omitempty"`
+	SeriesColors      map[string]string             `json:"seriesColors,omitempty"` // #rrggbbaa
+	SeriesStyles      map[string]SeriesStyle        `json:"seriesStyles,omitempty"`
+	SeriesFills       map[string]string             `json:"seriesFills,omitempty"` // #rrggbbaa, empty shades the series color
+	SeriesGradients   map[string]ChartStateGradient `json:"seriesGradients,omitempty"`
+	Ratios            map[string]ChartStateRatio    `json:"ratios,omitempty"`
+	Derived           map[string]ChartStateDerived  `json:"derived,omitempty"`
+	XLimit            int                           `json:"xLimit,omitempty"` // applied by NewLineChartFromJSON only
+	LegendPosition    LegendPosition                `json:"legendPosition,omitempty"`
+	XAxisRange        *ChartStateRange              `json:"xAxisRange,omitempty"`
+	YInverted         bool                          `json:"yInverted,omitempty"`
+	StackingMode      StackingMode                  `json:"stackingMode,omitempty"`
+	LineInterpolation LineInterpolation             `json:"lineInterpolation,omitempty"`
+	Viewport          *ChartViewport                `json:"viewport,omitempty"` // the zoomed region, nil when not zoomed
+	HiddenSeries      []string                      `json:"hiddenSeries,omitempty"`
+}
+
+// ChartStateGradient persisted series value gradient
+type ChartStateGradient struct {
+	Low  string  `json:"low"`  // #rrggbbaa
+	High string  `json:"high"` // #rrggbbaa
+	Min  float32 `json:"min"`
+	Max  float32 `json:"max"`
+}
+
+// ChartStateRatio persisted inputs of a ratio series
+type ChartStateRatio struct {
+	Numerator   string `json:"numerator"`
+	Denominator string `json:"denominator"`
+}
+
+// ChartStateDerived persisted source and computation of a derived series
+type ChartStateDerived struct {
+	Source string      `json:"source"`
+	Kind   DerivedKind `json:"kind"`
+	Window int         `json:"window"`
+}
+
+// ChartStateMarker persisted vertical marker, at Time when set otherwise at Index
+type ChartStateMarker struct {
+	Index int    `json:"index,omitempty"`
+	Time  string `json:"time,omitempty"` // RFC3339Nano
+	Text  string `json:"text"`
+	Color string `json:"color,omitempty"` // #rrggbbaa, empty for the foreground color
+}
+
+// ChartStateRegion persisted reference region
+type ChartStateRegion struct {
+	Name  string     `json:"name"`
+	Axis  RegionAxis `json:"axis"`
+	From  float32    `json:"from"`
+	To    float32    `json:"to"`
+	Color string     `json:"color,omitempty"` // #rrggbbaa, empty for the foreground shade
+}
+
+// ChartStateThreshold persisted threshold line
+type ChartStateThreshold struct {
+	Name  string  `json:"name"`
+	Value float32 `json:"value"`
+	Color string  `json:"color,omitempty"` // #rrggbbaa, empty for the error color
+	Label string  `json:"label,omitempty"`
+}
+
+// ChartStateRange persisted numeric x axis range
+type ChartStateRange struct {
+	Min float32 `json:"min"`
+	Max float32 `json:"max"`
+}
+
+// ChartStatePoint persisted datapoint
+type ChartStatePoint struct {
+	Value     float32  `json:"value"`
+	ColorName string   `json:"colorName"`
+	Color     string   `json:"color,omitempty"` // #rrggbbaa, drawn in place of the color name
+	Timestamp string   `json:"timestamp"`
+	Lower     *float32 `json:"lower,omitempty"`
+	Upper     *float32 `json:"upper,omitempty"`
+	X         *float32 `json:"x,omitempty"`
+	Missing   bool     `json:"missing,omitempty"`
+}
+
+// StateMigration upgrades a decoded state document by one schema version, from the version
+// it was registered for to the next; the document's schemaVersion is updated by the caller
+type StateMigration func(doc map[string]any) (map[string]any, error)
+
+var (
+	stateMigrations = map[int]StateMigration{
+		0: func(doc map[string]any) (map[string]any, error) { // unversioned states lack the display flags
+			for _, flag := range []string{"dataPointMarkers", "horizGridLines", "vertGridLines", "colorLegend", "mousePointDisplay"} {
+				if _, ok := doc[flag]; !ok {
+					doc[flag] = true
+				}
+			}
+			return doc, nil
+		},
+	}
+	stateMigrationsLock sync.RWMutex
+)
+
+// RegisterStateMigration installs the migration run on states saved with fromVersion
+func RegisterStateMigration(fromVersion int, migration StateMigration) {
+	stateMigrationsLock.Lock()
+	defer stateMigrationsLock.Unlock()
+	stateMigrations[fromVersion] = migration
+}
+
+// State returns the chart's current persistable state
+func (w *LineChartSkn) State() ChartState {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+
+	state := ChartState{
+		SchemaVersion:     ChartStateSchemaVersion,
+		Title:             w.topCenteredLabel,
+		Footer:            w.bottomCenteredLabel,
+		TopLeftLabel:      w.topLeftLabel,
+		TopRightLabel:     w.topRightLabel,
+		BottomLeftLabel:   w.bottomLeftLabel,
+		BottomRightLabel:  w.bottomRightLabel,
+		LeftScaleLabel:    w.leftMiddleLabel,
+		RightScaleLabel:   w.rightMiddleLabel,
+		XScaleFactor:      w.chartXScaleMultiplier,
+		YScaleFactor:      w.chartYScaleMultiplier,
+		LineStrokeSize:    w.dataPointStrokeSize,
+		DataPointMarkers:  w.enableDataPointMarkers,
+		HorizGridLines:    w.enableHorizGridLines,
+		VertGridLines:     w.enableVertGridLines,
+		ColorLegend:       w.enableColorLegend,
+		MousePointDisplay: w.enableMousePointDisplay,
+		Series:            map[string][]ChartStatePoint{},
+		XLimit:            w.dataPointXLimit,
+		LegendPosition:    w.legendPosition,
+		YInverted:         w.yInverted,
+		StackingMode:      w.stackingMode,
+		LineInterpolation: w.lineInterpolation,
+	}
+	if w.xAxis != nil {
+		state.XAxisRange = &ChartStateRange{Min: w.xAxis.min, Max: w.xAxis.max}
+	}
+	if w.viewport != nil {
+		vp := *w.viewport
+		state.Viewport = &vp
+	}
+	for key, hidden := range w.hiddenSeries {
+		if hidden {
+			state.HiddenSeries = append(state.HiddenSeries, key)
+		}
+	}
+	sort.Strings(state.HiddenSeries)
+	if w.enableExportContext {
+		state.Annotations = append([]Annotation(nil), w.annotations...)
+		state.TimeBands = append([]TimeBand(nil), w.timeBands...)
+		for _, line := range w.thresholds {
+			st := ChartStateThreshold{Name: line.name, Value: line.value, Label: line.label}
+			if line.color != nil {
+				st.Color = formatHexColor(line.color)
+			}
+			state.Thresholds = append(state.Thresholds, st)
+		}
+		for _, region := range w.regions {
+			sr := ChartStateRegion{Name: region.Name, Axis: region.Axis, From: region.From, To: region.To}
+			if region.Color != nil {
+				sr.Color = formatHexColor(region.Color)
+			}
+			state.Regions = append(state.Regions, sr)
+		}
+		for _, marker := range w.verticalMarkers {
+			sm := ChartStateMarker{Index: marker.At.Index, Text: marker.Text}
+			if !marker.At.Time.IsZero() {
+				sm.Time = marker.At.Time.Format(time.RFC3339Nano)
+			}
+			if marker.Color != nil {
+				sm.Color = formatHexColor(marker.Color)
+			}
+			state.VerticalMarkers = append(state.VerticalMarkers, sm)
+		}
+	}
+	for key, metadata := range w.seriesMetadata {
+		if state.SeriesMetadata == nil {
+			state.SeriesMetadata = map[string]SeriesMetadata{}
+		}
+		state.SeriesMetadata[key] = metadata.Copy()
+	}
+	for key, c := range w.seriesColors {
+		if state.SeriesColors == nil {
+			state.SeriesColors = map[string]string{}
+		}
+		state.SeriesColors[key] = formatHexColor(c)
+	}
+	for key, c := range w.seriesFills {
+		if state.SeriesFills == nil {
+			state.SeriesFills = map[string]string{}
+		}
+		state.SeriesFills[key] = ""
+		if c != nil {
+			state.SeriesFills[key] = formatHexColor(c)
+		}
+	}
+	for key, g := range w.seriesGradients {
+		if state.SeriesGradients == nil {
+			state.SeriesGradients = map[string]ChartStateGradient{}
+		}
+		state.SeriesGradients[key] = ChartStateGradient{Low: formatHexColor(g.Low), High: formatHexColor(g.High), Min: g.Min, Max: g.Max}
+	}
+	for key, ratio := range w.ratios {
+		if state.Ratios == nil {
+			state.Ratios = map[string]ChartStateRatio{}
+		}
+		state.Ratios[key] = ChartStateRatio{Numerator: ratio.numerator, Denominator: ratio.denominator}
+	}
+	for key, derived := range w.derived {
+		if state.Derived == nil {
+			state.Derived = map[string]ChartStateDerived{}
+		}
+		state.Derived[key] = ChartStateDerived{Source: derived.source, Kind: derived.kind, Window: derived.window}
+	}
+	for key, style := range w.seriesStyles {
+		if state.SeriesStyles == nil {
+			state.SeriesStyles = map[string]SeriesStyle{}
+		}
+		state.SeriesStyles[key] = style.normalized()
+	}
+	for key, gaps := range w.gaps {
+		if state.Gaps == nil {
+			state.Gaps = map[string][]int{}
+		}
+		state.Gaps[key] = append([]int(nil), gaps...)
+	}
+	for key, points := range w.dataPoints {
+		series := make([]ChartStatePoint, 0, len(points))
+		for _, point := range points {
+			sp := ChartStatePoint{
+				Value:     (*point).Value(),
+				ColorName: (*point).ColorName(),
+				Timestamp: (*point).Timestamp(),
+			}
+			if lower, upper, ok := (*point).Bounds(); ok {
+				sp.Lower, sp.Upper = &lower, &upper
+			}
+			if x, ok := (*point).XValue(); ok {
+				sp.X = &x
+			}
+			if c := (*point).Color(); c != nil {
+				sp.Color = formatHexColor(c)
+			}
+			if (*point).IsMissing() {
+				sp.Value, sp.Missing = 0, true // json has no NaN
+			}
+			series = append(series, sp)
+		}
+		state.Series[key] = series
+	}
+	return state
+}
+
+// ApplyState replaces the chart's labels, settings, and series with the given state
+func (w *LineChartSkn) ApplyState(state ChartState) error {
+	w.debugLog("LineChartSkn::ApplyState() ENTER")
+	if state.SchemaVersion != ChartStateSchemaVersion {
+		w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+		return fmt.Errorf("ApplyState() unsupported schema version. got:%d, want:%d", state.SchemaVersion, ChartStateSchemaVersion)
+	}
+	dataPoints := map[string][]*ChartDatapoint{}
+	for key, series := range state.Series {
+		if len(series) > w.dataPointXLimit {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() [%s] data series datapoints limit exceeded. limit:%d, count:%d", key, w.dataPointXLimit, len(series))
+		}
+		var points []*ChartDatapoint
+		for _, sp := range series {
+			point := NewChartDatapoint(sp.Value, sp.ColorName, sp.Timestamp)
+			if sp.Lower != nil && sp.Upper != nil {
+				point.SetBounds(*sp.Lower, *sp.Upper)
+			}
+			if sp.X != nil {
+				point.SetXValue(*sp.X)
+			}
+			point.SetMissing(sp.Missing)
+			if sp.Color != "" {
+				c, err := parseHexColor(sp.Color)
+				if err != nil {
+					w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+					return fmt.Errorf("ApplyState() [%s] %w", key, err)
+				}
+				point.SetColor(c)
+			}
+			points = append(points, &point)
+		}
+		dataPoints[key] = points
+	}
+	for _, a := range state.Annotations {
+		if a.Index < 0 || a.Index >= len(dataPoints[a.Series]) {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() [%s] annotation has no datapoint. index:%d", a.Series, a.Index)
+		}
+	}
+	seriesColors := map[string]color.Color{}
+	for key, hex := range state.SeriesColors {
+		c, err := parseHexColor(hex)
+		if err != nil {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() [%s] series %w", key, err)
+		}
+		seriesColors[key] = c
+	}
+	seriesFills := map[string]color.Color{}
+	for key, hex := range state.SeriesFills {
+		seriesFills[key] = nil
+		if hex == "" {
+			continue
+		}
+		c, err := parseHexColor(hex)
+		if err != nil {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() [%s] series fill %w", key, err)
+		}
+		seriesFills[key] = c
+	}
+	seriesGradients := map[string]ColorGradient{}
+	for key, sg := range state.SeriesGradients {
+		low, err := parseHexColor(sg.Low)
+		if err != nil {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() [%s] series gradient %w", key, err)
+		}
+		high, err := parseHexColor(sg.High)
+		if err != nil {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() [%s] series gradient %w", key, err)
+		}
+		gradient := ColorGradient{Low: low, High: high, Min: sg.Min, Max: sg.Max}
+		if err := gradient.validate(); err != nil {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() [%s] series %w", key, err)
+		}
+		seriesGradients[key] = gradient
+	}
+	for key, style := range state.SeriesStyles {
+		if err := style.validate(); err != nil {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() [%s] series style %w", key, err)
+		}
+	}
+	if state.XAxisRange != nil && state.XAxisRange.Max <= state.XAxisRange.Min {
+		w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+		return fmt.Errorf("ApplyState() x axis max must be greater than min: %v-%v", state.XAxisRange.Min, state.XAxisRange.Max)
+	}
+	if !state.LineInterpolation.valid() {
+		w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+		return fmt.Errorf("ApplyState() unknown line interpolation: %v", state.LineInterpolation)
+	}
+	var thresholds []thresholdLine
+	for _, st := range state.Thresholds {
+		if st.Name == "" || !isFinite(st.Value) {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() threshold line needs a name and finite value: %q", st.Name)
+		}
+		line := thresholdLine{name: st.Name, value: st.Value, label: st.Label}
+		if st.Color != "" {
+			c, err := parseHexColor(st.Color)
+			if err != nil {
+				w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+				return fmt.Errorf("ApplyState() [%s] threshold %w", st.Name, err)
+			}
+			line.color = c
+		}
+		thresholds = append(thresholds, line)
+	}
+	var regions []ReferenceRegion
+	for _, sr := range state.Regions {
+		region := ReferenceRegion{Name: sr.Name, Axis: sr.Axis, From: sr.From, To: sr.To}
+		if sr.Color != "" {
+			c, err := parseHexColor(sr.Color)
+			if err != nil {
+				w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+				return fmt.Errorf("ApplyState() [%s] region %w", sr.Name, err)
+			}
+			region.Color = c
+		}
+		if err := region.validate(); err != nil {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() %w", err)
+		}
+		regions = append(regions, region)
+	}
+	for key, sd := range state.Derived {
+		if err := (derivedSeries{source: sd.Source, kind: sd.Kind, window: sd.Window}).validate(); err != nil {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() [%s] %w", key, err)
+		}
+	}
+	var markers []VerticalMarker
+	for _, sm := range state.VerticalMarkers {
+		marker := VerticalMarker{At: MarkerAtIndex(sm.Index), Text: sm.Text}
+		if sm.Time != "" {
+			at, err := time.Parse(time.RFC3339Nano, sm.Time)
+			if err != nil {
+				w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+				return fmt.Errorf("ApplyState() vertical marker time unreadable: %w", err)
+			}
+			marker.At = MarkerAtTime(at)
+		}
+		if sm.Color != "" {
+			c, err := parseHexColor(sm.Color)
+			if err != nil {
+				w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+				return fmt.Errorf("ApplyState() [%v] vertical marker %w", marker.At, err)
+			}
+			marker.Color = c
+		}
+		if err := marker.validate(); err != nil {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() %w", err)
+		}
+		markers = append(markers, marker)
+	}
+	if vp := state.Viewport; vp != nil && (vp.XMax <= vp.XMin || vp.YMax <= vp.YMin) {
+		w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+		return fmt.Errorf("ApplyState() invalid viewport. x:%v-%v, y:%v-%v", vp.XMin, vp.XMax, vp.YMin, vp.YMax)
+	}
+	for _, b := range state.TimeBands {
+		err := b.validate()
+		if err != nil {
+			w.debugLog("LineChartSkn::ApplyState() ERROR EXIT")
+			return fmt.Errorf("ApplyState() %w", err)
+		}
+	}
+
+	w.mapsLock.Lock()
+	w.topCenteredLabel = state.Title
+	w.bottomCenteredLabel = state.Footer
+	w.topLeftLabel = state.TopLeftLabel
+	w.topRightLabel = state.TopRightLabel
+	w.bottomLeftLabel = state.BottomLeftLabel
+	w.bottomRightLabel = state.BottomRightLabel
+	w.leftMiddleLabel = state.LeftScaleLabel
+	w.rightMiddleLabel = state.RightScaleLabel
+	if state.XScaleFactor > 0 {
+		w.chartXScaleMultiplier = state.XScaleFactor
+	}
+	if state.YScaleFactor > 0 {
+		w.chartYScaleMultiplier = state.YScaleFactor
+		w.dataPointYLimit = float32(state.YScaleFactor * YPointLimit)
+	}
+	if state.LineStrokeSize > 0 {
+		w.dataPointStrokeSize = state.LineStrokeSize
+	}
+	w.enableDataPointMarkers = state.DataPointMarkers
+	w.enableHorizGridLines = state.HorizGridLines
+	w.enableVertGridLines = state.VertGridLines
+	w.enableColorLegend = state.ColorLegend
+	w.enableMousePointDisplay = state.MousePointDisplay
+	w.legendPosition = state.LegendPosition
+	w.yInverted = state.YInverted
+	w.stackingMode = state.StackingMode
+	w.lineInterpolation = state.LineInterpolation
+	if state.XAxisRange != nil {
+		w.xAxis = &xAxisRange{min: state.XAxisRange.Min, max: state.XAxisRange.Max}
+	}
+	w.viewport = nil
+	if state.Viewport != nil {
+		vp := *state.Viewport
+		w.viewport = &vp
+	}
+	w.hiddenSeries = map[string]bool{}
+	for _, key := range state.HiddenSeries {
+		w.hiddenSeries[key] = true
+	}
+	w.dataPoints = dataPoints
+	w.refining = nil
+	for key := range dataPoints {
+		w.touchSeries(key)
+		w.startRefining(key)
+	}
+	w.pinnedTooltips = nil
+	w.annotations = append([]Annotation(nil), state.Annotations...)
+	w.seriesMetadata = map[string]SeriesMetadata{}
+	for key, metadata := range state.SeriesMetadata {
+		w.seriesMetadata[key] = metadata.Copy()
+	}
+	w.seriesColors = seriesColors
+	w.seriesFills = seriesFills
+	w.seriesGradients = seriesGradients
+	w.ratios = nil
+	for key, ratio := range state.Ratios {
+		if w.ratios == nil {
+			w.ratios = map[string]ratioSeries{}
+		}
+		w.ratios[key] = ratioSeries{numerator: ratio.Numerator, denominator: ratio.Denominator}
+	}
+	w.derived = nil
+	for key, sd := range state.Derived {
+		if w.derived == nil {
+			w.derived = map[string]derivedSeries{}
+		}
+		w.derived[key] = derivedSeries{source: sd.Source, kind: sd.Kind, window: sd.Window}
+	}
+	w.seriesStyles = nil
+	for key, style := range state.SeriesStyles {
+		w.applySeriesStyle(key, style)
+	}
+	w.gaps = map[string][]int{}
+	for key, gaps := range state.Gaps {
+		w.gaps[key] = append([]int(nil), gaps...)
+	}
+	if state.TimeBands != nil { // states saved without context keep the chart's own bands
+		w.timeBands = append([]TimeBand(nil), state.TimeBands...)
+	}
+	if state.Thresholds != nil { // as are its threshold lines and regions
+		w.thresholds = thresholds
+	}
+	if state.Regions != nil {
+		w.regions = regions
+	}
+	if state.VerticalMarkers != nil {
+		w.verticalMarkers = markers
+	}
+	w.dataSeriesAdded = true
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+
+	w.Refresh()
+	w.debugLog("LineChartSkn::ApplyState() EXIT")
+	return nil
+}
+
+// SaveState writes the chart's state as versioned json, coarsened by the chart's ExportPrivacy
+func (w *LineChartSkn) SaveState(out io.Writer) error {
+	w.debugLog("LineChartSkn::SaveState()")
+	state := w.State()
+	w.GetExportPrivacy().apply(&state)
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(state)
+}
+
+// LoadState reads json written by SaveState, migrating states from older schema versions.
+// The chart keeps its own point limit, see NewLineChartFromJSON to restore that too
+func (w *LineChartSkn) LoadState(in io.Reader) error {
+	w.debugLog("LineChartSkn::LoadState() ENTER")
+	state, err := decodeState(in)
+	if err != nil {
+		w.debugLog("LineChartSkn::LoadState() ERROR EXIT")
+		return fmt.Errorf("LoadState() %w", err)
+	}
+	w.debugLog("LineChartSkn::LoadState() EXIT")
+	return w.ApplyState(state)
+}
+
+// MarshalJSON encodes the chart as SaveState does, its labels, settings, ranges, series styles and
+// datapoints, so it can be persisted or sent over the wire and shown again with UnmarshalJSON
+func (w *LineChartSkn) MarshalJSON() ([]byte, error) {
+	w.debugLog("LineChartSkn::MarshalJSON()")
+	state := w.State()
+	w.GetExportPrivacy().apply(&state)
+	return json.Marshal(state)
+}
+
+// UnmarshalJSON restores the view encoded by MarshalJSON or SaveState onto a chart created by New or
+// NewWithOptions, as LoadState does; the chart keeps its own point limit
+func (w *LineChartSkn) UnmarshalJSON(data []byte) error {
+	w.debugLog("LineChartSkn::UnmarshalJSON() ENTER")
+	w.mapsLock.RLock()
+	constructed := w.dataPoints != nil
+	w.mapsLock.RUnlock()
+	if !constructed {
+		w.debugLog("LineChartSkn::UnmarshalJSON() ERROR EXIT")
+		return errors.New("UnmarshalJSON() chart was not created by New or NewWithOptions, see NewLineChartFromJSON")
+	}
+	state, err := decodeState(bytes.NewReader(data))
+	if err != nil {
+		w.debugLog("LineChartSkn::UnmarshalJSON() ERROR EXIT")
+		return fmt.Errorf("UnmarshalJSON() %w", err)
+	}
+	w.debugLog("LineChartSkn::UnmarshalJSON() EXIT")
+	return w.ApplyState(state)
+}
+
+// NewLineChartFromJSON creates a chart fully restored from json written by SaveState: labels,
+// settings, point limit, series, annotations and time bands, so report-review tools can reopen
+// exactly what was exported
+func NewLineChartFromJSON(in io.Reader) (LineChart, error) {
+	state, err := decodeState(in)
+	if err != nil {
+		return nil, fmt.Errorf("NewLineChartFromJSON() %w", err)
+	}
+	chart, err := NewWithOptions(NewChartOptions(WithXLimit(state.XLimit)))
+	if err != nil {
+		return nil, fmt.Errorf("NewLineChartFromJSON() %w", err)
+	}
+	err = chart.ApplyState(state)
+	if err != nil {
+		return nil, fmt.Errorf("NewLineChartFromJSON() %w", err)
+	}
+	return chart, nil
+}
+
+// decodeState reads a state document, migrating it from older schema versions
+func decodeState(in io.Reader) (ChartState, error) {
+	var state ChartState
+	var doc map[string]any
+	err := json.NewDecoder(in).Decode(&doc)
+	if err != nil {
+		return state, fmt.Errorf("invalid state: %w", err)
+	}
+	doc, err = migrateState(doc)
+	if err != nil {
+		return state, err
+	}
+
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return state, fmt.Errorf("migrated state unusable: %w", err)
+	}
+	err = json.Unmarshal(raw, &state)
+	if err != nil {
+		return state, fmt.Errorf("migrated state unusable: %w", err)
+	}
+	return state, nil
+}
+
+// migrateState runs registered migrations until the document reaches ChartStateSchemaVersion
+func migrateState(doc map[string]any) (map[string]any, error) {
+	version := 0
+	if v, ok := doc["schemaVersion"].(float64); ok {
+		version = int(v)
+	}
+	if version > ChartStateSchemaVersion {
+		return nil, fmt.Errorf("LoadState() state is from a newer schema. got:%d, supported:%d", version, ChartStateSchemaVersion)
+	}
+
+	stateMigrationsLock.RLock()
+	defer stateMigrationsLock.RUnlock()
+	for version < ChartStateSchemaVersion {
+		migration, ok := stateMigrations[version]
+		if !ok {
+			return nil, fmt.Errorf("LoadState() no migration registered from schema version %d", version)
+		}
+		next, err := migration(doc)
+		if err != nil {
+			return nil, fmt.Errorf("LoadState() migration from schema version %d failed: %w", version, err)
+		}
+		if next == nil {
+			return nil, fmt.Errorf("LoadState() migration from schema version %d returned no state", version)
+		}
+		version++
+		doc = next
+		doc["schemaVersion"] = version
+	}
+	return doc, nil
+}
@@ -0,0 +1,133 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Versioned chart state", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "State", 10)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should round trip labels, settings, and series", func() {
+		lc.SetTopLeftLabel("top left")
+		lc.SetVertGridLines(false)
+		point := sknlinechart.NewChartDatapoint(12.5, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Second", &point)
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring(`"schemaVersion": 1`))
+
+		restored, _ := makeUI("Other", "Chart", 3)
+		restored.Resize(fyne.NewSize(800, 400))
+		Expect(restored.LoadState(&buf)).To(Succeed())
+		Expect(restored.State()).To(Equal(lc.State()))
+		Expect(restored.GetTitle()).To(Equal("Testing"))
+		Expect(restored.IsVertGridLinesEnabled()).To(BeFalse())
+	})
+	It("should carry annotations and time bands unless export context is off", func() {
+		Expect(lc.IsExportContextEnabled()).To(BeTrue())
+		Expect(lc.AddAnnotation("Testing", 2, "deploy")).To(Succeed())
+		Expect(lc.SetTimeBands([]sknlinechart.TimeBand{sknlinechart.WeekendTimeBand(theme.ColorGray)})).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring(`"timeBands"`))
+		restored, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		Expect(restored.LoadState(&buf)).To(Succeed())
+		Expect(restored.GetAnnotations()).To(Equal(lc.GetAnnotations()))
+		Expect(restored.GetTimeBands()).To(Equal(lc.GetTimeBands()))
+
+		lc.SetExportContext(false)
+		buf.Reset()
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		Expect(buf.String()).NotTo(ContainSubstring(`"annotations"`))
+		Expect(buf.String()).NotTo(ContainSubstring(`"timeBands"`))
+		bare, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithExportContext(false)))
+		Expect(bare.LoadState(&buf)).To(Succeed())
+		Expect(bare.GetAnnotations()).To(BeEmpty())
+		Expect(bare.GetTimeBands()).To(BeEmpty())
+	})
+	It("should construct a chart warm-started from exported json", func() {
+		var points []*sknlinechart.ChartDatapoint
+		for i := 0; i < 40; i++ {
+			point := sknlinechart.NewXYDatapoint(float32(i*25), float32(i), theme.ColorRed, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		source, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithTitle("Review"),
+			sknlinechart.WithXLimit(40),
+			sknlinechart.WithXAxisRange(0, 1000),
+			sknlinechart.WithLegendPosition(sknlinechart.LegendRight),
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Torque": points})))
+		Expect(source.AddAnnotation("Torque", 10, "peak")).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(source.SaveState(&buf)).To(Succeed())
+		chart, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chart.State()).To(Equal(source.State()))
+		Expect(chart.GetTitle()).To(Equal("Review"))
+		Expect(chart.GetLegendPosition()).To(Equal(sknlinechart.LegendRight))
+		Expect(chart.GetAnnotations()).To(HaveLen(1))
+		_, max, ok := chart.GetXAxisRange()
+		Expect(ok).To(BeTrue())
+		Expect(max).To(BeNumerically("==", 1000))
+
+		_, err = sknlinechart.NewLineChartFromJSON(strings.NewReader(`{"schemaVersion": `))
+		Expect(err).To(MatchError(ContainSubstring("invalid state")))
+	})
+	It("should refuse states with invalid time bands", func() {
+		bad := `{"schemaVersion": 1, "series": {}, "timeBands": [{"name": "Broken", "start": 0, "end": 0}]}`
+		Expect(lc.LoadState(strings.NewReader(bad))).To(MatchError(ContainSubstring("Broken")))
+		Expect(lc.GetTitle()).To(Equal("Testing"))
+	})
+	It("should load unversioned states from older releases", func() {
+		legacy := `{"title": "Legacy", "yScaleFactor": 10, "series": {"Temp": [{"value": 21.5, "colorName": "red", "timestamp": "now"}]}}`
+		Expect(lc.LoadState(strings.NewReader(legacy))).To(Succeed())
+		state := lc.State()
+		Expect(state.SchemaVersion).To(Equal(sknlinechart.ChartStateSchemaVersion))
+		Expect(state.Title).To(Equal("Legacy"))
+		Expect(state.Series).To(HaveKey("Temp"))
+		Expect(state.Series).NotTo(HaveKey("Testing"))
+		Expect(state.HorizGridLines).To(BeTrue())
+	})
+	It("should refuse states from a newer schema", func() {
+		Expect(lc.LoadState(strings.NewReader(`{"schemaVersion": 99}`))).To(MatchError(ContainSubstring("newer schema")))
+		Expect(lc.GetTitle()).To(Equal("Testing"))
+	})
+	It("should marshal the chart as json and restore the same view", func() {
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 2, XMax: 8, YMin: 10, YMax: 90})).To(Succeed())
+		Expect(lc.HideSeries("Testing")).To(Succeed())
+		Expect(lc.SetSeriesStyle("Testing", sknlinechart.SeriesStyle{Dashes: []float32{4, 2}})).To(Succeed())
+
+		data, err := json.Marshal(lc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"viewport":{"xMin":2,"xMax":8,"yMin":10,"yMax":90}`))
+
+		restored, _ := makeUI("Other", "Chart", 3)
+		Expect(json.Unmarshal(data, restored)).To(Succeed())
+		Expect(restored.State()).To(Equal(lc.State()))
+		Expect(restored.GetViewport()).To(Equal(lc.GetViewport()))
+		Expect(restored.IsSeriesVisible("Testing")).To(BeFalse())
+	})
+	It("should refuse to unmarshal into a chart not made by its constructors", func() {
+		data, err := json.Marshal(lc)
+		Expect(err).NotTo(HaveOccurred())
+		var bare sknlinechart.LineChartSkn
+		Expect(json.Unmarshal(data, &bare)).To(MatchError(ContainSubstring("NewLineChartFromJSON")))
+	})
+})
@@ -0,0 +1,145 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// statsOverlayOpacity opacity of the statistics lines, kept faint so the data stays in front
+const statsOverlayOpacity float32 = 0.6
+
+// SeriesStats statistics of a series' drawable points inside the viewport
+type SeriesStats struct {
+	Min   float32
+	Max   float32
+	Mean  float32
+	Last  float32
+	Count int // points measured, zero when none are in view
+}
+
+// IsStatsOverlayEnabled returns true when the statistics overlay is drawn
+func (w *LineChartSkn) IsStatsOverlayEnabled() bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.enableStatsOverlay
+}
+
+// SetStatsOverlayEnabled draws labeled lines at the min, max, mean, and last value of each shown
+// series, recomputed over the points inside the viewport on every refresh
+func (w *LineChartSkn) SetStatsOverlayEnabled(enable bool) {
+	w.debugLog("LineChartSkn::SetStatsOverlayEnabled()")
+	w.mapsLock.Lock()
+	w.enableStatsOverlay = enable
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// GetSeriesStats returns the min, max, mean, and last value of the series' points inside the viewport
+func (w *LineChartSkn) GetSeriesStats(seriesName string) (SeriesStats, error) {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	if _, ok := w.dataPoints[seriesName]; !ok {
+		return SeriesStats{}, fmt.Errorf("GetSeriesStats() series not found: %s", seriesName)
+	}
+	return w.seriesStats(seriesName), nil
+}
+
+// seriesStats measures the series' drawable points inside the viewport
+// caller must hold the mapsLock
+func (w *LineChartSkn) seriesStats(series string) SeriesStats {
+	var stats SeriesStats
+	var sum float32
+	for idx, point := range w.dataPoints[series] {
+		v := (*point).Value()
+		if !isFinite(v) || (*point).IsMissing() || !w.isXVisible(w.pointX(idx, *point)) {
+			continue
+		}
+		if stats.Count == 0 || v < stats.Min {
+			stats.Min = v
+		}
+		if stats.Count == 0 || v > stats.Max {
+			stats.Max = v
+		}
+		sum += v
+		stats.Last = v
+		stats.Count++
+	}
+	if stats.Count > 0 {
+		stats.Mean = sum / float32(stats.Count)
+	}
+	return stats
+}
+
+// layoutStatsOverlay draws a labeled line at each statistic of every shown series, hiding the rest
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutStatsOverlay() {
+	used := 0
+	if r.widget.enableStatsOverlay {
+		vp := r.widget.currentViewport()
+		names := make([]string, 0, len(r.widget.dataPoints))
+		for key := range r.widget.dataPoints {
+			names = append(names, key)
+		}
+		sort.Strings(names)
+		for _, series := range names {
+			if r.widget.hiddenSeries[series] {
+				continue
+			}
+			stats := r.widget.seriesStats(series)
+			if stats.Count == 0 {
+				continue
+			}
+			c := fadeColor(r.widget.legendColor(series), statsOverlayOpacity)
+			for _, stat := range []struct {
+				name  string
+				value float32
+			}{
+				{r.widget.Message(MessageStatsMin), stats.Min},
+				{r.widget.Message(MessageStatsMax), stats.Max},
+				{r.widget.Message(MessageStatsAvg), stats.Mean},
+				{r.widget.Message(MessageStatsLast), stats.Last},
+			} {
+				if stat.value < vp.YMin || stat.value > vp.YMax {
+					continue
+				}
+				r.placeStatsLine(used, c, fmt.Sprintf("%s %s %.2f", series, stat.name, stat.value),
+					r.pixels.y(r.widget.dataToPosition(vp.XMin, stat.value).Y))
+				used++
+			}
+		}
+	}
+	for idx := used; idx < len(r.statsLines); idx++ {
+		r.statsLines[idx].Hide()
+		r.statsLabels[idx].Hide()
+	}
+}
+
+// placeStatsLine shows pooled line idx across the plot area at y, labeled at its right end
+func (r *lineChartRenderer) placeStatsLine(idx int, c color.Color, label string, y float32) {
+	if idx == len(r.statsLines) {
+		line := canvas.NewLine(c)
+		line.StrokeWidth = 1.0
+		text := canvas.NewText("", c)
+		text.TextSize = theme.CaptionTextSize()
+		r.statsLines = append(r.statsLines, line)
+		r.statsLabels = append(r.statsLabels, text)
+	}
+	line, text := r.statsLines[idx], r.statsLabels[idx]
+	line.StrokeColor = c
+	line.Position1 = fyne.NewPos(r.widget.plotMin.X, y)
+	line.Position2 = fyne.NewPos(r.widget.plotMax.X, y)
+	line.Show()
+	line.Refresh()
+
+	text.Text = label
+	text.Color = c
+	size := fyne.MeasureText(text.Text, text.TextSize, text.TextStyle)
+	text.Move(fyne.NewPos(r.widget.plotMax.X-size.Width-theme.Padding(), y-size.Height))
+	text.Show()
+	text.Refresh()
+}
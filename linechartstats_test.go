@@ -0,0 +1,67 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Statistics overlay", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for _, value := range []float32{20, 60, 40, 80, 30} {
+			point := sknlinechart.NewChartDatapoint(value, theme.ColorGreen, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Load": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should measure the points inside the viewport", func() {
+		stats, err := lc.GetSeriesStats("Load")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats).To(Equal(sknlinechart.SeriesStats{Min: 20, Max: 80, Mean: 46, Last: 30, Count: 5}))
+
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 1, XMax: 3, YMin: 0, YMax: 100})).To(Succeed())
+		stats, _ = lc.GetSeriesStats("Load")
+		Expect(stats).To(Equal(sknlinechart.SeriesStats{Min: 40, Max: 80, Mean: 60, Last: 80, Count: 3}))
+
+		_, err = lc.GetSeriesStats("Unknown")
+		Expect(err).To(HaveOccurred())
+	})
+	It("should draw labeled lines at each statistic while enabled", func() {
+		Expect(visibleText(lc, "Load max 80.00")).To(BeNil())
+		lc.SetStatsOverlayEnabled(true)
+		Expect(lc.IsStatsOverlayEnabled()).To(BeTrue())
+
+		for _, label := range []string{"Load min 20.00", "Load max 80.00", "Load avg 46.00", "Load last 30.00"} {
+			Expect(visibleText(lc, label)).NotTo(BeNil(), label)
+		}
+		top, bottom := (*points[3]).MarkerPosition()
+		label := visibleText(lc, "Load max 80.00")
+		Expect(label.Position().Y + label.MinSize().Height).To(BeNumerically("~", (top.Y+bottom.Y)/2, 2))
+
+		By("recomputing on refresh as data arrives")
+		point := sknlinechart.NewChartDatapoint(95, theme.ColorGreen, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Load", &point)
+		Expect(visibleText(lc, "Load max 95.00")).NotTo(BeNil())
+		Expect(visibleText(lc, "Load max 80.00")).To(BeNil())
+
+		By("dropping the lines of hidden series and when disabled")
+		Expect(lc.HideSeries("Load")).To(Succeed())
+		Expect(visibleText(lc, "Load max 95.00")).To(BeNil())
+		Expect(lc.ShowSeries("Load")).To(Succeed())
+		lc.SetStatsOverlayEnabled(false)
+		Expect(visibleText(lc, "Load max 95.00")).To(BeNil())
+	})
+})
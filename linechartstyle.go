@@ -0,0 +1,192 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// SeriesStyle how a series' lines and markers are drawn, so a forecast can be dashed beside the actual
+// series, a reference series drawn faint, or overlapping series told apart by marker shape.
+// Zero fields keep the chart's defaults
+type SeriesStyle struct {
+	StrokeWidth float32     `json:"strokeWidth,omitempty"` // line width, 0 uses the chart's line stroke size
+	Dashes      []float32   `json:"dashes,omitempty"`      // alternating dash and gap lengths in pixels, empty draws solid
+	Opacity     float32     `json:"opacity,omitempty"`     // 0 to 1 scaling the series' alpha, 0 draws opaque
+	Marker      MarkerShape `json:"marker,omitempty"`      // datapoint marker shape, circles by default
+	MarkerSize  float32     `json:"markerSize,omitempty"`  // marker width in pixels, 0 uses 4
+}
+
+// validate reports the first invalid field, odd dash patterns repeat as for SVG
+func (s SeriesStyle) validate() error {
+	if s.StrokeWidth < 0 {
+		return fmt.Errorf("stroke width cannot be negative: %v", s.StrokeWidth)
+	}
+	if s.Opacity < 0 || s.Opacity > 1 {
+		return fmt.Errorf("opacity must be from 0 to 1: %v", s.Opacity)
+	}
+	if s.Marker < MarkerCircle || s.Marker > MarkerCross {
+		return fmt.Errorf("unknown marker shape: %v", s.Marker)
+	}
+	if s.MarkerSize < 0 {
+		return fmt.Errorf("marker size cannot be negative: %v", s.MarkerSize)
+	}
+	for _, d := range s.Dashes {
+		if d <= 0 {
+			return fmt.Errorf("dash lengths must be greater than zero: %v", s.Dashes)
+		}
+	}
+	return nil
+}
+
+// normalized returns a copy of the style whose dash pattern has an even number of lengths
+func (s SeriesStyle) normalized() SeriesStyle {
+	dashes := append([]float32{}, s.Dashes...)
+	if len(dashes)%2 == 1 {
+		dashes = append(dashes, dashes...)
+	}
+	if len(dashes) == 0 {
+		dashes = nil
+	}
+	s.Dashes = dashes
+	return s
+}
+
+// SetSeriesStyle strokes the series' lines with the style's width, dash pattern, and opacity, and draws
+// its markers in the style's shape and size; the zero SeriesStyle restores the chart's defaults
+func (w *LineChartSkn) SetSeriesStyle(seriesName string, style SeriesStyle) error {
+	w.debugLog("LineChartSkn::SetSeriesStyle() ENTER")
+	if err := style.validate(); err != nil {
+		w.debugLog("LineChartSkn::SetSeriesStyle() ERROR EXIT")
+		return fmt.Errorf("SetSeriesStyle() [%s] %w", seriesName, err)
+	}
+	w.mapsLock.Lock()
+	w.applySeriesStyle(seriesName, style)
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetSeriesStyle() EXIT")
+	return nil
+}
+
+// GetSeriesStyle returns the style set for the series, false when it is drawn with the chart's defaults
+func (w *LineChartSkn) GetSeriesStyle(seriesName string) (SeriesStyle, bool) {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	style, ok := w.seriesStyles[seriesName]
+	if ok {
+		style.Dashes = append([]float32(nil), style.Dashes...)
+	}
+	return style, ok
+}
+
+// applySeriesStyle records a validated style, forgetting the zero style
+// caller must hold the mapsLock
+func (w *LineChartSkn) applySeriesStyle(seriesName string, style SeriesStyle) {
+	style = style.normalized()
+	if style.StrokeWidth == 0 && style.Opacity == 0 && style.Dashes == nil && style.Marker == MarkerCircle && style.MarkerSize == 0 {
+		delete(w.seriesStyles, seriesName)
+		return
+	}
+	if w.seriesStyles == nil {
+		w.seriesStyles = map[string]SeriesStyle{}
+	}
+	w.seriesStyles[seriesName] = style
+}
+
+// styledStrokeSize returns the series' own stroke width when set, thin lines of a low render quality still win
+// caller must hold the mapsLock
+func (w *LineChartSkn) styledStrokeSize(seriesName string) float32 {
+	if style := w.seriesStyles[seriesName]; style.StrokeWidth > 0 && w.renderQuality != RenderQualityLow {
+		return style.StrokeWidth
+	}
+	return w.seriesStrokeSize()
+}
+
+// styledColor applies the series' opacity to the color
+// caller must hold the mapsLock
+func (w *LineChartSkn) styledColor(seriesName string, c color.Color) color.Color {
+	if style := w.seriesStyles[seriesName]; style.Opacity > 0 && style.Opacity < 1 {
+		return fadeColor(c, style.Opacity)
+	}
+	return c
+}
+
+// seriesDashPattern returns the dash pattern of the series, or the one dealt it in monochrome mode, nil when drawn solid
+// caller must hold the mapsLock
+func (w *LineChartSkn) seriesDashPattern(seriesName string) []float32 {
+	if w.monochrome {
+		return w.monochromeDashPattern(seriesName)
+	}
+	return w.seriesStyles[seriesName].Dashes
+}
+
+// dashSegment draws from-to as dashes of the series' pattern, continuing the pattern from phase pixels
+// along it so dashes run on across short segments, or as one solid line when the series is not dashed.
+// Returns the next unused pooled dash and the phase at to
+// caller must hold the mapsLock
+func (r *lineChartRenderer) dashSegment(series string, used int, from, to fyne.Position, c color.Color, stroke, phase float32) (int, float32) {
+	pattern := r.widget.seriesDashPattern(series)
+	if pattern == nil {
+		pattern = solidDashPattern
+	}
+	pool := r.seriesDashes[series]
+	used, phase = dashLine(&pool, used, from, to, c, stroke, pattern, phase)
+	r.seriesDashes[series] = pool
+	return used, phase
+}
+
+// hideDashes hides the series' pooled dashes from index used on
+func (r *lineChartRenderer) hideDashes(series string, used int) {
+	for _, dash := range r.seriesDashes[series][used:] {
+		dash.Hide()
+	}
+}
+
+// solidDashPattern a single dash longer than any line, drawing it solid
+var solidDashPattern = []float32{float32(math.Inf(1)), 0}
+
+// dashLine draws from-to as the alternating dash and gap lengths of pattern, starting phase pixels
+// into it, using the pooled lines from index used on. Returns the next unused index and the phase at to
+func dashLine(pool *[]*canvas.Line, used int, from, to fyne.Position, c color.Color, stroke float32, pattern []float32, phase float32) (int, float32) {
+	dx, dy := to.X-from.X, to.Y-from.Y
+	length := float32(math.Hypot(float64(dx), float64(dy)))
+	if length == 0 {
+		return used, phase
+	}
+	var cycle float32
+	for _, d := range pattern {
+		cycle += d
+	}
+	offset := float32(math.Mod(float64(phase), float64(cycle)))
+	part := 0
+	for offset >= pattern[part] {
+		offset -= pattern[part]
+		part = (part + 1) % len(pattern)
+	}
+	for d := float32(0); d < length; part = (part + 1) % len(pattern) {
+		end := d + pattern[part] - offset
+		offset = 0
+		if end > length {
+			end = length
+		}
+		if part%2 == 0 {
+			if used == len(*pool) {
+				*pool = append(*pool, canvas.NewLine(c))
+			}
+			dash := (*pool)[used]
+			dash.StrokeColor = c
+			dash.StrokeWidth = stroke
+			dash.Position1 = fyne.NewPos(from.X+dx*d/length, from.Y+dy*d/length)
+			dash.Position2 = fyne.NewPos(from.X+dx*end/length, from.Y+dy*end/length)
+			dash.Show()
+			dash.Refresh()
+			used++
+		}
+		d = end
+	}
+	return used, phase + length
+}
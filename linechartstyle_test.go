@@ -0,0 +1,81 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"image/color"
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Per-series styling", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+		blue   = theme.PrimaryColorNamed(theme.ColorBlue)
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 8; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(20+i*10), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Forecast": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should stroke the series at its own width", func() {
+		Expect(lc.SetSeriesStyle("Forecast", sknlinechart.SeriesStyle{StrokeWidth: 5})).To(Succeed())
+		lines := seriesLines(lc, blue)
+		Expect(lines).To(HaveLen(8))
+		for _, line := range lines {
+			Expect(line.StrokeWidth).To(BeNumerically("==", 5))
+		}
+	})
+	It("should draw a dashed series as short dashes no longer than the pattern", func() {
+		Expect(lc.SetSeriesStyle("Forecast", sknlinechart.SeriesStyle{Dashes: []float32{6, 4}})).To(Succeed())
+		style, ok := lc.GetSeriesStyle("Forecast")
+		Expect(ok).To(BeTrue())
+		Expect(style.Dashes).To(Equal([]float32{6, 4}))
+
+		dashes := seriesLines(lc, blue)
+		Expect(len(dashes)).To(BeNumerically(">", 8))
+		for _, dash := range dashes {
+			length := math.Hypot(float64(dash.Position2.X-dash.Position1.X), float64(dash.Position2.Y-dash.Position1.Y))
+			Expect(length).To(BeNumerically("<=", 6.01))
+		}
+
+		Expect(lc.SetSeriesStyle("Forecast", sknlinechart.SeriesStyle{})).To(Succeed())
+		_, ok = lc.GetSeriesStyle("Forecast")
+		Expect(ok).To(BeFalse())
+		Expect(seriesLines(lc, blue)).To(HaveLen(8))
+	})
+	It("should fade the series by its opacity", func() {
+		Expect(lc.SetSeriesStyle("Forecast", sknlinechart.SeriesStyle{Opacity: 0.5})).To(Succeed())
+		lines := seriesLines(lc, blue)
+		Expect(lines).To(HaveLen(8))
+		want := color.NRGBAModel.Convert(blue).(color.NRGBA).A / 2
+		Expect(color.NRGBAModel.Convert(lines[0].StrokeColor).(color.NRGBA).A).To(BeNumerically("~", want, 1))
+	})
+	It("should reject invalid styles and keep styles in saved state", func() {
+		Expect(lc.SetSeriesStyle("Forecast", sknlinechart.SeriesStyle{StrokeWidth: -1})).To(HaveOccurred())
+		Expect(lc.SetSeriesStyle("Forecast", sknlinechart.SeriesStyle{Opacity: 2})).To(HaveOccurred())
+		Expect(lc.SetSeriesStyle("Forecast", sknlinechart.SeriesStyle{Dashes: []float32{6, 0}})).To(HaveOccurred())
+
+		Expect(lc.SetSeriesStyle("Forecast", sknlinechart.SeriesStyle{StrokeWidth: 3, Dashes: []float32{5}})).To(Succeed())
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		style, ok := restored.GetSeriesStyle("Forecast")
+		Expect(ok).To(BeTrue())
+		Expect(style).To(Equal(sknlinechart.SeriesStyle{StrokeWidth: 3, Dashes: []float32{5, 5}}))
+	})
+})
@@ -0,0 +1,168 @@
+package sknlinechart
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// ExportSVG writes the chart as it is drawn, its frame, grid, labels, legend, series lines and markers,
+// as an svg document of the chart's size, so it stays crisp at any scale in reports and print.
+// Painted layers, such as confidence bands or series drawn by the raster backend, are embedded as
+// png images. The chart must have been shown, its renderer lays out what is written
+func (w *LineChartSkn) ExportSVG(out io.Writer) error {
+	w.debugLog("LineChartSkn::ExportSVG() ENTER")
+	var doc bytes.Buffer
+	size := w.Size()
+	fmt.Fprintf(&doc, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n",
+		svgNumber(size.Width), svgNumber(size.Height), svgNumber(size.Width), svgNumber(size.Height))
+	fill, opacity := svgColor(w.paperColor())
+	fmt.Fprintf(&doc, `<rect width="100%%" height="100%%" fill="%s" fill-opacity="%s"/>`+"\n", fill, opacity)
+	err := w.writeSVGObjects(&doc, fyne.Position{})
+	if err != nil {
+		w.debugLog("LineChartSkn::ExportSVG() ERROR EXIT")
+		return err
+	}
+	doc.WriteString("</svg>\n")
+	_, err = out.Write(doc.Bytes())
+	w.debugLog("LineChartSkn::ExportSVG() EXIT")
+	return err
+}
+
+// writeSVGObjects writes the visible objects of the chart's renderer, offset by the chart's position
+// in the document; charts inset into it are written in turn
+func (w *LineChartSkn) writeSVGObjects(doc *bytes.Buffer, offset fyne.Position) error {
+	w.mapsLock.RLock()
+	r := w.renderer
+	w.mapsLock.RUnlock()
+	if r == nil {
+		return errors.New("ExportSVG() chart has not been shown, it has no layout to write")
+	}
+	r.renderLock.Lock()
+	defer r.renderLock.Unlock()
+	return r.writeSVG(doc, r.Objects(), offset)
+}
+
+// writeSVG writes each visible object as its svg element, descending into containers
+func (r *lineChartRenderer) writeSVG(doc *bytes.Buffer, objs []fyne.CanvasObject, offset fyne.Position) error {
+	for _, o := range objs {
+		if o == nil || !o.Visible() {
+			continue
+		}
+		pos := offset.Add(o.Position())
+		switch obj := o.(type) {
+		case *fyne.Container:
+			err := r.writeSVG(doc, obj.Objects, pos)
+			if err != nil {
+				return err
+			}
+		case *LineChartSkn:
+			err := obj.writeSVGObjects(doc, pos)
+			if err != nil {
+				return err
+			}
+		case *canvas.Line:
+			stroke, opacity := svgColor(obj.StrokeColor)
+			p1, p2 := offset.Add(obj.Position1), offset.Add(obj.Position2)
+			fmt.Fprintf(doc, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s" stroke-opacity="%s" stroke-width="%s"/>`+"\n",
+				svgNumber(p1.X), svgNumber(p1.Y), svgNumber(p2.X), svgNumber(p2.Y), stroke, opacity, svgNumber(obj.StrokeWidth))
+		case *canvas.Circle:
+			fill, fillOpacity := svgColor(obj.FillColor)
+			stroke, strokeOpacity := svgColor(obj.StrokeColor)
+			p1, p2 := offset.Add(obj.Position1), offset.Add(obj.Position2)
+			fmt.Fprintf(doc, `<ellipse cx="%s" cy="%s" rx="%s" ry="%s" fill="%s" fill-opacity="%s" stroke="%s" stroke-opacity="%s" stroke-width="%s"/>`+"\n",
+				svgNumber((p1.X+p2.X)/2), svgNumber((p1.Y+p2.Y)/2), svgNumber((p2.X-p1.X)/2), svgNumber((p2.Y-p1.Y)/2),
+				fill, fillOpacity, stroke, strokeOpacity, svgNumber(obj.StrokeWidth))
+		case *canvas.Rectangle:
+			fill, fillOpacity := svgColor(obj.FillColor)
+			stroke, strokeOpacity := svgColor(obj.StrokeColor)
+			size := obj.Size()
+			fmt.Fprintf(doc, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s" fill-opacity="%s" stroke="%s" stroke-opacity="%s" stroke-width="%s"/>`+"\n",
+				svgNumber(pos.X), svgNumber(pos.Y), svgNumber(size.Width), svgNumber(size.Height),
+				fill, fillOpacity, stroke, strokeOpacity, svgNumber(obj.StrokeWidth))
+		case *canvas.Text:
+			writeSVGText(doc, obj, pos)
+		case *canvas.Raster:
+			err := writeSVGRaster(doc, obj, pos)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeSVGText writes the text aligned within its bounds as fyne draws it
+func writeSVGText(doc *bytes.Buffer, t *canvas.Text, pos fyne.Position) {
+	if t.Text == "" {
+		return
+	}
+	size := t.TextSize
+	if size <= 0 {
+		size = theme.TextSize()
+	}
+	x, anchor := pos.X, "start"
+	switch t.Alignment {
+	case fyne.TextAlignCenter:
+		x, anchor = pos.X+t.Size().Width/2, "middle"
+	case fyne.TextAlignTrailing:
+		x, anchor = pos.X+t.Size().Width, "end"
+	}
+	family, weight, style := "sans-serif", "normal", "normal"
+	if t.TextStyle.Monospace {
+		family = "monospace"
+	}
+	if t.TextStyle.Bold {
+		weight = "bold"
+	}
+	if t.TextStyle.Italic {
+		style = "italic"
+	}
+	fill, opacity := svgColor(t.Color)
+	fmt.Fprintf(doc, `<text x="%s" y="%s" dominant-baseline="hanging" text-anchor="%s" font-family="%s" font-size="%s" font-weight="%s" font-style="%s" fill="%s" fill-opacity="%s">`,
+		svgNumber(x), svgNumber(pos.Y), anchor, family, svgNumber(size), weight, style, fill, opacity)
+	_ = xml.EscapeText(doc, []byte(t.Text))
+	doc.WriteString("</text>\n")
+}
+
+// writeSVGRaster embeds the raster painted at the document's scale as a png image
+func writeSVGRaster(doc *bytes.Buffer, raster *canvas.Raster, pos fyne.Position) error {
+	size := raster.Size()
+	if raster.Generator == nil || size.Width < 1 || size.Height < 1 {
+		return nil
+	}
+	var img bytes.Buffer
+	err := png.Encode(&img, raster.Generator(int(size.Width), int(size.Height)))
+	if err != nil {
+		return fmt.Errorf("ExportSVG() painting raster: %w", err)
+	}
+	fmt.Fprintf(doc, `<image x="%s" y="%s" width="%s" height="%s" href="data:image/png;base64,%s"/>`+"\n",
+		svgNumber(pos.X), svgNumber(pos.Y), svgNumber(size.Width), svgNumber(size.Height),
+		base64.StdEncoding.EncodeToString(img.Bytes()))
+	return nil
+}
+
+// svgColor returns the color as an svg rgb() paint and its opacity, none when unset
+func svgColor(c color.Color) (string, string) {
+	if c == nil {
+		return "none", "0"
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("rgb(%d,%d,%d)", n.R, n.G, n.B), svgNumber(float32(n.A) / 255)
+}
+
+// svgNumber formats a coordinate or length with at most two decimals
+func svgNumber(v float32) string {
+	return strconv.FormatFloat(math.Round(float64(v)*100)/100, 'f', -1, 64)
+}
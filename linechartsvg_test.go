@@ -0,0 +1,89 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("SVG export", func() {
+	var (
+		lc  sknlinechart.LineChart
+		win fyne.Window
+	)
+
+	// elements counts the svg document's elements by name, failing when it is not well formed
+	elements := func(doc []byte) map[string]int {
+		found := map[string]int{}
+		decoder := xml.NewDecoder(bytes.NewReader(doc))
+		for {
+			token, err := decoder.Token()
+			if err == io.EOF {
+				return found
+			}
+			Expect(err).NotTo(HaveOccurred())
+			if start, ok := token.(xml.StartElement); ok {
+				found[start.Name.Local]++
+			}
+		}
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithTitle("Sensors & <Probes>")))
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(20+i), theme.ColorOrange, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("CPU", &point)
+		}
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+		win.Close()
+	})
+
+	It("should write the frame, grid, labels, and series as vector elements", func() {
+		var out bytes.Buffer
+		Expect(lc.ExportSVG(&out)).To(Succeed())
+		size := lc.Size()
+		Expect(out.String()).To(HavePrefix(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%v" height="%v"`, size.Width, size.Height)))
+		found := elements(out.Bytes())
+		Expect(found["svg"]).To(Equal(1))
+		Expect(found["line"]).To(BeNumerically(">", 9))
+		Expect(found["rect"]).To(BeNumerically(">=", 1))
+		Expect(found["text"]).To(BeNumerically(">", 0))
+		Expect(out.String()).To(ContainSubstring(">Sensors &amp; &lt;Probes&gt;</text>"))
+		Expect(out.String()).To(ContainSubstring(">CPU</text>"))
+	})
+	It("should leave hidden series out", func() {
+		var shown, hidden bytes.Buffer
+		Expect(lc.ExportSVG(&shown)).To(Succeed())
+		Expect(lc.HideSeries("CPU")).To(Succeed())
+		Expect(lc.ExportSVG(&hidden)).To(Succeed())
+		Expect(elements(hidden.Bytes())["line"]).To(BeNumerically("<", elements(shown.Bytes())["line"]))
+	})
+	It("should refuse a chart never shown", func() {
+		unshown, _ := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		Expect(unshown.ExportSVG(io.Discard)).To(HaveOccurred())
+	})
+	It("should be written by automatic snapshots", func() {
+		Expect(sknlinechart.SnapshotSVG.Extension()).To(Equal("svg"))
+		dir := GinkgoT().TempDir()
+		Expect(lc.EnableAutoSnapshot(20*time.Millisecond, dir, sknlinechart.SnapshotSVG)).To(Succeed())
+		defer lc.DisableAutoSnapshot()
+		Eventually(func() bool {
+			files, _ := filepath.Glob(filepath.Join(dir, "*.svg"))
+			return len(files) > 0
+		}).WithTimeout(time.Second).Should(BeTrue())
+	})
+})
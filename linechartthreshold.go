@@ -0,0 +1,123 @@
+package sknlinechart
+
+import (
+	"errors"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// thresholdLine horizontal line marking a limit across the plot area
+type thresholdLine struct {
+	name  string
+	value float32
+	color color.Color // nil for the error color
+	label string
+}
+
+// AddThresholdLine draws a horizontal line across the plot area at value, such as an SLO target or
+// an alarm limit, with labelText above its right end when not empty. A nil color draws in the theme's
+// error color. Adding a name already in use moves and restyles that line
+func (w *LineChartSkn) AddThresholdLine(name string, value float32, lineColor color.Color, labelText string) error {
+	w.debugLog("LineChartSkn::AddThresholdLine() ENTER")
+	if name == "" || !isFinite(value) {
+		w.debugLog("LineChartSkn::AddThresholdLine() ERROR EXIT")
+		return errors.New("AddThresholdLine() a name and finite value are required")
+	}
+	w.mapsLock.Lock()
+	w.setThresholdLine(thresholdLine{name: name, value: value, color: lineColor, label: labelText})
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::AddThresholdLine() EXIT")
+	return nil
+}
+
+// RemoveThresholdLine removes the named threshold line, false when there is none
+func (w *LineChartSkn) RemoveThresholdLine(name string) bool {
+	w.debugLog("LineChartSkn::RemoveThresholdLine()")
+	w.mapsLock.Lock()
+	removed := false
+	lines := w.thresholds[:0]
+	for _, line := range w.thresholds {
+		if line.name == name {
+			removed = true
+			continue
+		}
+		lines = append(lines, line)
+	}
+	w.thresholds = lines
+	w.mapsLock.Unlock()
+	if removed {
+		w.Refresh()
+	}
+	return removed
+}
+
+// GetThresholdLines returns the names of the threshold lines, in the order added
+func (w *LineChartSkn) GetThresholdLines() []string {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	var names []string
+	for _, line := range w.thresholds {
+		names = append(names, line.name)
+	}
+	return names
+}
+
+// setThresholdLine replaces the line of the same name or appends it
+// caller must hold the mapsLock
+func (w *LineChartSkn) setThresholdLine(line thresholdLine) {
+	for i := range w.thresholds {
+		if w.thresholds[i].name == line.name {
+			w.thresholds[i] = line
+			return
+		}
+	}
+	w.thresholds = append(w.thresholds, line)
+}
+
+// layoutThresholds draws each threshold line within the viewport's value range, hiding the others
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutThresholds() {
+	for len(r.thresholdLines) < len(r.widget.thresholds) {
+		line := canvas.NewLine(theme.ErrorColor())
+		line.StrokeWidth = 1.5
+		line.Hide()
+		text := canvas.NewText("", theme.ErrorColor())
+		text.TextSize = theme.CaptionTextSize()
+		text.Hide()
+		r.thresholdLines = append(r.thresholdLines, line)
+		r.thresholdLabels = append(r.thresholdLabels, text)
+	}
+
+	vp := r.widget.currentViewport()
+	for idx, line := range r.thresholdLines {
+		text := r.thresholdLabels[idx]
+		if idx >= len(r.widget.thresholds) || r.widget.thresholds[idx].value < vp.YMin || r.widget.thresholds[idx].value > vp.YMax {
+			line.Hide()
+			text.Hide()
+			continue
+		}
+		threshold := r.widget.thresholds[idx]
+		c := threshold.color
+		if c == nil {
+			c = theme.ErrorColor()
+		}
+		c = r.widget.cvdColor(c)
+		y := r.pixels.y(r.widget.dataToPosition(vp.XMin, threshold.value).Y)
+		line.StrokeColor = c
+		line.Position1 = fyne.NewPos(r.widget.plotMin.X, y)
+		line.Position2 = fyne.NewPos(r.widget.plotMax.X, y)
+		line.Show()
+		line.Refresh()
+
+		text.Text = threshold.label
+		text.Color = c
+		size := fyne.MeasureText(text.Text, text.TextSize, text.TextStyle)
+		text.Move(fyne.NewPos(r.widget.plotMax.X-size.Width-theme.Padding(), y-size.Height))
+		text.Show()
+		text.Refresh()
+	}
+}
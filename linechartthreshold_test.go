@@ -0,0 +1,73 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Threshold lines", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+		amber  = color.NRGBA{R: 0xff, G: 0xbf, B: 0, A: 0xff}
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(80, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Latency": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should draw a labeled line across the plot area at the value", func() {
+		Expect(lc.AddThresholdLine("slo", 80, amber, "SLO 80ms")).To(Succeed())
+		Expect(lc.GetThresholdLines()).To(Equal([]string{"slo"}))
+
+		lines := seriesLines(lc, amber)
+		Expect(lines).To(HaveLen(1))
+		top, bottom := (*points[5]).MarkerPosition()
+		Expect(lines[0].Position1.Y).To(BeNumerically("~", (top.Y+bottom.Y)/2, 1))
+		Expect(lines[0].Position1.Y).To(Equal(lines[0].Position2.Y))
+		Expect(lines[0].Position2.X - lines[0].Position1.X).To(BeNumerically(">", 600))
+
+		label := visibleText(lc, "SLO 80ms")
+		Expect(label).NotTo(BeNil())
+		Expect(label.Position().Y).To(BeNumerically("<", lines[0].Position1.Y))
+
+		Expect(lc.RemoveThresholdLine("slo")).To(BeTrue())
+		Expect(lc.RemoveThresholdLine("slo")).To(BeFalse())
+		Expect(seriesLines(lc, amber)).To(BeEmpty())
+		Expect(visibleText(lc, "SLO 80ms")).To(BeNil())
+	})
+	It("should replace a line of the same name and hide lines outside the viewport", func() {
+		Expect(lc.AddThresholdLine("limit", 90, nil, "")).To(Succeed())
+		Expect(seriesLines(lc, theme.ErrorColor())).To(HaveLen(1))
+		Expect(lc.AddThresholdLine("limit", 95, amber, "")).To(Succeed())
+		Expect(lc.GetThresholdLines()).To(HaveLen(1))
+		Expect(seriesLines(lc, amber)).To(HaveLen(1))
+
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 0, XMax: 9, YMin: 0, YMax: 50})).To(Succeed())
+		Expect(seriesLines(lc, amber)).To(BeEmpty())
+	})
+	It("should reject unnamed lines and keep lines in saved state", func() {
+		Expect(lc.AddThresholdLine("", 10, nil, "")).To(HaveOccurred())
+		Expect(lc.AddThresholdLine("slo", 80, amber, "SLO")).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.GetThresholdLines()).To(Equal([]string{"slo"}))
+	})
+})
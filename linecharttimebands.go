@@ -0,0 +1,131 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"time"
+)
+
+// timeBandOpacity opacity of the shading drawn behind datapoints inside a time band
+const timeBandOpacity float32 = 0.12
+
+// timestampLayouts formats tried, in order, when reading datapoint timestamps
+var timestampLayouts = []string{
+	time.RFC1123,
+	time.RFC1123Z,
+	time.RFC3339Nano,
+	time.RFC3339,
+	time.DateTime,
+	time.UnixDate,
+	time.ANSIC,
+}
+
+// parseTimestamp reads a datapoint timestamp, false when it is not in a known format
+func parseTimestamp(ts string) (time.Time, bool) {
+	for _, layout := range timestampLayouts {
+		t, err := time.Parse(layout, ts)
+		if err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// TimeBand recurring time window shaded behind the data, like nights, weekends, or maintenance windows.
+// Start and End are offsets from midnight; an End before Start wraps past midnight into the next day
+type TimeBand struct {
+	Name      string         `json:"name"`
+	Days      []time.Weekday `json:"days,omitempty"` // days the band starts on, empty for every day
+	Start     time.Duration  `json:"start"`
+	End       time.Duration  `json:"end"`
+	ColorName string         `json:"colorName,omitempty"` // theme color name of the shading, empty for the foreground color
+}
+
+// WeekendTimeBand shades all of Saturday and Sunday
+func WeekendTimeBand(colorName string) TimeBand {
+	return TimeBand{
+		Name:      "Weekend",
+		Days:      []time.Weekday{time.Saturday, time.Sunday},
+		Start:     0,
+		End:       24 * time.Hour,
+		ColorName: colorName,
+	}
+}
+
+// Contains returns true when t, in its own location, falls inside the band
+func (b TimeBand) Contains(t time.Time) bool {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := t.Sub(midnight)
+	if b.Start < b.End {
+		return b.onDay(t.Weekday()) && offset >= b.Start && offset < b.End
+	}
+	// wraps past midnight: the evening part of a band day or the morning after it
+	return (b.onDay(t.Weekday()) && offset >= b.Start) ||
+		(b.onDay((t.Weekday()+6)%7) && offset < b.End)
+}
+
+// onDay returns true when the band starts on day
+func (b TimeBand) onDay(day time.Weekday) bool {
+	if len(b.Days) == 0 {
+		return true
+	}
+	for _, d := range b.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// validate checks the band's offsets lie within one day
+func (b TimeBand) validate() error {
+	day := 24 * time.Hour
+	if b.Start < 0 || b.Start > day || b.End < 0 || b.End > day || b.Start == b.End {
+		return fmt.Errorf("TimeBand [%s] invalid window. start:%v, end:%v", b.Name, b.Start, b.End)
+	}
+	return nil
+}
+
+// GetTimeBands returns a copy of the recurring time bands shaded behind the data
+func (w *LineChartSkn) GetTimeBands() []TimeBand {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return append([]TimeBand(nil), w.timeBands...)
+}
+
+// SetTimeBands shades the datapoints whose timestamps fall inside any of the bands,
+// helping explain periodic dips; nil removes the shading
+func (w *LineChartSkn) SetTimeBands(bands []TimeBand) error {
+	w.debugLog("LineChartSkn::SetTimeBands() ENTER")
+	for _, b := range bands {
+		err := b.validate()
+		if err != nil {
+			w.debugLog("LineChartSkn::SetTimeBands() ERROR EXIT")
+			return err
+		}
+	}
+	w.mapsLock.Lock()
+	w.timeBands = append([]TimeBand(nil), bands...)
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetTimeBands() EXIT")
+	return nil
+}
+
+// timeBandAt returns the first band containing the timestamp of index, false when none does
+// caller must hold the mapsLock
+func (w *LineChartSkn) timeBandAt(index int) (TimeBand, bool) {
+	point := w.pointAtIndex(index)
+	if point == nil {
+		return TimeBand{}, false
+	}
+	t, ok := point.Time()
+	if !ok {
+		return TimeBand{}, false
+	}
+	for _, b := range w.timeBands {
+		if b.Contains(t) {
+			return b, true
+		}
+	}
+	return TimeBand{}, false
+}
@@ -0,0 +1,81 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Recurring time band shading", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+		night  = sknlinechart.TimeBand{Name: "Night", Start: 22 * time.Hour, End: 6 * time.Hour}
+	)
+
+	// shadedRegions returns the visible full-height rectangles drawn behind the data
+	shadedRegions := func() []*canvas.Rectangle {
+		var regions []*canvas.Rectangle
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if r, ok := o.(*canvas.Rectangle); ok && r.Visible() && r.Size().Height > 100 {
+				regions = append(regions, r)
+			}
+		}
+		return regions
+	}
+
+	BeforeEach(func() {
+		points = nil
+		start := time.Date(2024, time.March, 1, 20, 0, 0, 0, time.UTC) // a Friday evening
+		for i := 0; i < 40; i++ {
+			point := sknlinechart.NewChartDatapoint(50, theme.ColorBlue, start.Add(time.Duration(i)*time.Hour).Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should match times inside a band, including bands that wrap past midnight", func() {
+		weekend := sknlinechart.WeekendTimeBand(theme.ColorGray)
+		Expect(weekend.Contains(time.Date(2024, time.March, 2, 12, 0, 0, 0, time.UTC))).To(BeTrue())
+		Expect(weekend.Contains(time.Date(2024, time.March, 4, 12, 0, 0, 0, time.UTC))).To(BeFalse())
+
+		Expect(night.Contains(time.Date(2024, time.March, 1, 23, 0, 0, 0, time.UTC))).To(BeTrue())
+		Expect(night.Contains(time.Date(2024, time.March, 2, 5, 59, 0, 0, time.UTC))).To(BeTrue())
+		Expect(night.Contains(time.Date(2024, time.March, 2, 6, 0, 0, 0, time.UTC))).To(BeFalse())
+
+		fridayNight := night
+		fridayNight.Days = []time.Weekday{time.Friday}
+		Expect(fridayNight.Contains(time.Date(2024, time.March, 2, 3, 0, 0, 0, time.UTC))).To(BeTrue())
+		Expect(fridayNight.Contains(time.Date(2024, time.March, 3, 3, 0, 0, 0, time.UTC))).To(BeFalse())
+	})
+	It("should reject windows outside one day", func() {
+		Expect(lc.SetTimeBands([]sknlinechart.TimeBand{{Name: "Bad", Start: time.Hour, End: time.Hour}})).To(HaveOccurred())
+		Expect(lc.SetTimeBands([]sknlinechart.TimeBand{{Name: "Bad", Start: 0, End: 25 * time.Hour}})).To(HaveOccurred())
+		Expect(lc.GetTimeBands()).To(BeEmpty())
+	})
+	It("should shade each run of datapoints inside a band behind the data", func() {
+		Expect(shadedRegions()).To(BeEmpty())
+		Expect(lc.SetTimeBands([]sknlinechart.TimeBand{night})).To(Succeed())
+		Expect(lc.GetTimeBands()).To(HaveLen(1))
+
+		regions := shadedRegions()
+		Expect(regions).To(HaveLen(2))             // Friday and Saturday nights
+		first, _ := (*points[2]).MarkerPosition()  // 22:00 Friday
+		last, _ := (*points[9]).MarkerPosition()   // 05:00 Saturday
+		after, _ := (*points[10]).MarkerPosition() // 06:00 Saturday
+		Expect(regions[0].Position().X).To(BeNumerically("<", first.X))
+		Expect(regions[0].Position().X + regions[0].Size().Width).To(BeNumerically(">", last.X))
+		Expect(regions[0].Position().X + regions[0].Size().Width).To(BeNumerically("<", after.X))
+
+		Expect(lc.SetTimeBands(nil)).To(Succeed())
+		Expect(shadedRegions()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,110 @@
+package sknlinechart
+
+import (
+	"time"
+)
+
+// timeSpan earliest and latest datapoint timestamps, spread across the chart's width by time spacing
+type timeSpan struct {
+	start time.Time
+	end   time.Time
+}
+
+// IsTimeSpacingEnabled returns true when datapoints are spaced by their timestamps rather than their index
+func (w *LineChartSkn) IsTimeSpacingEnabled() bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.enableTimeSpacing
+}
+
+// SetTimeSpacing positions datapoints along the x axis in proportion to their timestamps, from the
+// earliest to the latest shown, so bursts and silences in irregular telemetry are laid out truthfully.
+// Vertical grid lines then fall on wall-clock boundaries, whole minutes or hours, so the chart lines up with logs.
+// Points whose timestamps cannot be read keep their index position; x values of a numeric x axis take precedence
+func (w *LineChartSkn) SetTimeSpacing(enable bool) {
+	w.debugLog("LineChartSkn::SetTimeSpacing()")
+	w.mapsLock.Lock()
+	w.enableTimeSpacing = enable
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// updateTimeSpan measures the timestamps spread across the chart, forecasts included, none when
+// time spacing is off or fewer than two distinct times can be read
+// caller must hold the mapsLock
+func (w *LineChartSkn) updateTimeSpan() {
+	w.timeSpan = nil
+	if !w.enableTimeSpacing {
+		return
+	}
+	var span timeSpan
+	for _, points := range w.dataPoints {
+		for _, point := range points {
+			span.include(*point)
+		}
+	}
+	for _, forecast := range w.forecasts {
+		for _, point := range forecast {
+			span.include(point)
+		}
+	}
+	if span.end.After(span.start) {
+		w.timeSpan = &span
+	}
+}
+
+// include widens the span to the datapoint's timestamp, when it can be read
+func (s *timeSpan) include(point ChartDatapoint) {
+	ts, ok := point.Time()
+	if !ok {
+		return
+	}
+	if s.start.IsZero() || ts.Before(s.start) {
+		s.start = ts
+	}
+	if ts.After(s.end) {
+		s.end = ts
+	}
+}
+
+// timeX returns where the time lies on the x axis in index units
+func (w *LineChartSkn) timeX(ts time.Time) float32 {
+	span := w.timeSpan.end.Sub(w.timeSpan.start)
+	return float32(float64(ts.Sub(w.timeSpan.start)) / float64(span) * float64(w.dataPointXLimit-1))
+}
+
+// xTime returns the time at a position on the x axis in index units
+func (w *LineChartSkn) xTime(x float32) time.Time {
+	span := w.timeSpan.end.Sub(w.timeSpan.start)
+	return w.timeSpan.start.Add(time.Duration(float64(x) / float64(w.dataPointXLimit-1) * float64(span)))
+}
+
+// formatAxisTime shows times of the span at a useful precision, adding the date once it covers days
+func (w *LineChartSkn) formatAxisTime(ts time.Time) string {
+	if w.timeSpan.end.Sub(w.timeSpan.start) > 24*time.Hour {
+		return ts.Format("Jan 2 15:04")
+	}
+	return ts.Format("15:04:05")
+}
+
+// axisTimeLabel x axis label text, kept while the label shows the same second at the same precision
+type axisTimeLabel struct {
+	second int64
+	long   bool
+	text   string
+}
+
+// axisTimeText returns the text of x axis label idx for the time, formatting it only when the second
+// shown or the precision changed since the last refresh
+func (r *lineChartRenderer) axisTimeText(idx int, ts time.Time) string {
+	for len(r.xLabelTimes) <= idx {
+		r.xLabelTimes = append(r.xLabelTimes, axisTimeLabel{})
+	}
+	long := r.widget.timeSpan.end.Sub(r.widget.timeSpan.start) > 24*time.Hour
+	cached := &r.xLabelTimes[idx]
+	if cached.text == "" || cached.second != ts.Unix() || cached.long != long {
+		*cached = axisTimeLabel{second: ts.Unix(), long: long, text: r.widget.formatAxisTime(ts)}
+	}
+	return cached.text
+}
@@ -0,0 +1,81 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Timestamp spaced x axis", func() {
+	var (
+		lc        sknlinechart.LineChart
+		win       fyne.Window
+		telemetry []sknlinechart.ChartDatapoint
+	)
+
+	BeforeEach(func() {
+		start := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+		telemetry = nil
+		for _, offset := range []time.Duration{0, time.Second, 2 * time.Second, 62 * time.Second, 63 * time.Second} {
+			telemetry = append(telemetry, sknlinechart.NewChartDatapoint(50, theme.ColorOrange, start.Add(offset).Format(time.RFC1123)))
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithTimeSpacing(true),
+		))
+		lc.ApplyDataPoints("Telemetry", telemetry)
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+		win.Close()
+	})
+
+	It("should space points in proportion to their timestamp deltas", func() {
+		Expect(lc.IsTimeSpacingEnabled()).To(BeTrue())
+		first, second, third, fourth := markerX(telemetry[0]), markerX(telemetry[1]), markerX(telemetry[2]), markerX(telemetry[3])
+		Expect(second - first).To(BeNumerically(">", 0))
+		Expect(second - first).To(BeNumerically("~", third-second, 1))
+		span := markerX(telemetry[4]) - first // 63 seconds
+		Expect(fourth - third).To(BeNumerically("~", span*60/63, 2))
+		Expect(visibleText(lc, "12:01:00")).NotTo(BeNil())
+	})
+	It("should space points by index once disabled", func() {
+		lc.SetTimeSpacing(false)
+		Expect(lc.IsTimeSpacingEnabled()).To(BeFalse())
+		first, second, third, fourth := markerX(telemetry[0]), markerX(telemetry[1]), markerX(telemetry[2]), markerX(telemetry[3])
+		Expect(second - first).To(BeNumerically(">", 0))
+		Expect(second - first).To(BeNumerically("~", fourth-third, 1))
+		Expect(visibleText(lc, "12:01:00")).To(BeNil())
+	})
+	It("should place forecasts by their timestamps, widening the span to include them", func() {
+		start := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+		forecast := []sknlinechart.ChartDatapoint{
+			sknlinechart.NewChartDatapoint(55, theme.ColorOrange, start.Add(93*time.Second).Format(time.RFC1123)),
+			sknlinechart.NewChartDatapoint(60, theme.ColorOrange, start.Add(126*time.Second).Format(time.RFC1123)),
+		}
+		Expect(lc.SetForecastSeries("Telemetry", forecast)).To(Succeed())
+		win.Resize(fyne.NewSize(801, 400))
+		var end float32
+		for _, line := range seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange)) {
+			if line.Position2.X > end {
+				end = line.Position2.X
+			}
+		}
+		first, now := markerX(telemetry[0]), markerX(telemetry[4]) // 63 of 126 seconds
+		Expect(now - first).To(BeNumerically("~", (end-first)/2, 2))
+	})
+	It("should keep points with unreadable timestamps at their index", func() {
+		point := sknlinechart.NewChartDatapoint(50, theme.ColorOrange, "not a time")
+		lc.ApplyDataPoint("Telemetry", &point)
+		win.Resize(fyne.NewSize(801, 400))
+		last := markerX(point)
+		Expect(last).To(BeNumerically(">", markerX(telemetry[2])))
+		Expect(last).To(BeNumerically("<", markerX(telemetry[3])))
+	})
+})
@@ -0,0 +1,55 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"time"
+)
+
+// SetTimeWindow keeps only datapoints whose timestamps fall within the last d, so series sampled
+// at different rates stay aligned on the same wall-clock window. Older points are dropped now and
+// whenever new data arrives; point limits still apply. Zero disables the window
+func (w *LineChartSkn) SetTimeWindow(d time.Duration) error {
+	w.debugLog("LineChartSkn::SetTimeWindow() ENTER")
+	if d < 0 {
+		w.debugLog("LineChartSkn::SetTimeWindow() ERROR EXIT")
+		return fmt.Errorf("SetTimeWindow() duration cannot be negative: %v", d)
+	}
+	w.mapsLock.Lock()
+	w.timeWindow = d
+	dropped := w.applyTimeWindow()
+	w.mapsLock.Unlock()
+	w.metrics.dropped(dropped)
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetTimeWindow() EXIT")
+	return nil
+}
+
+// GetTimeWindow returns the retention window set by SetTimeWindow, zero when disabled
+func (w *LineChartSkn) GetTimeWindow() time.Duration {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.timeWindow
+}
+
+// applyTimeWindow drops the leading datapoints of every series older than the time window,
+// stopping at the first point whose timestamp is recent or unreadable; returns the count dropped
+// caller must hold the mapsLock
+func (w *LineChartSkn) applyTimeWindow() int {
+	if w.timeWindow <= 0 {
+		return 0
+	}
+	cutoff := time.Now().Add(-w.timeWindow)
+	dropped := 0
+	for key, points := range w.dataPoints {
+		expired := 0
+		for _, point := range points {
+			ts, ok := (*point).Time()
+			if !ok || !ts.Before(cutoff) {
+				break
+			}
+			expired++
+		}
+		dropped += w.dropOldest(key, expired)
+	}
+	return dropped
+}
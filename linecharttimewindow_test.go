@@ -0,0 +1,59 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Time window retention", func() {
+	var lc sknlinechart.LineChart
+
+	// series samples every step up to now, oldest first
+	series := func(step time.Duration, count int) []sknlinechart.ChartDatapoint {
+		var points []sknlinechart.ChartDatapoint
+		now := time.Now()
+		for i := count - 1; i >= 0; i-- {
+			ts := now.Add(-time.Duration(i) * step).Format(time.RFC1123)
+			points = append(points, sknlinechart.NewChartDatapoint(float32(i), theme.ColorBlue, ts))
+		}
+		return points
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions())
+		lc.Resize(fyne.NewSize(800, 400))
+		lc.ApplyDataPoints("Fast", series(time.Second, 120))
+		lc.ApplyDataPoints("Slow", series(10*time.Second, 60))
+	})
+	AfterEach(func() {
+		stopRedraws(lc)
+	})
+
+	It("should drop points older than the window from every series", func() {
+		Expect(lc.SetTimeWindow(-time.Second)).To(HaveOccurred())
+		Expect(lc.SetTimeWindow(time.Minute + 30*time.Second)).To(Succeed())
+		Expect(lc.GetTimeWindow()).To(Equal(time.Minute + 30*time.Second))
+		Expect(len(lc.GetDataSeries("Fast"))).To(BeNumerically("~", 90, 2))
+		Expect(len(lc.GetDataSeries("Slow"))).To(BeNumerically("~", 9, 1))
+	})
+	It("should keep dropping as new data arrives", func() {
+		Expect(lc.SetTimeWindow(time.Hour)).To(Succeed())
+		Expect(lc.GetDataSeries("Slow")).To(HaveLen(60))
+
+		Expect(lc.SetTimeWindow(5 * time.Minute)).To(Succeed())
+		old := sknlinechart.NewChartDatapoint(1, theme.ColorRed, time.Now().Add(-time.Hour).Format(time.RFC1123))
+		lc.ApplyDataPoint("Stale", &old)
+		Expect(lc.GetDataSeries("Stale")).To(BeEmpty())
+	})
+	It("should keep points whose timestamps cannot be read", func() {
+		point := sknlinechart.NewChartDatapoint(1, theme.ColorRed, "not a time")
+		lc.ApplyDataPoint("Unknown", &point)
+		Expect(lc.SetTimeWindow(time.Second)).To(Succeed())
+		Expect(lc.GetDataSeries("Unknown")).To(HaveLen(1))
+	})
+})
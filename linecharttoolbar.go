@@ -0,0 +1,53 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// NewChartToolbar returns a toolbar wired to the chart's standard controls: pause and resume
+// redraws, reset zoom, export png, and toggles for the grid, datapoint markers, and legend.
+// Applications may append their own items to the returned toolbar
+func NewChartToolbar(chart LineChart) *widget.Toolbar {
+	pause := widget.NewToolbarAction(theme.MediaPauseIcon(), nil)
+	if chart.IsRefreshSuspended() {
+		pause.SetIcon(theme.MediaPlayIcon())
+	}
+	pause.OnActivated = func() {
+		if chart.IsRefreshSuspended() {
+			chart.ResumeRefresh()
+			pause.SetIcon(theme.MediaPauseIcon())
+		} else {
+			chart.SuspendRefresh()
+			pause.SetIcon(theme.MediaPlayIcon())
+		}
+	}
+
+	export := widget.NewToolbarAction(theme.DocumentSaveIcon(), func() {
+		if skn, ok := chart.(*LineChartSkn); ok {
+			skn.showExportPNGDialog()
+		}
+	})
+
+	grid := widget.NewToolbarAction(theme.GridIcon(), func() {
+		enable := !(chart.IsHorizGridLinesEnabled() && chart.IsVertGridLinesEnabled())
+		chart.SetHorizGridLines(enable)
+		chart.SetVertGridLines(enable)
+	})
+	markers := widget.NewToolbarAction(theme.RadioButtonCheckedIcon(), func() {
+		chart.SetDataPointMarkers(!chart.IsDataPointMarkersEnabled())
+	})
+	legend := widget.NewToolbarAction(theme.ListIcon(), func() {
+		chart.SetLegendVisible(!chart.IsLegendVisible())
+	})
+
+	return widget.NewToolbar(
+		pause,
+		widget.NewToolbarAction(theme.ZoomFitIcon(), chart.ResetZoom),
+		export,
+		widget.NewToolbarSeparator(),
+		grid,
+		markers,
+		legend,
+	)
+}
@@ -0,0 +1,56 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Chart toolbar", func() {
+	var (
+		lc      sknlinechart.LineChart
+		toolbar *widget.Toolbar
+	)
+
+	action := func(idx int) *widget.ToolbarAction {
+		return toolbar.Items[idx].(*widget.ToolbarAction)
+	}
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "Toolbar", 20)
+		lc.Resize(fyne.NewSize(800, 400))
+		toolbar = sknlinechart.NewChartToolbar(lc)
+	})
+
+	It("should pause and resume redraws", func() {
+		pause := action(0)
+		pause.OnActivated()
+		Expect(lc.IsRefreshSuspended()).To(BeTrue())
+		Expect(pause.Icon).To(Equal(theme.MediaPlayIcon()))
+		pause.OnActivated()
+		Expect(lc.IsRefreshSuspended()).To(BeFalse())
+		Expect(pause.Icon).To(Equal(theme.MediaPauseIcon()))
+	})
+	It("should reset zoom", func() {
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 2, XMax: 10, YMin: 0, YMax: 100})).To(Succeed())
+		Expect(lc.IsZoomed()).To(BeTrue())
+		action(1).OnActivated()
+		Expect(lc.IsZoomed()).To(BeFalse())
+	})
+	It("should toggle the grid, markers, and legend", func() {
+		action(4).OnActivated()
+		Expect(lc.IsHorizGridLinesEnabled()).To(BeFalse())
+		Expect(lc.IsVertGridLinesEnabled()).To(BeFalse())
+		action(4).OnActivated()
+		Expect(lc.IsHorizGridLinesEnabled()).To(BeTrue())
+
+		action(5).OnActivated()
+		Expect(lc.IsDataPointMarkersEnabled()).To(BeFalse())
+
+		action(6).OnActivated()
+		Expect(lc.IsLegendVisible()).To(BeFalse())
+	})
+})
@@ -0,0 +1,155 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// isMobileDevice reports whether the running app is on a touch device, false when no app exists yet
+func isMobileDevice() bool {
+	app := fyne.CurrentApp()
+	if app == nil || app.Driver() == nil || app.Driver().Device() == nil {
+		return false
+	}
+	return app.Driver().Device().IsMobile()
+}
+
+// IsTouchModeEnabled returns true when taps show datapoint values in place of mouse hover
+func (w *LineChartSkn) IsTouchModeEnabled() bool {
+	return w.enableTouchMode
+}
+
+// SetTouchMode makes a primary tap show the value of the datapoint under it, as hover does with a mouse.
+// Enabled by default on mobile devices
+func (w *LineChartSkn) SetTouchMode(enable bool) {
+	w.enableTouchMode = enable
+}
+
+// Dragged moves an annotation label when editing, pans a zoomed chart, or extends the zoom
+// selection started by MouseDown
+func (w *LineChartSkn) Dragged(de *fyne.DragEvent) {
+	w.debugLog("LineChartSkn::Dragged() ENTER")
+	w.localizeEvent(&de.PointEvent)
+	if !w.dragInProgress { // touch drivers send no MouseDown, check where the drag began
+		w.dragInProgress = true
+		if w.draggedAnnotation < 0 {
+			w.beginAnnotationDrag(fyne.NewPos(de.Position.X-de.Dragged.DX, de.Position.Y-de.Dragged.DY))
+		}
+	}
+	if w.draggedAnnotation >= 0 {
+		if w.dragAnnotation(de.Position) {
+			w.Refresh()
+		}
+		w.debugLog("LineChartSkn::Dragged(annotation) EXIT")
+		return
+	}
+	if w.selectionActive { // desktop drivers stop sending MouseMoved once a drag starts
+		w.selectionEnd = w.clampToPlotArea(de.Position)
+		w.Refresh()
+		w.debugLog("LineChartSkn::Dragged(zoom selection) EXIT")
+		return
+	}
+	if w.panViewport(de.Dragged.DX, de.Dragged.DY) {
+		w.Refresh()
+		vp := w.GetViewport()
+		w.broadcastViewport(&vp)
+	}
+	w.debugLog("LineChartSkn::Dragged() EXIT")
+}
+
+// DragEnd finishes a pan or annotation move
+func (w *LineChartSkn) DragEnd() {
+	w.debugLog("LineChartSkn::DragEnd()")
+	w.dragInProgress = false
+	w.draggedAnnotation = -1
+}
+
+// panViewport moves the zoomed region opposite the pointer movement, kept within the full data extent
+// returns false when the chart is not zoomed or is already at the edge
+func (w *LineChartSkn) panViewport(dx, dy float32) bool {
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	if w.viewport == nil {
+		return false
+	}
+	width := w.plotMax.X - w.plotMin.X
+	height := w.plotMax.Y - w.plotMin.Y
+	if width <= 0 || height <= 0 {
+		return false
+	}
+	vp := *w.viewport
+	if w.yInverted {
+		dy = -dy
+	}
+	shiftX := clampShift(-dx/width*(vp.XMax-vp.XMin), vp.XMin, vp.XMax, 0, float32(w.dataPointXLimit-1))
+	shiftY := clampShift(dy/height*(vp.YMax-vp.YMin), vp.YMin, vp.YMax, 0, w.dataPointYLimit)
+	if shiftX == 0 && shiftY == 0 {
+		return false
+	}
+	w.viewport = &ChartViewport{
+		XMin: vp.XMin + shiftX,
+		XMax: vp.XMax + shiftX,
+		YMin: vp.YMin + shiftY,
+		YMax: vp.YMax + shiftY,
+	}
+	w.relayoutRequired = true
+	return true
+}
+
+// clampShift limits shift so the range lo-hi stays within floor-ceiling
+func clampShift(shift, lo, hi, floor, ceiling float32) float32 {
+	if lo+shift < floor {
+		shift = floor - lo
+	}
+	if hi+shift > ceiling {
+		shift = ceiling - hi
+	}
+	if lo+shift < floor { // range wider than the extent
+		return 0
+	}
+	return shift
+}
+
+// showDatapointAt displays the popup for the datapoint nearest the position, as hovering does;
+// returns false when no datapoint is in range
+func (w *LineChartSkn) showDatapointAt(pos fyne.Position) bool {
+	w.mapsLock.Lock()
+	if w.hoverMode == HoverCompareSeries {
+		w.updateCompareRows(pos)
+		w.hoverPoint = nil
+		matched := len(w.compareRows) > 0
+		w.mapsLock.Unlock()
+		return matched
+	}
+	key, idx, point, matched := w.nearestDatapoint(pos)
+	if matched {
+		w.debugLog("showDatapointAt() matched Position: ", pos, ", Series: ", key, ", Index: ", idx)
+		value := fmt.Sprint(key, ", ", w.pointXText(idx, *point), ", ", w.Message(MessageValue), ": ", w.pointValueText(key, *point), "    [", (*point).Timestamp(), "]")
+		w.enableMouseContainer(value, w.pointColor(key, point), &pos)
+		w.mouseDisplayContent = w.buildPopupContent(key, point, pos)
+		w.hoverSeries, w.hoverIndex, w.hoverPoint = strings.Clone(key), idx, (*point).Copy()
+		if w.OnHoverPointCallback != nil {
+			w.OnHoverPointCallback(strings.Clone(key), (*point).Copy())
+		}
+	}
+	w.mapsLock.Unlock()
+	return matched
+}
+
+// tapDatapoint touch mode tap, shows the tapped datapoint's value or clears the popup when none is hit
+func (w *LineChartSkn) tapDatapoint(pos fyne.Position) {
+	w.debugLog("LineChartSkn::tapDatapoint() ENTER")
+	if w.enableCrosshair {
+		w.crosshairActive = w.isInsidePlotArea(pos)
+		w.crosshairPosition = pos
+	}
+	if !w.enableMousePointDisplay || !w.showDatapointAt(pos) {
+		w.disableMouseContainer()
+		w.debugLog("LineChartSkn::tapDatapoint(no match) EXIT")
+		return
+	}
+	w.Refresh()
+	w.debugLog("LineChartSkn::tapDatapoint() EXIT")
+}
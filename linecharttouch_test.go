@@ -0,0 +1,87 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Touch interaction", func() {
+	var (
+		lc     sknlinechart.LineChart
+		skn    *sknlinechart.LineChartSkn
+		points []*sknlinechart.ChartDatapoint
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(float32(10*i), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Testing": points}),
+			sknlinechart.WithTouchMode(true)))
+		lc.Resize(fyne.NewSize(800, 400))
+		skn = lc.(*sknlinechart.LineChartSkn)
+	})
+
+	It("should be off by default on desktop", func() {
+		chart, _ := makeUI("Testing", "Touch", 10)
+		Expect(chart.IsTouchModeEnabled()).To(BeFalse())
+		Expect(lc.IsTouchModeEnabled()).To(BeTrue())
+	})
+	It("should show the tapped datapoint's value", func() {
+		var tapped sknlinechart.ChartDatapoint
+		lc.SetOnHoverPointCallback(func(series string, dataPoint sknlinechart.ChartDatapoint) {
+			tapped = dataPoint
+		})
+		top, bottom := (*points[5]).MarkerPosition()
+		skn.Tapped(&fyne.PointEvent{Position: fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)})
+
+		Expect(tapped).NotTo(BeNil())
+		Expect(tapped.Value()).To(BeNumerically("==", 50))
+		Expect(lc.IsMousePointDisplayEnabled()).To(BeTrue())
+	})
+	It("should keep the primary button action when touch mode is off", func() {
+		lc.SetTouchMode(false)
+		skn.Tapped(&fyne.PointEvent{Position: fyne.NewPos(10, 10)})
+		Expect(lc.IsMousePointDisplayEnabled()).To(BeFalse())
+	})
+	It("should pan a zoomed chart within the data extent", func() {
+		err := lc.SetViewport(sknlinechart.ChartViewport{XMin: 10, XMax: 40, YMin: 20, YMax: 60})
+		Expect(err).NotTo(HaveOccurred())
+
+		skn.Dragged(&fyne.DragEvent{Dragged: fyne.NewDelta(-100, 0)})
+		skn.DragEnd()
+		vp := lc.GetViewport()
+		Expect(vp.XMin).To(BeNumerically(">", 10))
+		Expect(vp.XMax - vp.XMin).To(BeNumerically("~", 30, 0.01))
+
+		skn.Dragged(&fyne.DragEvent{Dragged: fyne.NewDelta(100000, 0)})
+		Expect(lc.GetViewport().XMin).To(BeNumerically("==", 0))
+		Expect(lc.GetViewport().XMax).To(BeNumerically("~", 30, 0.01))
+	})
+	It("should not pan the full extent", func() {
+		skn.Dragged(&fyne.DragEvent{Dragged: fyne.NewDelta(-100, 50)})
+		Expect(lc.IsZoomed()).To(BeFalse())
+	})
+	It("should extend a zoom selection while dragging", func() {
+		lc.SetZoomSelection(true)
+		down := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary}
+		down.Position = fyne.NewPos(100, 100)
+		skn.MouseDown(down)
+		skn.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(300, 200)}, Dragged: fyne.NewDelta(200, 100)})
+		up := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary}
+		up.Position = fyne.NewPos(300, 200)
+		skn.MouseUp(up)
+		skn.DragEnd()
+
+		Expect(lc.IsZoomed()).To(BeTrue())
+	})
+})
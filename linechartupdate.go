@@ -0,0 +1,123 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// UpdateDataPoint revises the value of a datapoint already in the series, such as the running
+// aggregate of the current minute, without removing and re-adding it. Only the point's marker and
+// the line segments either side of it are redrawn. Index 0 is the oldest point; revising a missing
+// sample fills it, and non-finite values follow the chart's NonFiniteValuePolicy
+func (w *LineChartSkn) UpdateDataPoint(seriesName string, index int, newValue float32) error {
+	w.debugLog("LineChartSkn::UpdateDataPoint() ENTER")
+	w.mapsLock.Lock()
+	points, ok := w.dataPoints[seriesName]
+	if !ok {
+		w.mapsLock.Unlock()
+		w.debugLog("LineChartSkn::UpdateDataPoint() ERROR EXIT")
+		return fmt.Errorf("UpdateDataPoint() series not found: %s", seriesName)
+	}
+	if index < 0 || index >= len(points) {
+		w.mapsLock.Unlock()
+		w.debugLog("LineChartSkn::UpdateDataPoint() ERROR EXIT")
+		return fmt.Errorf("UpdateDataPoint() index %d out of range for series %s of %d points", index, seriesName, len(points))
+	}
+	point := points[index]
+	oldValue, wasMissing := (*point).Value(), (*point).IsMissing()
+	(*point).SetValue(newValue)
+	(*point).SetMissing(false)
+	if !w.acceptDatapoint(point) {
+		(*point).SetValue(oldValue)
+		(*point).SetMissing(wasMissing)
+		w.mapsLock.Unlock()
+		w.debugLog("LineChartSkn::UpdateDataPoint() ERROR EXIT")
+		return fmt.Errorf("UpdateDataPoint() value rejected by the non-finite value policy: %v", newValue)
+	}
+	if w.updatedPoints == nil {
+		w.updatedPoints = map[string][]int{}
+	}
+	w.updatedPoints[seriesName] = append(w.updatedPoints[seriesName], index)
+	fromLatest := len(points) - 1 - index
+	w.mapsLock.Unlock()
+
+	w.mirrorUpdate(seriesName, fromLatest, newValue)
+	w.refreshCoalesced()
+	w.debugLog("LineChartSkn::UpdateDataPoint() EXIT")
+	return nil
+}
+
+// mirrorUpdate forwards a revised value to each detached chart of the series, whose points are
+// matched counting back from the latest since they may hold a shorter history
+func (w *LineChartSkn) mirrorUpdate(seriesName string, fromLatest int, newValue float32) {
+	for _, chart := range w.detachedChartsFor(seriesName) {
+		chart.mapsLock.RLock()
+		index := len(chart.dataPoints[seriesName]) - 1 - fromLatest
+		chart.mapsLock.RUnlock()
+		if index >= 0 {
+			_ = chart.UpdateDataPoint(seriesName, index, newValue)
+		}
+	}
+}
+
+// layoutUpdatedPoints redraws the datapoints revised since the last refresh, laying out
+// a whole series only when a point's visibility may have changed
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutUpdatedPoints() {
+	for series, indexes := range r.widget.updatedPoints {
+		for _, idx := range indexes {
+			if !r.layoutUpdatedPoint(series, idx) {
+				r.layoutSeries(series)
+				break
+			}
+		}
+	}
+	r.widget.updatedPoints = nil
+}
+
+// layoutUpdatedPoint moves a revised datapoint's marker and the ends of the line segments joining it
+// to its neighbours, false when the point was not drawn before or cannot be drawn now
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutUpdatedPoint(series string, idx int) bool {
+	data := r.widget.dataPoints[series]
+	if idx >= len(data) {
+		return true // shifted out since the update
+	}
+	o, held := r.objectIndex(series, idx) // the point's line and marker
+	if !held {
+		// outside the viewport, or laid out with its series on the next refresh; a point skipped by
+		// the render quality or downsampling moves its hover position with its series instead
+		return r.virtualIndexes[series] == nil
+	}
+	point := data[idx]
+	top, _ := (*point).MarkerPosition()
+	if top.IsZero() || !isFinite((*point).Value()) || (*point).IsMissing() || r.widget.drawsPooledLines(series) || r.downsampled[series] {
+		return false
+	}
+
+	thisPoint := r.widget.dataToPosition(r.widget.pointX(idx, *point), (*point).Value())
+	thisPoint.X = float32(math.Trunc(float64(thisPoint.X)))
+	thisPoint.Y = float32(math.Trunc(float64(thisPoint.Y)))
+	c := r.seriesColor(series, point)
+
+	dpm := r.dataPointMarkers[series][o]
+	_, size := r.widget.seriesMarker(series)
+	zt := fyne.NewPos(thisPoint.X-size/2, thisPoint.Y-size/2)
+	zb := fyne.NewPos(thisPoint.X+size/2, thisPoint.Y+size/2)
+	dpm.Position1, dpm.Position2 = zt, zb
+	dpm.FillColor = c
+	(*point).SetMarkerPosition(&zt, &zb)
+
+	dpv := r.dataPoints[series][o]
+	if dpv.Position1 == dpv.Position2 { // the first point drawn, a line to itself
+		dpv.Position2 = thisPoint
+	}
+	dpv.Position1 = thisPoint
+	dpv.StrokeColor = c
+	if o+1 < len(r.dataPoints[series]) {
+		r.dataPoints[series][o+1].Position2 = thisPoint
+	}
+	return true
+}
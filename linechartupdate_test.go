@@ -0,0 +1,76 @@
+package sknlinechart_test
+
+import (
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Updating a datapoint in place", func() {
+	var (
+		lc      sknlinechart.LineChart
+		win     fyne.Window
+		minutes []sknlinechart.ChartDatapoint
+	)
+
+	// markerY returns the vertical center of the point's marker
+	markerY := func(point sknlinechart.ChartDatapoint) float32 {
+		top, bottom := point.MarkerPosition()
+		return (top.Y + bottom.Y) / 2
+	}
+
+	BeforeEach(func() {
+		minutes = nil
+		for _, value := range []float32{40, 50, 60, 70, 80} {
+			minutes = append(minutes, sknlinechart.NewChartDatapoint(value, theme.ColorOrange, time.Now().Format(time.RFC1123)))
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithRefreshInterval(0))) // redraw on every point
+		lc.ApplyDataPoints("Aggregate", minutes)
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should move the revised point and the segments joining it", func() {
+		before, left, right := markerY(minutes[2]), markerY(minutes[1]), markerY(minutes[3])
+		Expect(lc.UpdateDataPoint("Aggregate", 2, 90)).To(Succeed())
+
+		Expect(lc.GetDataSeries("Aggregate")[2].Value()).To(BeNumerically("==", 90))
+		Expect(markerY(minutes[2])).To(BeNumerically("<", before))
+		Expect([]float32{markerY(minutes[1]), markerY(minutes[3])}).To(Equal([]float32{left, right}))
+
+		var joined int
+		for _, line := range seriesLines(lc, theme.PrimaryColorNamed(theme.ColorOrange)) {
+			if line.Position1.Y == markerY(minutes[2]) || line.Position2.Y == markerY(minutes[2]) {
+				joined++
+			}
+		}
+		Expect(joined).To(Equal(2))
+	})
+	It("should fill a missing sample once it is revised", func() {
+		missing := sknlinechart.NewMissingDatapoint(theme.ColorOrange, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Aggregate", &missing)
+		Expect(lc.GetDataSeries("Aggregate")[5].IsMissing()).To(BeTrue())
+
+		Expect(lc.UpdateDataPoint("Aggregate", 5, 65)).To(Succeed())
+		Expect(lc.GetDataSeries("Aggregate")[5].IsMissing()).To(BeFalse())
+		Expect(markerY(missing)).To(BeNumerically(">", 0))
+	})
+	It("should reject unknown series, bad indexes, and dropped values", func() {
+		Expect(lc.UpdateDataPoint("Unknown", 0, 1)).To(HaveOccurred())
+		Expect(lc.UpdateDataPoint("Aggregate", 5, 1)).To(HaveOccurred())
+		Expect(lc.UpdateDataPoint("Aggregate", -1, 1)).To(HaveOccurred())
+
+		lc.SetNonFiniteValuePolicy(sknlinechart.NonFiniteDrop)
+		Expect(lc.UpdateDataPoint("Aggregate", 4, float32(math.Inf(1)))).To(HaveOccurred())
+		Expect(lc.GetDataSeries("Aggregate")[4].Value()).To(BeNumerically("==", 80))
+	})
+})
@@ -0,0 +1,207 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+)
+
+// MarkerAt where a vertical marker stands on the x axis: a datapoint index, or a time when Time is set
+type MarkerAt struct {
+	Index int
+	Time  time.Time
+}
+
+// MarkerAtIndex places a vertical marker at a datapoint index, a fixed position on the x axis
+func MarkerAtIndex(index int) MarkerAt {
+	return MarkerAt{Index: index}
+}
+
+// MarkerAtTime places a vertical marker at a time, which follows the data as older points roll off
+func MarkerAtTime(at time.Time) MarkerAt {
+	return MarkerAt{Time: at}
+}
+
+// String describes the marker's place for errors and popups
+func (m MarkerAt) String() string {
+	if m.Time.IsZero() {
+		return fmt.Sprint("Index: ", m.Index)
+	}
+	return m.Time.Format(time.RFC3339)
+}
+
+// VerticalMarker labeled line across the plot's height marking an event, like a deploy or an alarm
+type VerticalMarker struct {
+	At    MarkerAt
+	Text  string
+	Color color.Color // nil for the foreground color
+}
+
+// AddVerticalMarker draws a line across the plot's height at an index or time, flagged with text at
+// its top, so events such as deploys or alarms show against the data. A marker already at the same
+// place is replaced. Time markers are placed by time spacing when enabled, otherwise at the first
+// datapoint of the longest series at or after the time, and are hidden while no datapoint is that recent
+func (w *LineChartSkn) AddVerticalMarker(at MarkerAt, text string, markerColor color.Color) error {
+	w.debugLog("LineChartSkn::AddVerticalMarker() ENTER")
+	marker := VerticalMarker{At: at, Text: text, Color: markerColor}
+	if err := marker.validate(); err != nil {
+		w.debugLog("LineChartSkn::AddVerticalMarker() ERROR EXIT")
+		return fmt.Errorf("AddVerticalMarker() %w", err)
+	}
+	w.mapsLock.Lock()
+	w.setVerticalMarker(marker)
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::AddVerticalMarker() EXIT")
+	return nil
+}
+
+// RemoveVerticalMarker removes the marker at the place, false when there is none
+func (w *LineChartSkn) RemoveVerticalMarker(at MarkerAt) bool {
+	w.debugLog("LineChartSkn::RemoveVerticalMarker()")
+	w.mapsLock.Lock()
+	removed := false
+	for idx, marker := range w.verticalMarkers {
+		if marker.At.equal(at) {
+			w.verticalMarkers = append(w.verticalMarkers[:idx], w.verticalMarkers[idx+1:]...)
+			removed = true
+			break
+		}
+	}
+	w.mapsLock.Unlock()
+	if removed {
+		w.Refresh()
+	}
+	return removed
+}
+
+// GetVerticalMarkers returns the vertical markers in the order added
+func (w *LineChartSkn) GetVerticalMarkers() []VerticalMarker {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return append([]VerticalMarker(nil), w.verticalMarkers...)
+}
+
+// validate checks the marker has text and a place on the axis
+func (m VerticalMarker) validate() error {
+	if strings.TrimSpace(m.Text) == "" {
+		return fmt.Errorf("[%v] marker text is empty", m.At)
+	}
+	if m.At.Time.IsZero() && m.At.Index < 0 {
+		return fmt.Errorf("marker index out of range: %d", m.At.Index)
+	}
+	return nil
+}
+
+// equal compares places, times by instant whatever their location
+func (m MarkerAt) equal(other MarkerAt) bool {
+	return m.Index == other.Index && m.Time.Equal(other.Time)
+}
+
+// setVerticalMarker replaces the marker at the same place or appends it
+// caller must hold the mapsLock
+func (w *LineChartSkn) setVerticalMarker(marker VerticalMarker) {
+	for idx := range w.verticalMarkers {
+		if w.verticalMarkers[idx].At.equal(marker.At) {
+			w.verticalMarkers[idx] = marker
+			return
+		}
+	}
+	w.verticalMarkers = append(w.verticalMarkers, marker)
+}
+
+// markerX returns where the marker stands on the x axis in index units, false when no datapoint reaches its time
+// caller must hold the mapsLock
+func (w *LineChartSkn) markerX(at MarkerAt) (float32, bool) {
+	if at.Time.IsZero() {
+		return float32(at.Index), true
+	}
+	if w.timeSpan != nil {
+		return w.timeX(at.Time), true
+	}
+	names := make([]string, 0, len(w.dataPoints))
+	for name := range w.dataPoints {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if len(w.dataPoints[names[i]]) != len(w.dataPoints[names[j]]) {
+			return len(w.dataPoints[names[i]]) > len(w.dataPoints[names[j]])
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		for idx, point := range w.dataPoints[name] {
+			ts, ok := (*point).Time()
+			if ok && !ts.Before(at.Time) {
+				return w.pointX(idx, *point), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// layoutVerticalMarkers draws each marker's line and flag label, hiding those outside the viewport
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutVerticalMarkers() {
+	for len(r.verticalMarkerDisplays) < len(r.widget.verticalMarkers) {
+		line := canvas.NewLine(theme.ForegroundColor())
+		line.StrokeWidth = 1.0
+		frame := canvas.NewRectangle(theme.OverlayBackgroundColor())
+		frame.StrokeWidth = 1.0
+		text := canvas.NewText("", theme.ForegroundColor())
+		text.TextSize = theme.CaptionTextSize()
+		display := container.NewWithoutLayout(line, frame, text)
+		display.Hide()
+		r.verticalMarkerDisplays = append(r.verticalMarkerDisplays, display)
+	}
+
+	for idx, display := range r.verticalMarkerDisplays {
+		line := display.Objects[0].(*canvas.Line)
+		if idx >= len(r.widget.verticalMarkers) {
+			line.Hide()
+			display.Hide()
+			continue
+		}
+		marker := r.widget.verticalMarkers[idx]
+		x, ok := r.widget.markerX(marker.At)
+		if !ok || !r.widget.isXVisible(x) {
+			line.Hide()
+			display.Hide()
+			continue
+		}
+		c := marker.Color
+		if c == nil {
+			c = theme.ForegroundColor()
+		}
+		c = r.widget.cvdColor(c)
+		xp := r.pixels.x(r.widget.dataToPosition(x, 0).X)
+		line.StrokeColor = c
+		line.Position1 = fyne.NewPos(xp, r.widget.plotMin.Y)
+		line.Position2 = fyne.NewPos(xp, r.widget.plotMax.Y)
+		line.Show()
+
+		ts := fyne.MeasureText(marker.Text, theme.CaptionTextSize(), fyne.TextStyle{})
+		size := fyne.NewSize(ts.Width+theme.Padding()*2, ts.Height+theme.Padding())
+		pos := fyne.NewPos(xp, r.widget.plotMin.Y)
+		if pos.X+size.Width > r.widget.plotMax.X { // flag to the left near the right edge
+			pos.X = xp - size.Width
+		}
+		frame := display.Objects[1].(*canvas.Rectangle)
+		frame.StrokeColor = c
+		frame.Move(pos)
+		frame.Resize(size)
+		text := display.Objects[2].(*canvas.Text)
+		text.Text = marker.Text
+		text.Move(fyne.NewPos(pos.X+theme.Padding(), pos.Y+theme.Padding()/2))
+
+		display.Show()
+		display.Refresh()
+	}
+}
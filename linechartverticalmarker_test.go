@@ -0,0 +1,84 @@
+package sknlinechart_test
+
+import (
+	"bytes"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Vertical markers", func() {
+	var (
+		lc     sknlinechart.LineChart
+		points []*sknlinechart.ChartDatapoint
+		start  = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		purple = color.NRGBA{R: 0x80, B: 0xc0, A: 0xff}
+	)
+
+	BeforeEach(func() {
+		points = nil
+		for i := 0; i < 10; i++ {
+			point := sknlinechart.NewChartDatapoint(50, theme.ColorBlue, start.Add(time.Duration(i)*time.Minute).Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithDataPoints(map[string][]*sknlinechart.ChartDatapoint{"Requests": points})))
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should draw a flagged line across the plot at an index", func() {
+		Expect(lc.AddVerticalMarker(sknlinechart.MarkerAtIndex(4), "deploy v2", purple)).To(Succeed())
+		lines := seriesLines(lc, purple)
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0].Position1.X).To(BeNumerically("~", markerX(*points[4]), 1))
+		Expect(lines[0].Position1.X).To(Equal(lines[0].Position2.X))
+		Expect(lines[0].Position2.Y - lines[0].Position1.Y).To(BeNumerically(">", 250))
+
+		label := visibleText(lc, "deploy v2")
+		Expect(label).NotTo(BeNil())
+		Expect(label.Position().X).To(BeNumerically(">=", lines[0].Position1.X))
+
+		Expect(lc.RemoveVerticalMarker(sknlinechart.MarkerAtIndex(4))).To(BeTrue())
+		Expect(lc.RemoveVerticalMarker(sknlinechart.MarkerAtIndex(4))).To(BeFalse())
+		Expect(seriesLines(lc, purple)).To(BeEmpty())
+		Expect(visibleText(lc, "deploy v2")).To(BeNil())
+	})
+	It("should place a time marker at the first datapoint reaching its time", func() {
+		Expect(lc.AddVerticalMarker(sknlinechart.MarkerAtTime(start.Add(150*time.Second)), "alarm", purple)).To(Succeed())
+		lines := seriesLines(lc, purple)
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0].Position1.X).To(BeNumerically("~", markerX(*points[3]), 1))
+
+		Expect(lc.AddVerticalMarker(sknlinechart.MarkerAtTime(start.Add(time.Hour)), "later", purple)).To(Succeed())
+		Expect(seriesLines(lc, purple)).To(HaveLen(1))
+		Expect(visibleText(lc, "later")).To(BeNil())
+	})
+	It("should replace a marker at the same place and reject empty text", func() {
+		Expect(lc.AddVerticalMarker(sknlinechart.MarkerAtIndex(2), "note", nil)).To(Succeed())
+		Expect(lc.AddVerticalMarker(sknlinechart.MarkerAtIndex(2), "revised", purple)).To(Succeed())
+		Expect(lc.GetVerticalMarkers()).To(HaveLen(1))
+		Expect(visibleText(lc, "revised")).NotTo(BeNil())
+
+		Expect(lc.AddVerticalMarker(sknlinechart.MarkerAtIndex(3), " ", nil)).To(HaveOccurred())
+		Expect(lc.AddVerticalMarker(sknlinechart.MarkerAtIndex(-1), "before", nil)).To(HaveOccurred())
+	})
+	It("should keep markers in saved state", func() {
+		Expect(lc.AddVerticalMarker(sknlinechart.MarkerAtIndex(2), "note", nil)).To(Succeed())
+		Expect(lc.AddVerticalMarker(sknlinechart.MarkerAtTime(start.Add(time.Minute)), "deploy", purple)).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(lc.SaveState(&buf)).To(Succeed())
+		restored, err := sknlinechart.NewLineChartFromJSON(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		markers := restored.GetVerticalMarkers()
+		Expect(markers).To(HaveLen(2))
+		Expect(markers[0].At).To(Equal(sknlinechart.MarkerAtIndex(2)))
+		Expect(markers[1].At.Time.Equal(start.Add(time.Minute))).To(BeTrue())
+		Expect(markers[1].Text).To(Equal("deploy"))
+	})
+})
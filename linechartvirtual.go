@@ -0,0 +1,143 @@
+package sknlinechart
+
+import (
+	"sort"
+
+	"fyne.io/fyne/v2/canvas"
+)
+
+// visibleSpan returns the indexes of the series' first point within the viewport and of the one
+// after its last, every point unless zoomed in or spaced along the x axis; from equals to when none are
+// caller must hold the mapsLock
+func (w *LineChartSkn) visibleSpan(series string) (from, to int) {
+	if w.viewport == nil && w.xAxis == nil && w.timeSpan == nil { // placed by index, the first XLimit are shown
+		to = len(w.dataPoints[series])
+		if to > w.dataPointXLimit {
+			to = w.dataPointXLimit
+		}
+		return 0, to
+	}
+	for idx, point := range w.dataPoints[series] {
+		if !w.isXVisible(w.pointX(idx, *point)) {
+			continue
+		}
+		if to == 0 {
+			from = idx
+		}
+		to = idx + 1
+	}
+	return from, to
+}
+
+// virtualize holds lines and markers for the series' drawn points alone: those within the viewport
+// and, of them, the ones kept by downsampling and the render quality's stride. Zoomed into a long
+// history, or out across it, the renderer keeps objects in proportion to the plot's width rather than
+// to the history held. The series' objects start with the point at virtualBase, one per point from
+// there unless virtualIndexes lists the points they draw; grew is true when points gained objects,
+// moved when the points held changed other than by appending
+// caller must hold the mapsLock
+func (r *lineChartRenderer) virtualize(series string, keep []bool) (grew, moved bool) {
+	data := r.widget.dataPoints[series]
+	from, to := r.widget.visibleSpan(series)
+	if stride := r.widget.pointStride(); keep != nil || stride != 1 {
+		drawn := make([]int, 0, len(r.virtualIndexes[series]))
+		for idx := from; idx < to; idx++ {
+			if (keep == nil || keep[idx]) && (idx%stride == 0 || idx == len(data)-1) {
+				drawn = append(drawn, idx)
+			}
+		}
+		return r.virtualizeDrawn(series, from, drawn)
+	}
+	base := r.virtualBase[series]
+	lines, markers := r.dataPoints[series], r.dataPointMarkers[series]
+
+	if r.virtualIndexes[series] == nil {
+		if from == base && to <= base+len(lines) { // the same points, or fewer at the end
+			if to < base+len(lines) {
+				r.releasePointObjects(series, to-base)
+				moved = true
+			}
+			return false, moved
+		}
+		if from == base { // appended to
+			for idx := base + len(lines); idx < to; idx++ {
+				line, marker := r.pointObjects((*data[idx]).ColorName())
+				lines, markers = append(lines, line), append(markers, marker)
+			}
+			r.dataPoints[series], r.dataPointMarkers[series] = lines, markers
+			return true, false
+		}
+	}
+
+	// the viewport moved along the series, or every point is drawn again, the points still shown keep their objects
+	drawn := make([]int, 0, to-from)
+	for idx := from; idx < to; idx++ {
+		drawn = append(drawn, idx)
+	}
+	grew, moved = r.virtualizeDrawn(series, from, drawn)
+	delete(r.virtualIndexes, series)
+	return grew, moved
+}
+
+// virtualizeDrawn holds a line and marker for each of the drawn points, ascending from the first
+// in the viewport at from, the points already held keeping their objects
+// caller must hold the mapsLock
+func (r *lineChartRenderer) virtualizeDrawn(series string, from int, drawn []int) (grew, moved bool) {
+	data := r.widget.dataPoints[series]
+	lines, markers := r.dataPoints[series], r.dataPointMarkers[series]
+	if held := r.virtualIndexes[series]; held != nil && len(held) == len(drawn) && r.virtualBase[series] == from {
+		same := true
+		for o, idx := range drawn {
+			if held[o] != idx {
+				same = false
+				break
+			}
+		}
+		if same {
+			return false, false
+		}
+	}
+
+	held := make([]*canvas.Line, 0, len(drawn))
+	heldMarkers := make([]*canvas.Circle, 0, len(drawn))
+	for _, idx := range drawn {
+		if o, ok := r.objectIndex(series, idx); ok && lines[o] != nil {
+			held, heldMarkers = append(held, lines[o]), append(heldMarkers, markers[o])
+			lines[o] = nil
+			continue
+		}
+		line, marker := r.pointObjects((*data[idx]).ColorName())
+		held, heldMarkers = append(held, line), append(heldMarkers, marker)
+		grew = true
+	}
+	for o, line := range lines {
+		if line != nil {
+			r.releasePointObject(line, markers[o])
+		}
+	}
+	r.dataPoints[series], r.dataPointMarkers[series] = held, heldMarkers
+	r.virtualBase[series] = from
+	r.virtualIndexes[series] = drawn
+	return grew, true
+}
+
+// objectIndex returns the position among the series' lines and markers of those drawing the point
+// at idx, false when none do: outside the viewport, or skipped by downsampling or the render quality
+// caller must hold the mapsLock
+func (r *lineChartRenderer) objectIndex(series string, idx int) (int, bool) {
+	if drawn := r.virtualIndexes[series]; drawn != nil {
+		o := sort.SearchInts(drawn, idx)
+		return o, o < len(drawn) && drawn[o] == idx
+	}
+	o := idx - r.virtualBase[series]
+	return o, o >= 0 && o < len(r.dataPoints[series])
+}
+
+// pointIndex returns the index of the point drawn by the series' line and marker at o
+// caller must hold the mapsLock
+func (r *lineChartRenderer) pointIndex(series string, o int) int {
+	if drawn := r.virtualIndexes[series]; drawn != nil {
+		return drawn[o]
+	}
+	return r.virtualBase[series] + o
+}
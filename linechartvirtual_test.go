@@ -0,0 +1,79 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Viewport virtualization", func() {
+	var (
+		lc     sknlinechart.LineChart
+		win    fyne.Window
+		points []sknlinechart.ChartDatapoint
+	)
+
+	// markers counts the datapoint markers the renderer holds
+	markers := func() int {
+		count := 0
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if _, ok := o.(*canvas.Circle); ok {
+				count++
+			}
+		}
+		return count
+	}
+
+	BeforeEach(func() {
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithXLimit(10000),
+			sknlinechart.WithProgressiveLoading(false),
+		))
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+		points = nil
+		for i := 0; i < 10000; i++ {
+			points = append(points, sknlinechart.NewChartDatapoint(float32(20+i%30), theme.ColorOrange, time.Now().Format(time.RFC1123)))
+		}
+		lc.ApplyDataPoints("History", points)
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should hold objects for the points within the viewport alone", func() {
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 5000, XMax: 5099, YMin: 0, YMax: 100})).To(Succeed())
+		Expect(markers()).To(BeNumerically("<", 200))
+		Expect(markerX(points[5050])).To(BeNumerically(">", 0))
+		Expect(markerX(points[4000])).To(BeZero())
+	})
+	It("should hold objects for the downsampled points alone, bounded by the plot's width", func() {
+		Expect(markers()).To(BeNumerically("<", 800))
+		Expect(markerX(points[4001])).To(BeNumerically(">", 0), "points drawn by no objects are still hovered")
+
+		lc.SetDownsampling(false)
+		Expect(markers()).To(BeNumerically(">=", 10000))
+		lc.SetDownsampling(true)
+		Expect(markers()).To(BeNumerically("<", 800))
+	})
+	It("should follow the viewport along the history", func() {
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 100, XMax: 199, YMin: 0, YMax: 100})).To(Succeed())
+		held := markers()
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 150, XMax: 249, YMin: 0, YMax: 100})).To(Succeed())
+		Expect(markers()).To(Equal(held))
+		Expect(markerX(points[120])).To(BeZero())
+		Expect(markerX(points[240])).To(BeNumerically(">", markerX(points[200])))
+	})
+	It("should place every point again once the zoom is reset", func() {
+		Expect(lc.SetViewport(sknlinechart.ChartViewport{XMin: 5000, XMax: 5099, YMin: 0, YMax: 100})).To(Succeed())
+		lc.ResetZoom()
+		Expect(markers()).To(BeNumerically("<", 800))
+		Expect(markerX(points[4000])).To(BeNumerically(">", 0))
+	})
+})
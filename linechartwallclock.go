@@ -0,0 +1,80 @@
+package sknlinechart
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// wallClockSteps intervals the time spaced grid may divide the x axis by, each a natural boundary of the clock
+var wallClockSteps = []time.Duration{
+	time.Second, 2 * time.Second, 5 * time.Second, 10 * time.Second, 15 * time.Second, 30 * time.Second,
+	time.Minute, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 2 * time.Hour, 3 * time.Hour, 6 * time.Hour, 12 * time.Hour, 24 * time.Hour,
+}
+
+// wallClockTicks returns the wall-clock boundaries inside the viewport when spaced by time, whole
+// seconds, minutes or hours at the finest step leaving room for at most maxTicks labelled grid lines.
+// None are returned when the x axis is not time based or no step fits
+// caller must hold the mapsLock
+func (w *LineChartSkn) wallClockTicks(maxTicks int) []time.Time {
+	if w.timeSpan == nil || w.xAxis != nil || maxTicks < 2 {
+		return nil
+	}
+	vp := w.currentViewport()
+	from, to := w.xTime(vp.XMin), w.xTime(vp.XMax)
+	for _, step := range wallClockSteps {
+		if to.Sub(from)/step+1 > time.Duration(maxTicks) {
+			continue
+		}
+		var ticks []time.Time
+		for tick := wallClockCeil(from, step); !tick.After(to); tick = tick.Add(step) {
+			ticks = append(ticks, tick)
+		}
+		return ticks
+	}
+	return nil
+}
+
+// wallClockCeil returns the first boundary of step at or after ts, counted in ts's own time zone
+// so hours and days fall on the local clock
+func wallClockCeil(ts time.Time, step time.Duration) time.Time {
+	_, offset := ts.Zone()
+	zone := time.Duration(offset) * time.Second
+	tick := ts.Add(zone).Truncate(step).Add(-zone)
+	if tick.Before(ts) {
+		tick = tick.Add(step)
+	}
+	return tick
+}
+
+// layoutWallClockGrid moves the vertical grid lines and x axis labels onto wall-clock boundaries
+// when spaced by time, recomputed on each refresh as the window scrolls. Lines left without a boundary are hidden
+// caller must hold the mapsLock
+func (r *lineChartRenderer) layoutWallClockGrid() {
+	r.wallClock = nil
+	defer r.manageGridLineVisibility()
+	if r.widget.timeSpan == nil || r.widget.xAxis != nil {
+		return
+	}
+	label := fyne.MeasureText(r.widget.formatAxisTime(r.widget.timeSpan.end), theme.TextSize(), fyne.TextStyle{})
+	width := r.widget.plotMax.X - r.widget.plotMin.X
+	maxTicks := int(width / (label.Width + theme.Padding()*2))
+	if maxTicks > len(r.xLines) {
+		maxTicks = len(r.xLines)
+	}
+	r.wallClock = r.widget.wallClockTicks(maxTicks)
+	if r.wallClock == nil {
+		return
+	}
+
+	top, bottom := r.yInc, float32(YPointLimit+1)*r.yInc
+	for idx, tick := range r.wallClock {
+		xp := r.pixels.x(r.widget.dataToPosition(r.widget.timeX(tick), 0).X)
+		r.xLines[idx].Position1 = fyne.NewPos(xp, top)
+		r.xLines[idx].Position2 = fyne.NewPos(xp, bottom+8)
+		r.xLabels[idx].Move(fyne.NewPos(xp+label.Width/2, bottom+10))
+	}
+	r.updateXAxisLabels() // a resize may change the step between boundaries
+}
@@ -0,0 +1,80 @@
+package sknlinechart_test
+
+import (
+	"regexp"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Wall-clock grid", func() {
+	var (
+		lc    sknlinechart.LineChart
+		win   fyne.Window
+		start time.Time
+	)
+
+	clockLabel := regexp.MustCompile(`^\d\d:\d\d:\d\d$`)
+	// axisTimes returns the visible x axis time labels, left to right
+	axisTimes := func() []string {
+		var times []string
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if text, ok := o.(*canvas.Text); ok && text.Visible() && clockLabel.MatchString(text.Text) {
+				times = append(times, text.Text)
+			}
+		}
+		return times
+	}
+	// gridLines counts the visible vertical grid lines
+	gridLines := func() int {
+		count := 0
+		for _, line := range seriesLines(lc, theme.PrimaryColorNamed(theme.ColorGreen)) {
+			if line.Position1.X == line.Position2.X && line.Position1.Y != line.Position2.Y {
+				count++
+			}
+		}
+		return count
+	}
+	add := func(offset time.Duration) {
+		point := sknlinechart.NewChartDatapoint(50, theme.ColorOrange, start.Add(offset).Format(time.RFC1123))
+		lc.ApplyDataPoint("Telemetry", &point)
+	}
+
+	BeforeEach(func() {
+		start = time.Date(2023, 6, 1, 12, 0, 3, 0, time.UTC)
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithTimeSpacing(true), sknlinechart.WithRefreshInterval(0)))
+		for _, offset := range []time.Duration{0, 20 * time.Second, 50 * time.Second, 80 * time.Second} {
+			add(offset)
+		}
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should place grid lines on whole seconds of a natural step", func() {
+		times := axisTimes()
+		Expect(times).To(ContainElements("12:00:10", "12:00:30", "12:01:00", "12:01:20"))
+		Expect(times).NotTo(ContainElement("12:00:03"))
+		Expect(gridLines()).To(Equal(len(times)))
+	})
+	It("should recompute the boundaries as the window scrolls", func() {
+		add(10 * time.Minute)
+		times := axisTimes()
+		Expect(times).To(ContainElements("12:01:00", "12:05:00", "12:10:00"))
+		Expect(times).NotTo(ContainElement("12:00:10"))
+		Expect(gridLines()).To(Equal(len(times)))
+	})
+	It("should return to even divisions once spaced by index", func() {
+		lc.SetTimeSpacing(false)
+		Expect(axisTimes()).To(BeEmpty())
+		Expect(gridLines()).To(BeNumerically(">", 100))
+	})
+})
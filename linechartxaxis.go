@@ -0,0 +1,88 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// xAxisRange numeric x values spanning the chart's width, replacing datapoint indexes on the x axis
+type xAxisRange struct {
+	min float32
+	max float32
+}
+
+// SetXAxisRange gives the chart a numeric x axis from min to max; datapoints carrying an x value,
+// see NewXYDatapoint, are plotted at that value instead of their index, so irregular or non-time
+// samples such as torque against RPM keep their true spacing. Points without an x value still plot at their index
+func (w *LineChartSkn) SetXAxisRange(min, max float32) error {
+	w.debugLog("LineChartSkn::SetXAxisRange() ENTER")
+	if max <= min {
+		w.debugLog("LineChartSkn::SetXAxisRange() ERROR EXIT")
+		return fmt.Errorf("SetXAxisRange() max must be greater than min: %v-%v", min, max)
+	}
+	w.mapsLock.Lock()
+	w.xAxis = &xAxisRange{min: min, max: max}
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.debugLog("LineChartSkn::SetXAxisRange() EXIT")
+	return nil
+}
+
+// GetXAxisRange returns the numeric x axis range, false when points are plotted at their index
+func (w *LineChartSkn) GetXAxisRange() (float32, float32, bool) {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	if w.xAxis == nil {
+		return 0, 0, false
+	}
+	return w.xAxis.min, w.xAxis.max, true
+}
+
+// ClearXAxisRange returns the x axis to datapoint indexes
+func (w *LineChartSkn) ClearXAxisRange() {
+	w.debugLog("LineChartSkn::ClearXAxisRange()")
+	w.mapsLock.Lock()
+	w.xAxis = nil
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// pointX returns where the datapoint lies on the x axis in index units: its scaled x value on a
+// numeric axis, its timestamp when spaced by time, otherwise its index
+func (w *LineChartSkn) pointX(index int, point ChartDatapoint) float32 {
+	if x, ok := point.XValue(); ok && w.xAxis != nil {
+		return w.xAxisIndex(x)
+	}
+	if w.timeSpan != nil {
+		if ts, ok := point.Time(); ok {
+			return w.timeX(ts)
+		}
+	}
+	return float32(index)
+}
+
+// xAxisIndex converts a numeric x axis value to its position on the x axis in index units
+func (w *LineChartSkn) xAxisIndex(x float32) float32 {
+	return (x - w.xAxis.min) / (w.xAxis.max - w.xAxis.min) * float32(w.dataPointXLimit-1)
+}
+
+// xAxisValue converts a position on the x axis in index units to the numeric axis value
+func (w *LineChartSkn) xAxisValue(index float32) float32 {
+	return w.xAxis.min + index/float32(w.dataPointXLimit-1)*(w.xAxis.max-w.xAxis.min)
+}
+
+// pointXText describes where the datapoint lies on the x axis for popups and readouts,
+// its x value on a numeric axis otherwise the given index label
+func (w *LineChartSkn) pointXText(indexLabel int, point ChartDatapoint) string {
+	if x, ok := point.XValue(); ok && w.xAxis != nil {
+		return fmt.Sprint(w.Message(MessageX), ": ", formatXValue(x))
+	}
+	return fmt.Sprint(w.Message(MessageIndex), ": ", indexLabel)
+}
+
+// formatXValue shows an x value in its shortest form
+func formatXValue(x float32) string {
+	return strconv.FormatFloat(float64(x), 'g', 6, 32)
+}
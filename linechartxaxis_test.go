@@ -0,0 +1,115 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Numeric x axis", func() {
+	var (
+		lc     sknlinechart.LineChart
+		win    fyne.Window
+		torque []sknlinechart.ChartDatapoint
+	)
+
+	// bandSpan returns the left and right edges of the confidence bands as painted
+	bandSpan := func() (left, right float32) {
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			raster, ok := o.(*canvas.Raster)
+			if !ok || !raster.Visible() {
+				continue
+			}
+			img := raster.Generator(int(raster.Size().Width), int(raster.Size().Height))
+			bounds := img.Bounds()
+			first, last := -1, -1
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+					if _, _, _, a := img.At(x, y).RGBA(); a > 0 {
+						if first < 0 {
+							first = x
+						}
+						last = x
+						break
+					}
+				}
+			}
+			if first >= 0 {
+				return raster.Position().X + float32(first), raster.Position().X + float32(last)
+			}
+		}
+		return 0, 0
+	}
+
+	BeforeEach(func() {
+		torque = nil
+		for _, rpm := range []float32{1000, 1500, 2000, 4000, 5500} {
+			torque = append(torque, sknlinechart.NewXYDatapoint(rpm, rpm/100, theme.ColorOrange, time.Now().Format(time.RFC1123)))
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithXAxisRange(0, 6000),
+		))
+		lc.ApplyDataPoints("Torque", torque)
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should carry an optional x value on a datapoint", func() {
+		point := sknlinechart.NewChartDatapoint(50, theme.ColorYellow, time.Now().Format(time.RFC1123))
+		_, ok := point.XValue()
+		Expect(ok).To(BeFalse())
+
+		x, ok := torque[1].XValue()
+		Expect(ok).To(BeTrue())
+		Expect(x).To(BeNumerically("==", 1500))
+		copied, _ := torque[1].Copy().XValue()
+		Expect(copied).To(BeNumerically("==", 1500))
+
+		torque[1].ClearXValue()
+		_, ok = torque[1].XValue()
+		Expect(ok).To(BeFalse())
+	})
+	It("should space points by their x values rather than their indexes", func() {
+		first, second, third, fourth := markerX(torque[0]), markerX(torque[1]), markerX(torque[2]), markerX(torque[3])
+		Expect(second - first).To(BeNumerically(">", 0))
+		Expect(second - first).To(BeNumerically("~", third-second, 1))
+		Expect(fourth - third).To(BeNumerically("~", 4*(second-first), 2))
+		Expect(visibleText(lc, "6000")).NotTo(BeNil())
+	})
+	It("should plot by index again once the range is cleared", func() {
+		Expect(lc.SetXAxisRange(10, 10)).To(HaveOccurred())
+		min, max, ok := lc.GetXAxisRange()
+		Expect(ok).To(BeTrue())
+		Expect([]float32{min, max}).To(Equal([]float32{0, 6000}))
+
+		lc.ClearXAxisRange()
+		_, _, ok = lc.GetXAxisRange()
+		Expect(ok).To(BeFalse())
+		first, second, third, fourth := markerX(torque[0]), markerX(torque[1]), markerX(torque[2]), markerX(torque[3])
+		Expect(second - first).To(BeNumerically(">", 0))
+		Expect(second - first).To(BeNumerically("~", fourth-third, 1))
+		Expect(visibleText(lc, "6000")).To(BeNil())
+	})
+	It("should place confidence bands with their points' x values", func() {
+		var bounded []sknlinechart.ChartDatapoint
+		for _, rpm := range []float32{3000, 3500, 4500} {
+			point := sknlinechart.NewChartDatapointWithBounds(30, 25, 35, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			point.SetXValue(rpm)
+			bounded = append(bounded, point)
+		}
+		lc.ApplyDataPoints("Range", bounded)
+		lc.Refresh() // rather than when the refresh interval ends
+		left, right := bandSpan()
+		Expect(left).To(BeNumerically("~", markerX(bounded[0]), 2))
+		Expect(right).To(BeNumerically("~", markerX(bounded[2]), 2))
+	})
+})
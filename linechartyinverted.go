@@ -0,0 +1,37 @@
+package sknlinechart
+
+// IsYInverted returns true when values increase downward, the y axis labeled from its minimum at the top
+func (w *LineChartSkn) IsYInverted() bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.yInverted
+}
+
+// SetYInverted plots increasing values downward, as instrumentation conventions for depth or rank
+// expect; the y labels are reversed to match and dragging a zoomed chart follows the flipped axis
+func (w *LineChartSkn) SetYInverted(inverted bool) {
+	w.debugLog("LineChartSkn::SetYInverted()")
+	w.mapsLock.Lock()
+	w.yInverted = inverted
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// yFraction returns how far down from the top of the plot area a fraction of the viewport's Y range
+// measured from its minimum is drawn
+func (w *LineChartSkn) yFraction(fraction float32) float32 {
+	if w.yInverted {
+		return fraction
+	}
+	return 1 - fraction
+}
+
+// yLabelStep returns how many label steps above the viewport's minimum the y label at idx,
+// counted from the top, shows
+func (r *lineChartRenderer) yLabelStep(idx int) int {
+	if r.widget.yInverted {
+		return idx
+	}
+	return YPointLimit - idx
+}
@@ -0,0 +1,57 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Inverted y axis", func() {
+	var (
+		lc     sknlinechart.LineChart
+		win    fyne.Window
+		depths []sknlinechart.ChartDatapoint
+	)
+
+	// markerY returns the vertical center of the point's marker
+	markerY := func(point sknlinechart.ChartDatapoint) float32 {
+		top, bottom := point.MarkerPosition()
+		return (top.Y + bottom.Y) / 2
+	}
+
+	BeforeEach(func() {
+		depths = nil
+		for _, value := range []float32{10, 60, 110} {
+			depths = append(depths, sknlinechart.NewChartDatapoint(value, theme.ColorOrange, time.Now().Format(time.RFC1123)))
+		}
+		lc, _ = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithYInverted(true),
+		))
+		lc.ApplyDataPoints("Depth", depths)
+		win = test.NewWindow(lc)
+		win.Resize(fyne.NewSize(800, 400))
+	})
+	AfterEach(func() {
+		win.Close()
+	})
+
+	It("should plot increasing values downward with the labels reversed", func() {
+		Expect(lc.IsYInverted()).To(BeTrue())
+		Expect(markerY(depths[0])).To(BeNumerically(">", 0))
+		Expect(markerY(depths[0])).To(BeNumerically("<", markerY(depths[1])))
+		Expect(markerY(depths[1])).To(BeNumerically("<", markerY(depths[2])))
+		Expect(visibleText(lc, "0").Position().Y).To(BeNumerically("<", visibleText(lc, "130").Position().Y))
+	})
+	It("should plot increasing values upward once restored", func() {
+		lc.SetYInverted(false)
+		Expect(lc.IsYInverted()).To(BeFalse())
+		Expect(markerY(depths[0])).To(BeNumerically(">", markerY(depths[1])))
+		Expect(markerY(depths[1])).To(BeNumerically(">", markerY(depths[2])))
+		Expect(visibleText(lc, "0").Position().Y).To(BeNumerically(">", visibleText(lc, "130").Position().Y))
+	})
+})
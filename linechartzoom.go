@@ -0,0 +1,214 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+)
+
+// ChartViewport describes the visible data region of the chart.
+// XMin/XMax are datapoint indexes, YMin/YMax are datapoint values
+type ChartViewport struct {
+	XMin float32 `json:"xMin"`
+	XMax float32 `json:"xMax"`
+	YMin float32 `json:"yMin"`
+	YMax float32 `json:"yMax"`
+}
+
+// minimum pixel size of a selection rectangle before it is treated as a zoom request
+const zoomSelectionMinPixels = 4
+
+// IsZoomSelectionEnabled returns state of rubber-band zoom selection with the primary mouse button
+func (w *LineChartSkn) IsZoomSelectionEnabled() bool {
+	return w.enableZoomSelection
+}
+
+// SetZoomSelection enables dragging a selection rectangle with the primary mouse button to zoom into
+func (w *LineChartSkn) SetZoomSelection(enable bool) {
+	w.enableZoomSelection = enable
+	if !enable {
+		w.selectionActive = false
+	}
+}
+
+// IsZoomed returns true when the chart is not showing its full data extent
+func (w *LineChartSkn) IsZoomed() bool {
+	return w.viewport != nil
+}
+
+// GetViewport returns the visible data region, the full extent when not zoomed
+func (w *LineChartSkn) GetViewport() ChartViewport {
+	return w.currentViewport()
+}
+
+// SetViewport zooms the chart to the given data region
+func (w *LineChartSkn) SetViewport(vp ChartViewport) error {
+	w.debugLog("LineChartSkn::SetViewport() ENTER")
+	if vp.XMax <= vp.XMin || vp.YMax <= vp.YMin {
+		w.debugLog("LineChartSkn::SetViewport() ERROR EXIT")
+		return fmt.Errorf("SetViewport() invalid region. x:%v-%v, y:%v-%v", vp.XMin, vp.XMax, vp.YMin, vp.YMax)
+	}
+	w.mapsLock.Lock()
+	w.viewport = &vp
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.broadcastViewport(&vp)
+	w.debugLog("LineChartSkn::SetViewport() EXIT")
+	return nil
+}
+
+// ResetZoom returns the chart to its full data extent
+func (w *LineChartSkn) ResetZoom() {
+	w.debugLog("LineChartSkn::ResetZoom()")
+	w.mapsLock.Lock()
+	w.viewport = nil
+	w.relayoutRequired = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	w.broadcastViewport(nil)
+}
+
+// MouseDown pins the tooltip of the datapoint under a shift-click, otherwise starts a
+// zoom selection when enabled and the primary button is pressed inside the plot area
+func (w *LineChartSkn) MouseDown(me *desktop.MouseEvent) {
+	w.debugLog("LineChartSkn::MouseDown() ENTER")
+	w.localizeEvent(&me.PointEvent)
+	if me.Button == desktop.MouseButtonPrimary && me.Modifier&pinTooltipModifier != 0 && w.pinTooltipAt(me.Position) {
+		w.suppressTap = true
+		w.debugLog("LineChartSkn::MouseDown(pinned tooltip) EXIT")
+		return
+	}
+	if me.Button == desktop.MouseButtonPrimary && w.beginAnnotationDrag(me.Position) {
+		w.debugLog("LineChartSkn::MouseDown(annotation) EXIT")
+		return
+	}
+	if !w.enableZoomSelection || me.Button != desktop.MouseButtonPrimary || !w.isInsidePlotArea(me.Position) {
+		w.debugLog("LineChartSkn::MouseDown(ignored) EXIT")
+		return
+	}
+	w.selectionActive = true
+	w.selectionStart = me.Position
+	w.selectionEnd = me.Position
+	w.debugLog("LineChartSkn::MouseDown() EXIT")
+}
+
+// MouseUp completes a zoom selection, applying the selected region as the new viewport,
+// and performs the tertiary button action
+func (w *LineChartSkn) MouseUp(me *desktop.MouseEvent) {
+	startTime := time.Now()
+	w.debugLog("LineChartSkn::MouseUp() ENTER")
+	w.localizeEvent(&me.PointEvent)
+	if me.Button == desktop.MouseButtonTertiary { // no tap event exists for the middle button
+		w.performMouseAction(me.Button, me.Position)
+		w.debugLog("LineChartSkn::MouseUp(tertiary) EXIT")
+		return
+	}
+	if me.Button == desktop.MouseButtonPrimary {
+		w.draggedAnnotation = -1
+	}
+	if !w.selectionActive {
+		w.debugLog("LineChartSkn::MouseUp(ignored) EXIT")
+		return
+	}
+	w.selectionActive = false
+	w.selectionEnd = w.clampToPlotArea(me.Position)
+
+	start := w.clampToPlotArea(w.selectionStart)
+	end := w.selectionEnd
+	if math.Abs(float64(end.X-start.X)) < zoomSelectionMinPixels || math.Abs(float64(end.Y-start.Y)) < zoomSelectionMinPixels {
+		w.Refresh()
+		w.debugLog("LineChartSkn::MouseUp(too small) EXIT")
+		return
+	}
+
+	x1, y1 := w.positionToData(start)
+	x2, y2 := w.positionToData(end)
+	vp := ChartViewport{
+		XMin: float32(math.Min(float64(x1), float64(x2))),
+		XMax: float32(math.Max(float64(x1), float64(x2))),
+		YMin: float32(math.Min(float64(y1), float64(y2))),
+		YMax: float32(math.Max(float64(y1), float64(y2))),
+	}
+	w.suppressTap = true
+	_ = w.SetViewport(vp)
+	w.debugLog("LineChartSkn::MouseUp() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
+}
+
+// currentViewport returns the zoomed region or the full data extent
+func (w *LineChartSkn) currentViewport() ChartViewport {
+	if w.viewport != nil {
+		return *w.viewport
+	}
+	return ChartViewport{
+		XMin: 0,
+		XMax: float32(w.dataPointXLimit - 1),
+		YMin: 0,
+		YMax: w.dataPointYLimit,
+	}
+}
+
+// dataToPosition converts a datapoint index and value into a widget position inside the plot area
+// values outside the viewport's Y range are clamped to the plot edges
+func (w *LineChartSkn) dataToPosition(index, value float32) fyne.Position {
+	vp := w.currentViewport()
+	if value > vp.YMax {
+		value = vp.YMax
+	} else if value < vp.YMin {
+		value = vp.YMin
+	}
+	width := w.plotMax.X - w.plotMin.X
+	height := w.plotMax.Y - w.plotMin.Y
+	return fyne.NewPos(
+		w.plotMin.X+((index-vp.XMin)/(vp.XMax-vp.XMin))*width,
+		w.plotMin.Y+w.yFraction((value-vp.YMin)/(vp.YMax-vp.YMin))*height,
+	)
+}
+
+// positionToData converts a widget position into a datapoint index and value
+func (w *LineChartSkn) positionToData(pos fyne.Position) (float32, float32) {
+	vp := w.currentViewport()
+	width := w.plotMax.X - w.plotMin.X
+	height := w.plotMax.Y - w.plotMin.Y
+	if width <= 0 || height <= 0 {
+		return vp.XMin, vp.YMin
+	}
+	index := vp.XMin + ((pos.X-w.plotMin.X)/width)*(vp.XMax-vp.XMin)
+	value := vp.YMin + w.yFraction((pos.Y-w.plotMin.Y)/height)*(vp.YMax-vp.YMin)
+	return index, value
+}
+
+// isIndexVisible returns true when a datapoint index lies within the viewport
+func (w *LineChartSkn) isIndexVisible(index int) bool {
+	return w.isXVisible(float32(index))
+}
+
+// isXVisible returns true when a position on the x axis, in index units, lies within the viewport
+func (w *LineChartSkn) isXVisible(x float32) bool {
+	vp := w.currentViewport()
+	return x >= vp.XMin && x <= vp.XMax
+}
+
+// isInsidePlotArea returns true when the position lies within the grid area
+func (w *LineChartSkn) isInsidePlotArea(pos fyne.Position) bool {
+	return pos.X >= w.plotMin.X && pos.X <= w.plotMax.X &&
+		pos.Y >= w.plotMin.Y && pos.Y <= w.plotMax.Y
+}
+
+// clampToPlotArea limits the position to the grid area
+func (w *LineChartSkn) clampToPlotArea(pos fyne.Position) fyne.Position {
+	if pos.X < w.plotMin.X {
+		pos.X = w.plotMin.X
+	} else if pos.X > w.plotMax.X {
+		pos.X = w.plotMax.X
+	}
+	if pos.Y < w.plotMin.Y {
+		pos.Y = w.plotMin.Y
+	} else if pos.Y > w.plotMax.Y {
+		pos.Y = w.plotMax.Y
+	}
+	return pos
+}
@@ -0,0 +1,105 @@
+package sknlinechart_test
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Chart zoom viewport", func() {
+	var lc sknlinechart.LineChart
+
+	BeforeEach(func() {
+		lc, _ = makeUI("Testing", "Zoom", 100)
+		lc.Resize(fyne.NewSize(800, 400))
+	})
+
+	It("should report the full extent when not zoomed", func() {
+		vp := lc.GetViewport()
+		Expect(lc.IsZoomed()).To(BeFalse())
+		Expect(vp.XMin).To(BeNumerically("==", 0))
+		Expect(vp.XMax).To(BeNumerically("==", 149))
+		Expect(vp.YMax).To(BeNumerically("==", 130))
+	})
+	It("should accept a valid viewport and reset it", func() {
+		err := lc.SetViewport(sknlinechart.ChartViewport{XMin: 10, XMax: 40, YMin: 20, YMax: 60})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lc.IsZoomed()).To(BeTrue())
+		Expect(lc.GetViewport().XMax).To(BeNumerically("==", 40))
+
+		lc.ResetZoom()
+		Expect(lc.IsZoomed()).To(BeFalse())
+	})
+	It("should reject an inverted viewport", func() {
+		err := lc.SetViewport(sknlinechart.ChartViewport{XMin: 40, XMax: 10, YMin: 20, YMax: 60})
+		Expect(err).To(HaveOccurred())
+		Expect(lc.IsZoomed()).To(BeFalse())
+	})
+	It("should zoom to a rubber-band selection made with the primary button", func() {
+		skn := lc.(*sknlinechart.LineChartSkn)
+		lc.SetZoomSelection(true)
+		Expect(lc.IsZoomSelectionEnabled()).To(BeTrue())
+
+		down := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary}
+		down.Position = fyne.NewPos(100, 100)
+		skn.MouseDown(down)
+
+		move := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary}
+		move.Position = fyne.NewPos(300, 200)
+		skn.MouseMoved(move)
+		skn.MouseUp(move)
+
+		Expect(lc.IsZoomed()).To(BeTrue())
+		vp := lc.GetViewport()
+		Expect(vp.XMin).To(BeNumerically("<", vp.XMax))
+		Expect(vp.YMin).To(BeNumerically("<", vp.YMax))
+	})
+	It("should ignore selections when zoom selection is disabled", func() {
+		skn := lc.(*sknlinechart.LineChartSkn)
+		down := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary}
+		down.Position = fyne.NewPos(100, 100)
+		skn.MouseDown(down)
+		up := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary}
+		up.Position = fyne.NewPos(300, 200)
+		skn.MouseUp(up)
+
+		Expect(lc.IsZoomed()).To(BeFalse())
+	})
+})
+
+var _ = Describe("Chart crosshair", func() {
+	It("should track the mouse with index and value readouts", func() {
+		lc, _ := makeUI("Testing", "Crosshair", 100)
+		lc.Resize(fyne.NewSize(800, 400))
+		Expect(lc.IsCrosshairEnabled()).To(BeFalse())
+		lc.SetCrosshairEnabled(true)
+		Expect(lc.IsCrosshairEnabled()).To(BeTrue())
+
+		me := &desktop.MouseEvent{}
+		me.Position = fyne.NewPos(200, 150)
+		lc.(*sknlinechart.LineChartSkn).MouseMoved(me)
+
+		var readouts []string
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if t, ok := o.(*canvas.Text); ok && t.Visible() && (strings.HasPrefix(t.Text, "Index:") || strings.HasPrefix(t.Text, "Value:")) {
+				readouts = append(readouts, t.Text)
+			}
+		}
+		Expect(readouts).To(HaveLen(2))
+
+		lc.(*sknlinechart.LineChartSkn).MouseOut()
+		readouts = readouts[:0]
+		for _, o := range test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn)).Objects() {
+			if t, ok := o.(*canvas.Text); ok && t.Visible() && strings.HasPrefix(t.Text, "Value:") {
+				readouts = append(readouts, t.Text)
+			}
+		}
+		Expect(readouts).To(BeEmpty())
+	})
+})
@@ -0,0 +1,265 @@
+package sknlinechart
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PayloadDecoder converts a device payload, as received from MQTT or HTTP, into a datapoint value
+type PayloadDecoder interface {
+	Decode(payload []byte) (float64, error)
+}
+
+// PayloadDecoderFunc adapts a plain function into a PayloadDecoder
+type PayloadDecoderFunc func(payload []byte) (float64, error)
+
+// Decode calls the wrapped function
+func (f PayloadDecoderFunc) Decode(payload []byte) (float64, error) {
+	return f(payload)
+}
+
+// NewRawFloatDecoder decodes payloads holding a plain text number, like "21.5"
+func NewRawFloatDecoder() PayloadDecoder {
+	return PayloadDecoderFunc(func(payload []byte) (float64, error) {
+		return strconv.ParseFloat(strings.TrimSpace(string(payload)), 64)
+	})
+}
+
+// NewJSONPathDecoder decodes the number found at a dotted path within a json document,
+// array elements are addressed by index. Ex: "sensors.0.temperature"
+func NewJSONPathDecoder(path string) PayloadDecoder {
+	var keys []string
+	if path != "" {
+		keys = strings.Split(path, ".")
+	}
+	return PayloadDecoderFunc(func(payload []byte) (float64, error) {
+		var doc interface{}
+		err := json.Unmarshal(payload, &doc)
+		if err != nil {
+			return 0, err
+		}
+		for _, key := range keys {
+			switch node := doc.(type) {
+			case map[string]interface{}:
+				value, ok := node[key]
+				if !ok {
+					return 0, fmt.Errorf("json path %q: key %q not found", path, key)
+				}
+				doc = value
+			case []interface{}:
+				idx, err := strconv.Atoi(key)
+				if err != nil || idx < 0 || idx >= len(node) {
+					return 0, fmt.Errorf("json path %q: invalid index %q", path, key)
+				}
+				doc = node[idx]
+			default:
+				return 0, fmt.Errorf("json path %q: cannot descend into %q", path, key)
+			}
+		}
+		return jsonNumber(doc)
+	})
+}
+
+// NewSenMLDecoder decodes the value of the named record from a SenML json pack (RFC 8428).
+// The record name is the base name plus name; an empty name selects the first record with a value
+func NewSenMLDecoder(name string) PayloadDecoder {
+	return PayloadDecoderFunc(func(payload []byte) (float64, error) {
+		var pack []struct {
+			BaseName  string   `json:"bn"`
+			BaseValue float64  `json:"bv"`
+			Name      string   `json:"n"`
+			Value     *float64 `json:"v"`
+			BoolValue *bool    `json:"vb"`
+		}
+		err := json.Unmarshal(payload, &pack)
+		if err != nil {
+			return 0, err
+		}
+		var baseName string
+		var baseValue float64
+		for _, rec := range pack {
+			if rec.BaseName != "" {
+				baseName = rec.BaseName
+			}
+			if rec.BaseValue != 0 {
+				baseValue = rec.BaseValue
+			}
+			if name != "" && baseName+rec.Name != name {
+				continue
+			}
+			if rec.Value != nil {
+				return baseValue + *rec.Value, nil
+			}
+			if rec.BoolValue != nil {
+				if *rec.BoolValue {
+					return 1, nil
+				}
+				return 0, nil
+			}
+		}
+		return 0, fmt.Errorf("senml record %q not found", name)
+	})
+}
+
+// NewCBORDecoder decodes a payload holding a single CBOR (RFC 8949) encoded number
+func NewCBORDecoder() PayloadDecoder {
+	return PayloadDecoderFunc(decodeCBORNumber)
+}
+
+// jsonNumber converts a decoded json leaf into a float
+func jsonNumber(doc interface{}) (float64, error) {
+	switch value := doc.(type) {
+	case float64:
+		return value, nil
+	case bool:
+		if value {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(value), 64)
+	}
+	return 0, fmt.Errorf("json value is not numeric: %v", doc)
+}
+
+// decodeCBORNumber handles unsigned/negative integers and half, single, double floats
+func decodeCBORNumber(payload []byte) (float64, error) {
+	if len(payload) == 0 {
+		return 0, errors.New("cbor payload is empty")
+	}
+	major := payload[0] >> 5
+	info := payload[0] & 0x1f
+	body := payload[1:]
+
+	switch major {
+	case 0, 1: // unsigned, negative integer
+		var n uint64
+		switch {
+		case info < 24:
+			n = uint64(info)
+		case info == 24 && len(body) >= 1:
+			n = uint64(body[0])
+		case info == 25 && len(body) >= 2:
+			n = uint64(binary.BigEndian.Uint16(body))
+		case info == 26 && len(body) >= 4:
+			n = uint64(binary.BigEndian.Uint32(body))
+		case info == 27 && len(body) >= 8:
+			n = binary.BigEndian.Uint64(body)
+		default:
+			return 0, errors.New("cbor integer is truncated or malformed")
+		}
+		if major == 1 {
+			return -1 - float64(n), nil
+		}
+		return float64(n), nil
+	case 7: // floats and simple values
+		switch {
+		case info == 20:
+			return 0, nil
+		case info == 21:
+			return 1, nil
+		case info == 25 && len(body) >= 2:
+			return halfToFloat(binary.BigEndian.Uint16(body)), nil
+		case info == 26 && len(body) >= 4:
+			return float64(math.Float32frombits(binary.BigEndian.Uint32(body))), nil
+		case info == 27 && len(body) >= 8:
+			return math.Float64frombits(binary.BigEndian.Uint64(body)), nil
+		}
+	}
+	return 0, fmt.Errorf("cbor item is not a number, major type: %d", major)
+}
+
+// halfToFloat converts an IEEE 754 half precision value
+func halfToFloat(h uint16) float64 {
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+	var val float64
+	switch exp {
+	case 0:
+		val = math.Ldexp(mant, -24)
+	case 31:
+		if mant == 0 {
+			val = math.Inf(1)
+		} else {
+			val = math.NaN()
+		}
+	default:
+		val = math.Ldexp(mant+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		return -val
+	}
+	return val
+}
+
+// payloadRoute binds a topic to the series and decoder used for its payloads
+type payloadRoute struct {
+	series    string
+	colorName string
+	decoder   PayloadDecoder
+}
+
+// PayloadDecoderRegistry maps MQTT topics or HTTP paths to the series and decoder for their payloads,
+// so subscriber callbacks can hand raw payloads straight to a chart
+type PayloadDecoderRegistry struct {
+	routes map[string]payloadRoute
+	lock   sync.RWMutex
+}
+
+// NewPayloadDecoderRegistry returns an empty registry
+func NewPayloadDecoderRegistry() *PayloadDecoderRegistry {
+	return &PayloadDecoderRegistry{
+		routes: map[string]payloadRoute{},
+	}
+}
+
+// Register routes payloads of topic to series, drawn in the themed colorName, using decoder
+func (r *PayloadDecoderRegistry) Register(topic, series, colorName string, decoder PayloadDecoder) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.routes[topic] = payloadRoute{series: series, colorName: colorName, decoder: decoder}
+}
+
+// Unregister removes the route of topic
+func (r *PayloadDecoderRegistry) Unregister(topic string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.routes, topic)
+}
+
+// Decode returns the series name and value decoded from the payload of topic
+func (r *PayloadDecoderRegistry) Decode(topic string, payload []byte) (string, float64, error) {
+	r.lock.RLock()
+	route, ok := r.routes[topic]
+	r.lock.RUnlock()
+	if !ok {
+		return "", 0, fmt.Errorf("no decoder registered for topic: %s", topic)
+	}
+	value, err := route.decoder.Decode(payload)
+	if err != nil {
+		return route.series, 0, fmt.Errorf("topic %s: %w", topic, err)
+	}
+	return route.series, value, nil
+}
+
+// Apply decodes the payload of topic and adds it as a new datapoint to the routed series of chart
+func (r *PayloadDecoderRegistry) Apply(chart LineChart, topic string, payload []byte) error {
+	series, value, err := r.Decode(topic, payload)
+	if err != nil {
+		return err
+	}
+	r.lock.RLock()
+	colorName := r.routes[topic].colorName
+	r.lock.RUnlock()
+
+	point := NewChartDatapointAt(float32(value), colorName, time.Now())
+	chart.ApplyDataPoint(series, &point)
+	return nil
+}
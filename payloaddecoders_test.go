@@ -0,0 +1,78 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("IoT payload decoders", func() {
+
+	It("should decode raw text numbers", func() {
+		v, err := sknlinechart.NewRawFloatDecoder().Decode([]byte(" 21.5\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(BeNumerically("==", 21.5))
+
+		_, err = sknlinechart.NewRawFloatDecoder().Decode([]byte("warm"))
+		Expect(err).To(HaveOccurred())
+	})
+	It("should decode numbers at a json path", func() {
+		payload := []byte(`{"sensors":[{"temp":18.25},{"temp":"19.5"}],"ok":true}`)
+		v, err := sknlinechart.NewJSONPathDecoder("sensors.1.temp").Decode(payload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(BeNumerically("==", 19.5))
+
+		v, err = sknlinechart.NewJSONPathDecoder("ok").Decode(payload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(BeNumerically("==", 1))
+
+		_, err = sknlinechart.NewJSONPathDecoder("sensors.4.temp").Decode(payload)
+		Expect(err).To(HaveOccurred())
+	})
+	It("should decode named senml records", func() {
+		payload := []byte(`[{"bn":"urn:dev:ow:10e2073a01080063:","n":"voltage","u":"V","v":120.1},{"n":"current","u":"A","v":1.2}]`)
+		v, err := sknlinechart.NewSenMLDecoder("urn:dev:ow:10e2073a01080063:current").Decode(payload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(BeNumerically("~", 1.2, 0.0001))
+
+		v, err = sknlinechart.NewSenMLDecoder("").Decode(payload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(BeNumerically("~", 120.1, 0.0001))
+
+		_, err = sknlinechart.NewSenMLDecoder("missing").Decode(payload)
+		Expect(err).To(HaveOccurred())
+	})
+	It("should decode cbor numbers", func() {
+		decoder := sknlinechart.NewCBORDecoder()
+		cases := map[string][]byte{
+			"10":     {0x0a},
+			"500":    {0x19, 0x01, 0xf4},
+			"-10":    {0x29},
+			"1.5":    {0xf9, 0x3e, 0x00},
+			"100000": {0xfa, 0x47, 0xc3, 0x50, 0x00},
+			"1.1":    {0xfb, 0x3f, 0xf1, 0x99, 0x99, 0x99, 0x99, 0x99, 0x9a},
+		}
+		expected := map[string]float64{"10": 10, "500": 500, "-10": -10, "1.5": 1.5, "100000": 100000, "1.1": 1.1}
+		for key, payload := range cases {
+			v, err := decoder.Decode(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v).To(BeNumerically("~", expected[key], 0.0001), key)
+		}
+		_, err := decoder.Decode([]byte{0x63, 0x61, 0x62, 0x63}) // text "abc"
+		Expect(err).To(HaveOccurred())
+	})
+	It("should route topic payloads into chart series", func() {
+		lc, _ := makeUI("Testing", "Decoders", 0)
+		registry := sknlinechart.NewPayloadDecoderRegistry()
+		registry.Register("home/livingroom/temp", "Temperature", theme.ColorRed, sknlinechart.NewRawFloatDecoder())
+
+		series, value, err := registry.Decode("home/livingroom/temp", []byte("22.5"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(series).To(Equal("Temperature"))
+		Expect(value).To(BeNumerically("==", 22.5))
+
+		Expect(registry.Apply(lc, "home/livingroom/temp", []byte("23"))).To(Succeed())
+		Expect(registry.Apply(lc, "home/kitchen/temp", []byte("23"))).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,342 @@
+package sknlinechart
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+// ScenarioStepKind identifies the behavior of one scenario step
+type ScenarioStepKind int
+
+const (
+	ScenarioSteady  ScenarioStepKind = iota // hold Value for Duration
+	ScenarioRamp                            // move linearly from Value to Target over Duration
+	ScenarioSpike                           // a single sample at Target
+	ScenarioNoise                           // Value +/- Amplitude for Duration, from the scenario seed
+	ScenarioDropout                         // no samples for Duration
+)
+
+// ScenarioStep one segment of a simulated signal
+type ScenarioStep struct {
+	Kind      ScenarioStepKind
+	Duration  time.Duration
+	Value     float32
+	Target    float32
+	Amplitude float32
+}
+
+// Scenario scripted signal played by a SimulatedSource
+type Scenario struct {
+	Series    string
+	ColorName string
+	Interval  time.Duration // time between samples
+	Seed      int64         // noise seed, same seed same values
+	Loop      bool
+	Steps     []ScenarioStep
+}
+
+// ScenarioSample one generated value, Offset is the time since the scenario started.
+// AfterDropout marks the first sample following a dropout, where the source reconnected
+type ScenarioSample struct {
+	Offset       time.Duration
+	Value        float32
+	AfterDropout bool
+}
+
+// ParseScenario builds a Scenario from a small line oriented script, one statement per line:
+//
+//	series Temperature red
+//	interval 1s
+//	seed 42
+//	steady 20 for 30s
+//	spike 80
+//	ramp 20 to 60 over 10s
+//	noise 40 2.5 for 20s
+//	dropout 10s
+//	loop
+//
+// Blank lines and lines starting with # are ignored
+func ParseScenario(script string) (*Scenario, error) {
+	sc := &Scenario{
+		Series:    "Simulated",
+		ColorName: theme.ColorBlue,
+		Interval:  time.Second,
+	}
+	scanner := bufio.NewScanner(strings.NewReader(script))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		err := sc.parseStatement(fields)
+		if err != nil {
+			return nil, fmt.Errorf("scenario line %d: %w", lineNo, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+// parseStatement applies one script statement to the scenario
+func (sc *Scenario) parseStatement(fields []string) error {
+	var err error
+	args := fields[1:]
+	switch fields[0] {
+	case "series":
+		if len(args) < 1 || len(args) > 2 {
+			return fmt.Errorf("usage: series <name> [color]")
+		}
+		sc.Series = args[0]
+		if len(args) == 2 {
+			sc.ColorName = args[1]
+		}
+	case "interval":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: interval <duration>")
+		}
+		sc.Interval, err = time.ParseDuration(args[0])
+		if err == nil && sc.Interval <= 0 {
+			err = fmt.Errorf("interval must be positive")
+		}
+	case "seed":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: seed <number>")
+		}
+		sc.Seed, err = strconv.ParseInt(args[0], 10, 64)
+	case "loop":
+		sc.Loop = true
+	case "steady":
+		if len(args) != 3 || args[1] != "for" {
+			return fmt.Errorf("usage: steady <value> for <duration>")
+		}
+		step := ScenarioStep{Kind: ScenarioSteady}
+		step.Value, err = parseFloat32(args[0])
+		if err == nil {
+			step.Duration, err = time.ParseDuration(args[2])
+		}
+		sc.Steps = append(sc.Steps, step)
+	case "spike":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: spike <value>")
+		}
+		step := ScenarioStep{Kind: ScenarioSpike}
+		step.Target, err = parseFloat32(args[0])
+		sc.Steps = append(sc.Steps, step)
+	case "ramp":
+		if len(args) != 5 || args[1] != "to" || args[3] != "over" {
+			return fmt.Errorf("usage: ramp <from> to <to> over <duration>")
+		}
+		step := ScenarioStep{Kind: ScenarioRamp}
+		step.Value, err = parseFloat32(args[0])
+		if err == nil {
+			step.Target, err = parseFloat32(args[2])
+		}
+		if err == nil {
+			step.Duration, err = time.ParseDuration(args[4])
+		}
+		sc.Steps = append(sc.Steps, step)
+	case "noise":
+		if len(args) != 4 || args[2] != "for" {
+			return fmt.Errorf("usage: noise <value> <amplitude> for <duration>")
+		}
+		step := ScenarioStep{Kind: ScenarioNoise}
+		step.Value, err = parseFloat32(args[0])
+		if err == nil {
+			step.Amplitude, err = parseFloat32(args[1])
+		}
+		if err == nil {
+			step.Duration, err = time.ParseDuration(args[3])
+		}
+		sc.Steps = append(sc.Steps, step)
+	case "dropout":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: dropout <duration>")
+		}
+		step := ScenarioStep{Kind: ScenarioDropout}
+		step.Duration, err = time.ParseDuration(args[0])
+		sc.Steps = append(sc.Steps, step)
+	default:
+		return fmt.Errorf("unknown statement: %s", fields[0])
+	}
+	return err
+}
+
+// Samples generates one pass through the scenario, identical on every call
+func (sc *Scenario) Samples() []ScenarioSample {
+	var samples []ScenarioSample
+	if sc.Interval <= 0 {
+		return samples
+	}
+	random := rand.New(rand.NewSource(sc.Seed))
+	var offset time.Duration
+	dropped := false
+
+	for _, step := range sc.Steps {
+		first := len(samples)
+		count := int(step.Duration / sc.Interval)
+		switch step.Kind {
+		case ScenarioSteady:
+			for i := 0; i < count; i++ {
+				samples = append(samples, ScenarioSample{Offset: offset, Value: step.Value})
+				offset += sc.Interval
+			}
+		case ScenarioRamp:
+			for i := 0; i < count; i++ {
+				fraction := float32(1)
+				if count > 1 {
+					fraction = float32(i) / float32(count-1)
+				}
+				samples = append(samples, ScenarioSample{Offset: offset, Value: step.Value + (step.Target-step.Value)*fraction})
+				offset += sc.Interval
+			}
+		case ScenarioSpike:
+			samples = append(samples, ScenarioSample{Offset: offset, Value: step.Target})
+			offset += sc.Interval
+		case ScenarioNoise:
+			for i := 0; i < count; i++ {
+				value := step.Value + step.Amplitude*(2*random.Float32()-1)
+				samples = append(samples, ScenarioSample{Offset: offset, Value: value})
+				offset += sc.Interval
+			}
+		case ScenarioDropout:
+			offset += time.Duration(count) * sc.Interval
+			dropped = true
+			continue
+		}
+		if dropped && first < len(samples) {
+			samples[first].AfterDropout = true
+			dropped = false
+		}
+	}
+	return samples
+}
+
+// Duration total time of one pass through the scenario
+func (sc *Scenario) Duration() time.Duration {
+	var total time.Duration
+	if sc.Interval <= 0 {
+		return total
+	}
+	for _, step := range sc.Steps {
+		if step.Kind == ScenarioSpike {
+			total += sc.Interval
+		} else {
+			total += time.Duration(int(step.Duration/sc.Interval)) * sc.Interval
+		}
+	}
+	return total
+}
+
+// SimulatedSource plays a Scenario into a chart, either in real time or all at once
+type SimulatedSource struct {
+	scenario *Scenario
+	stop     chan struct{}
+	lock     sync.Mutex
+}
+
+// NewSimulatedSource creates a source for the given scenario
+func NewSimulatedSource(scenario *Scenario) *SimulatedSource {
+	return &SimulatedSource{
+		scenario: scenario,
+	}
+}
+
+// Scenario returns the scenario being played
+func (s *SimulatedSource) Scenario() *Scenario {
+	return s.scenario
+}
+
+// Fill applies one pass of the scenario to the chart immediately, timestamps start at base.
+// Intended for UI tests and screenshots where a deterministic chart is needed
+func (s *SimulatedSource) Fill(chart LineChart, base time.Time) {
+	for _, sample := range s.scenario.Samples() {
+		if sample.AfterDropout {
+			chart.InsertGap(s.scenario.Series)
+		}
+		point := NewChartDatapointAt(sample.Value, s.scenario.ColorName, base.Add(sample.Offset))
+		chart.ApplyDataPoint(s.scenario.Series, &point)
+	}
+}
+
+// Start plays the scenario into the chart in real time, repeating when the scenario loops.
+// Replaces any playback already running
+func (s *SimulatedSource) Start(chart LineChart) {
+	s.Stop()
+	stop := make(chan struct{})
+	s.lock.Lock()
+	s.stop = stop
+	s.lock.Unlock()
+	go s.play(chart, stop)
+}
+
+// Stop ends real time playback
+func (s *SimulatedSource) Stop() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+}
+
+// IsRunning returns true while real time playback is active
+func (s *SimulatedSource) IsRunning() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.stop != nil
+}
+
+// play emits samples on their scheduled offsets until stopped or the scenario ends
+func (s *SimulatedSource) play(chart LineChart, stop chan struct{}) {
+	defer func() {
+		s.lock.Lock()
+		if s.stop == stop {
+			s.stop = nil
+		}
+		s.lock.Unlock()
+	}()
+
+	samples := s.scenario.Samples()
+	passLength := s.scenario.Duration()
+	if len(samples) == 0 || passLength <= 0 {
+		return
+	}
+	start := time.Now()
+	for {
+		for _, sample := range samples {
+			timer := time.NewTimer(time.Until(start.Add(sample.Offset)))
+			select {
+			case <-stop:
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			if sample.AfterDropout {
+				chart.InsertGap(s.scenario.Series)
+			}
+			point := NewChartDatapointAt(sample.Value, s.scenario.ColorName, time.Now())
+			chart.ApplyDataPoint(s.scenario.Series, &point)
+		}
+		if !s.scenario.Loop {
+			return
+		}
+		start = start.Add(passLength)
+	}
+}
+
+// parseFloat32 parses a script number
+func parseFloat32(s string) (float32, error) {
+	v, err := strconv.ParseFloat(s, 32)
+	return float32(v), err
+}
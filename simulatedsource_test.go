@@ -0,0 +1,73 @@
+package sknlinechart_test
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+const demoScenario = `
+# spike thirty seconds in, then a ramp and a dropout
+series Pressure red
+interval 1s
+seed 7
+steady 20 for 30s
+spike 80
+ramp 20 to 60 over 5s
+dropout 10s
+noise 40 2.5 for 4s
+`
+
+var _ = Describe("Simulated scenario data source", func() {
+
+	It("should parse a scenario script", func() {
+		sc, err := sknlinechart.ParseScenario(demoScenario)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sc.Series).To(Equal("Pressure"))
+		Expect(sc.ColorName).To(Equal(theme.ColorRed))
+		Expect(sc.Interval).To(Equal(time.Second))
+		Expect(sc.Steps).To(HaveLen(5))
+		Expect(sc.Duration()).To(Equal(50 * time.Second))
+	})
+	It("should report the line of a script error", func() {
+		_, err := sknlinechart.ParseScenario("interval 1s\nramp 10 up 20")
+		Expect(err).To(MatchError(ContainSubstring("line 2")))
+	})
+	It("should generate the same samples on every pass", func() {
+		sc, _ := sknlinechart.ParseScenario(demoScenario)
+		first := sc.Samples()
+		Expect(first).To(Equal(sc.Samples()))
+		Expect(first).To(HaveLen(40))
+
+		By("placing the spike at t+30s")
+		Expect(first[30].Offset).To(Equal(30 * time.Second))
+		Expect(first[30].Value).To(BeNumerically("==", 80))
+
+		By("ending the ramp on its target")
+		Expect(first[35].Value).To(BeNumerically("==", 60))
+
+		By("skipping the dropout period")
+		Expect(first[36].Offset).To(Equal(46 * time.Second))
+		Expect(first[36].Value).To(BeNumerically("~", 40, 2.5))
+	})
+	It("should fill a chart deterministically", func() {
+		lc, _ := makeUI("Testing", "Simulated", 0)
+		defer stopRedraws(lc)
+		sc, _ := sknlinechart.ParseScenario(demoScenario)
+		source := sknlinechart.NewSimulatedSource(sc)
+		Expect(func() { source.Fill(lc, time.Unix(0, 0)) }).NotTo(Panic())
+	})
+	It("should play in real time until stopped", func() {
+		lc, _ := makeUI("Testing", "Simulated", 0)
+		defer stopRedraws(lc)
+		sc, _ := sknlinechart.ParseScenario("interval 5ms\nsteady 10 for 1s\nloop")
+		source := sknlinechart.NewSimulatedSource(sc)
+		source.Start(lc)
+		Expect(source.IsRunning()).To(BeTrue())
+		source.Stop()
+		Expect(source.IsRunning()).To(BeFalse())
+	})
+})